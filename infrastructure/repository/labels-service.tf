@@ -149,6 +149,7 @@ variable "service_labels" {
     "neptune",
     "networkfirewall",
     "networkmanager",
+    "opensearch",
     "opsworks",
     "opsworkscm",
     "organizations",
@@ -0,0 +1,67 @@
+package conns
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+// assumeRoleProvider returns a credentials provider for the role configured in the provider.
+// The role is assumed using the specified session so that the STS requests use its HTTP transport,
+// and therefore any custom CA bundle and proxy configured for the provider.
+func (c *Config) assumeRoleProvider(sess *session.Session) *stscreds.AssumeRoleProvider {
+	provider := &stscreds.AssumeRoleProvider{
+		Client:  sts.New(sess),
+		RoleARN: c.AssumeRoleARN,
+	}
+
+	if c.AssumeRoleDurationSeconds > 0 {
+		provider.Duration = time.Duration(c.AssumeRoleDurationSeconds) * time.Second
+	}
+
+	if c.AssumeRoleExternalID != "" {
+		provider.ExternalID = aws.String(c.AssumeRoleExternalID)
+	}
+
+	if c.AssumeRolePolicy != "" {
+		provider.Policy = aws.String(c.AssumeRolePolicy)
+	}
+
+	for _, v := range c.AssumeRolePolicyARNs {
+		provider.PolicyArns = append(provider.PolicyArns, &sts.PolicyDescriptorType{
+			Arn: aws.String(v),
+		})
+	}
+
+	if c.AssumeRoleSessionName != "" {
+		provider.RoleSessionName = c.AssumeRoleSessionName
+	}
+
+	for k, v := range c.AssumeRoleTags {
+		provider.Tags = append(provider.Tags, &sts.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(v),
+		})
+	}
+
+	if len(c.AssumeRoleTransitiveTagKeys) > 0 {
+		provider.TransitiveTagKeys = aws.StringSlice(c.AssumeRoleTransitiveTagKeys)
+	}
+
+	return provider
+}
+
+// assumeRoleCredentials returns validated credentials for the role configured in the provider.
+func (c *Config) assumeRoleCredentials(sess *session.Session) (*credentials.Credentials, error) {
+	creds := credentials.NewCredentials(c.assumeRoleProvider(sess))
+
+	if _, err := creds.Get(); err != nil {
+		return nil, err
+	}
+
+	return creds, nil
+}
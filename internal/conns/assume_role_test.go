@@ -0,0 +1,82 @@
+package conns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+)
+
+func TestAssumeRoleProvider(t *testing.T) {
+	sess, err := session.NewSession(&aws.Config{Region: aws.String("us-west-2")}) //lintignore:AWSAT003
+
+	if err != nil {
+		t.Fatalf("error creating session: %s", err)
+	}
+
+	c := &Config{
+		AssumeRoleARN:               "arn:aws:iam::123456789012:role/test", //lintignore:AWSAT005
+		AssumeRoleDurationSeconds:   900,
+		AssumeRoleExternalID:        "external-id",
+		AssumeRolePolicy:            `{"Version":"2012-10-17"}`,
+		AssumeRolePolicyARNs:        []string{"arn:aws:iam::aws:policy/ReadOnlyAccess"}, //lintignore:AWSAT005
+		AssumeRoleSessionName:       "session-name",
+		AssumeRoleTags:              map[string]string{"key": "value"},
+		AssumeRoleTransitiveTagKeys: []string{"key"},
+	}
+
+	provider := c.assumeRoleProvider(sess)
+
+	if got, expected := provider.RoleARN, c.AssumeRoleARN; got != expected {
+		t.Errorf("got RoleARN %s, expected %s", got, expected)
+	}
+
+	if got, expected := provider.Duration, 15*time.Minute; got != expected {
+		t.Errorf("got Duration %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(provider.ExternalID), c.AssumeRoleExternalID; got != expected {
+		t.Errorf("got ExternalID %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValue(provider.Policy), c.AssumeRolePolicy; got != expected {
+		t.Errorf("got Policy %s, expected %s", got, expected)
+	}
+
+	if got, expected := len(provider.PolicyArns), 1; got != expected {
+		t.Fatalf("got %d PolicyArns, expected %d", got, expected)
+	}
+
+	if got, expected := aws.StringValue(provider.PolicyArns[0].Arn), c.AssumeRolePolicyARNs[0]; got != expected {
+		t.Errorf("got PolicyArns[0] %s, expected %s", got, expected)
+	}
+
+	if got, expected := provider.RoleSessionName, c.AssumeRoleSessionName; got != expected {
+		t.Errorf("got RoleSessionName %s, expected %s", got, expected)
+	}
+
+	if got, expected := len(provider.Tags), 1; got != expected {
+		t.Fatalf("got %d Tags, expected %d", got, expected)
+	}
+
+	if got, expected := aws.StringValue(provider.Tags[0].Key)+"="+aws.StringValue(provider.Tags[0].Value), "key=value"; got != expected {
+		t.Errorf("got Tags[0] %s, expected %s", got, expected)
+	}
+
+	if got, expected := aws.StringValueSlice(provider.TransitiveTagKeys), c.AssumeRoleTransitiveTagKeys; len(got) != 1 || got[0] != expected[0] {
+		t.Errorf("got TransitiveTagKeys %v, expected %v", got, expected)
+	}
+
+	// The STS client shares the session's HTTP client, and so its transport.
+	client, ok := provider.Client.(*sts.STS)
+
+	if !ok {
+		t.Fatalf("unexpected STS client type: %T", provider.Client)
+	}
+
+	if client.Config.HTTPClient != sess.Config.HTTPClient {
+		t.Errorf("STS client does not use the session's HTTP client")
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
@@ -165,7 +166,6 @@ import (
 	"github.com/aws/aws-sdk-go/service/lookoutforvision"
 	"github.com/aws/aws-sdk-go/service/lookoutmetrics"
 	"github.com/aws/aws-sdk-go/service/machinelearning"
-	"github.com/aws/aws-sdk-go/service/macie"
 	"github.com/aws/aws-sdk-go/service/macie2"
 	"github.com/aws/aws-sdk-go/service/managedblockchain"
 	"github.com/aws/aws-sdk-go/service/marketplacecatalog"
@@ -193,6 +193,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/aws/aws-sdk-go/service/networkmanager"
 	"github.com/aws/aws-sdk-go/service/nimblestudio"
+	"github.com/aws/aws-sdk-go/service/opensearchservice"
 	"github.com/aws/aws-sdk-go/service/opsworks"
 	"github.com/aws/aws-sdk-go/service/opsworkscm"
 	"github.com/aws/aws-sdk-go/service/organizations"
@@ -446,7 +447,6 @@ const (
 	LookoutForVision              = "lookoutforvision"
 	LookoutMetrics                = "lookoutmetrics"
 	MachineLearning               = "machinelearning"
-	Macie                         = "macie"
 	Macie2                        = "macie2"
 	ManagedBlockchain             = "managedblockchain"
 	MarketplaceCatalog            = "marketplacecatalog"
@@ -474,6 +474,7 @@ const (
 	NetworkFirewall               = "networkfirewall"
 	NetworkManager                = "networkmanager"
 	NimbleStudio                  = "nimblestudio"
+	OpenSearch                    = "opensearch"
 	OpsWorks                      = "opsworks"
 	OpsWorksCM                    = "opsworkscm"
 	Organizations                 = "organizations"
@@ -731,7 +732,6 @@ func init() {
 	serviceData[LookoutForVision] = &ServiceDatum{AWSClientName: "LookoutForVision", AWSServiceName: lookoutforvision.ServiceName, AWSEndpointsID: lookoutforvision.EndpointsID, AWSServiceID: lookoutforvision.ServiceID, ProviderNameUpper: "LookoutForVision", HCLKeys: []string{"lookoutforvision"}}
 	serviceData[LookoutMetrics] = &ServiceDatum{AWSClientName: "LookoutMetrics", AWSServiceName: lookoutmetrics.ServiceName, AWSEndpointsID: lookoutmetrics.EndpointsID, AWSServiceID: lookoutmetrics.ServiceID, ProviderNameUpper: "LookoutMetrics", HCLKeys: []string{"lookoutmetrics"}}
 	serviceData[MachineLearning] = &ServiceDatum{AWSClientName: "MachineLearning", AWSServiceName: machinelearning.ServiceName, AWSEndpointsID: machinelearning.EndpointsID, AWSServiceID: machinelearning.ServiceID, ProviderNameUpper: "MachineLearning", HCLKeys: []string{"machinelearning"}}
-	serviceData[Macie] = &ServiceDatum{AWSClientName: "Macie", AWSServiceName: macie.ServiceName, AWSEndpointsID: macie.EndpointsID, AWSServiceID: macie.ServiceID, ProviderNameUpper: "Macie", HCLKeys: []string{"macie"}}
 	serviceData[Macie2] = &ServiceDatum{AWSClientName: "Macie2", AWSServiceName: macie2.ServiceName, AWSEndpointsID: macie2.EndpointsID, AWSServiceID: macie2.ServiceID, ProviderNameUpper: "Macie2", HCLKeys: []string{"macie2"}}
 	serviceData[ManagedBlockchain] = &ServiceDatum{AWSClientName: "ManagedBlockchain", AWSServiceName: managedblockchain.ServiceName, AWSEndpointsID: managedblockchain.EndpointsID, AWSServiceID: managedblockchain.ServiceID, ProviderNameUpper: "ManagedBlockchain", HCLKeys: []string{"managedblockchain"}}
 	serviceData[MarketplaceCatalog] = &ServiceDatum{AWSClientName: "MarketplaceCatalog", AWSServiceName: marketplacecatalog.ServiceName, AWSEndpointsID: marketplacecatalog.EndpointsID, AWSServiceID: marketplacecatalog.ServiceID, ProviderNameUpper: "MarketplaceCatalog", HCLKeys: []string{"marketplacecatalog"}}
@@ -759,6 +759,7 @@ func init() {
 	serviceData[NetworkFirewall] = &ServiceDatum{AWSClientName: "NetworkFirewall", AWSServiceName: networkfirewall.ServiceName, AWSEndpointsID: networkfirewall.EndpointsID, AWSServiceID: networkfirewall.ServiceID, ProviderNameUpper: "NetworkFirewall", HCLKeys: []string{"networkfirewall"}}
 	serviceData[NetworkManager] = &ServiceDatum{AWSClientName: "NetworkManager", AWSServiceName: networkmanager.ServiceName, AWSEndpointsID: networkmanager.EndpointsID, AWSServiceID: networkmanager.ServiceID, ProviderNameUpper: "NetworkManager", HCLKeys: []string{"networkmanager"}}
 	serviceData[NimbleStudio] = &ServiceDatum{AWSClientName: "NimbleStudio", AWSServiceName: nimblestudio.ServiceName, AWSEndpointsID: nimblestudio.EndpointsID, AWSServiceID: nimblestudio.ServiceID, ProviderNameUpper: "NimbleStudio", HCLKeys: []string{"nimblestudio"}}
+	serviceData[OpenSearch] = &ServiceDatum{AWSClientName: "OpenSearchService", AWSServiceName: opensearchservice.ServiceName, AWSEndpointsID: opensearchservice.EndpointsID, AWSServiceID: opensearchservice.ServiceID, ProviderNameUpper: "OpenSearch", HCLKeys: []string{"opensearch", "opensearchservice"}}
 	serviceData[OpsWorks] = &ServiceDatum{AWSClientName: "OpsWorks", AWSServiceName: opsworks.ServiceName, AWSEndpointsID: opsworks.EndpointsID, AWSServiceID: opsworks.ServiceID, ProviderNameUpper: "OpsWorks", HCLKeys: []string{"opsworks"}}
 	serviceData[OpsWorksCM] = &ServiceDatum{AWSClientName: "OpsWorksCM", AWSServiceName: opsworkscm.ServiceName, AWSEndpointsID: opsworkscm.EndpointsID, AWSServiceID: opsworkscm.ServiceID, ProviderNameUpper: "OpsWorksCM", HCLKeys: []string{"opsworkscm"}}
 	serviceData[Organizations] = &ServiceDatum{AWSClientName: "Organizations", AWSServiceName: organizations.ServiceName, AWSEndpointsID: organizations.EndpointsID, AWSServiceID: organizations.ServiceID, ProviderNameUpper: "Organizations", HCLKeys: []string{"organizations"}}
@@ -866,11 +867,16 @@ type Config struct {
 	AllowedAccountIds   []string
 	ForbiddenAccountIds []string
 
+	CustomCABundle    string
 	DefaultTagsConfig *tftags.DefaultConfig
 	Endpoints         map[string]string
 	IgnoreTagsConfig  *tftags.IgnoreConfig
 	Insecure          bool
 	HTTPProxy         string
+	HTTPSProxy        string
+	NoProxy           string
+	RetryMode         string
+	TagPolicyConfig   *tftags.PolicyConfig
 
 	SkipCredsValidation     bool
 	SkipGetEC2Platforms     bool
@@ -1045,7 +1051,6 @@ type AWSClient struct {
 	LookoutMetricsConn                *lookoutmetrics.LookoutMetrics
 	MachineLearningConn               *machinelearning.MachineLearning
 	Macie2Conn                        *macie2.Macie2
-	MacieConn                         *macie.Macie
 	ManagedBlockchainConn             *managedblockchain.ManagedBlockchain
 	MarketplaceCatalogConn            *marketplacecatalog.MarketplaceCatalog
 	MarketplaceCommerceAnalyticsConn  *marketplacecommerceanalytics.MarketplaceCommerceAnalytics
@@ -1073,6 +1078,7 @@ type AWSClient struct {
 	NetworkFirewallConn               *networkfirewall.NetworkFirewall
 	NetworkManagerConn                *networkmanager.NetworkManager
 	NimbleStudioConn                  *nimblestudio.NimbleStudio
+	OpenSearchConn                    *opensearchservice.OpenSearchService
 	OpsWorksCMConn                    *opsworkscm.OpsWorksCM
 	OpsWorksConn                      *opsworks.OpsWorks
 	OrganizationsConn                 *organizations.Organizations
@@ -1145,6 +1151,7 @@ type AWSClient struct {
 	SupportedPlatforms                []string
 	SWFConn                           *swf.SWF
 	SyntheticsConn                    *synthetics.Synthetics
+	TagPolicyConfig                   *tftags.PolicyConfig
 	TerraformVersion                  string
 	TextractConn                      *textract.Textract
 	TimestreamQueryConn               *timestreamquery.TimestreamQuery
@@ -1179,6 +1186,29 @@ func (client *AWSClient) RegionalHostname(prefix string) string {
 	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
 }
 
+// ForResourceType returns the client to use for the specified resource type.
+// Any provider-level tag configuration that excludes the resource type is removed from the returned client.
+func (client *AWSClient) ForResourceType(resourceType string) *AWSClient {
+	excludeDefaultTags := client.DefaultTagsConfig.ExcludesResourceType(resourceType)
+	excludeTagPolicy := client.TagPolicyConfig.ExcludesResourceType(resourceType)
+
+	if !excludeDefaultTags && !excludeTagPolicy {
+		return client
+	}
+
+	c := *client
+
+	if excludeDefaultTags {
+		c.DefaultTagsConfig = nil
+	}
+
+	if excludeTagPolicy {
+		c.TagPolicyConfig = nil
+	}
+
+	return &c
+}
+
 // Client configures and returns a fully initialized AWSClient
 func (c *Config) Client() (interface{}, error) {
 	// Get the auth and region. This can fail if keys/regions were not
@@ -1189,36 +1219,46 @@ func (c *Config) Client() (interface{}, error) {
 		}
 	}
 
+	// The session is built by the provider rather than by awsbase, whose credential
+	// resolution cannot use the provider's custom CA bundle or proxy. The role is
+	// assumed below using that session.
 	awsbaseConfig := &awsbase.Config{
-		AccessKey:                   c.AccessKey,
-		AssumeRoleARN:               c.AssumeRoleARN,
-		AssumeRoleDurationSeconds:   c.AssumeRoleDurationSeconds,
-		AssumeRoleExternalID:        c.AssumeRoleExternalID,
-		AssumeRolePolicy:            c.AssumeRolePolicy,
-		AssumeRolePolicyARNs:        c.AssumeRolePolicyARNs,
-		AssumeRoleSessionName:       c.AssumeRoleSessionName,
-		AssumeRoleTags:              c.AssumeRoleTags,
-		AssumeRoleTransitiveTagKeys: c.AssumeRoleTransitiveTagKeys,
-		CallerDocumentationURL:      "https://registry.terraform.io/providers/hashicorp/aws",
-		CallerName:                  "Terraform AWS Provider",
-		CredsFilename:               c.CredsFilename,
-		DebugLogging:                logging.IsDebugOrHigher(),
-		IamEndpoint:                 c.Endpoints[IAM],
-		Insecure:                    c.Insecure,
-		HTTPProxy:                   c.HTTPProxy,
-		MaxRetries:                  c.MaxRetries,
-		Profile:                     c.Profile,
-		Region:                      c.Region,
-		SecretKey:                   c.SecretKey,
-		SkipCredsValidation:         c.SkipCredsValidation,
-		SkipMetadataApiCheck:        c.SkipMetadataApiCheck,
-		SkipRequestingAccountId:     c.SkipRequestingAccountId,
-		StsEndpoint:                 c.Endpoints[STS],
-		Token:                       c.Token,
-		UserAgentProducts:           StdUserAgentProducts(c.TerraformVersion),
+		AccessKey:              c.AccessKey,
+		CallerDocumentationURL: "https://registry.terraform.io/providers/hashicorp/aws",
+		CallerName:             "Terraform AWS Provider",
+		CredsFilename:          c.CredsFilename,
+		DebugLogging:           logging.IsDebugOrHigher(),
+		IamEndpoint:            c.Endpoints[IAM],
+		Insecure:               c.Insecure,
+		MaxRetries:             c.MaxRetries,
+		Profile:                c.Profile,
+		Region:                 c.Region,
+		SecretKey:              c.SecretKey,
+		StsEndpoint:            c.Endpoints[STS],
+		Token:                  c.Token,
+		UserAgentProducts:      StdUserAgentProducts(c.TerraformVersion),
 	}
 
-	sess, accountID, Partition, err := awsbase.GetSessionWithAccountIDAndPartition(awsbaseConfig)
+	sess, err := c.session(awsbaseConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	if err := configureRetryMode(sess, c.RetryMode); err != nil {
+		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
+	}
+
+	if c.AssumeRoleARN != "" {
+		creds, err := c.assumeRoleCredentials(sess)
+
+		if err != nil {
+			return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", (&awsbase.Config{AssumeRoleARN: c.AssumeRoleARN}).NewCannotAssumeRoleError(err))
+		}
+
+		sess = sess.Copy(&aws.Config{Credentials: creds})
+	}
+
+	accountID, Partition, err := c.accountIDAndPartition(sess)
 	if err != nil {
 		return nil, fmt.Errorf("error configuring Terraform AWS Provider: %w", err)
 	}
@@ -1398,7 +1438,6 @@ func (c *Config) Client() (interface{}, error) {
 		LookoutMetricsConn:                lookoutmetrics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[LookoutMetrics])})),
 		MachineLearningConn:               machinelearning.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MachineLearning])})),
 		Macie2Conn:                        macie2.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Macie2])})),
-		MacieConn:                         macie.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Macie])})),
 		ManagedBlockchainConn:             managedblockchain.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[ManagedBlockchain])})),
 		MarketplaceCatalogConn:            marketplacecatalog.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceCatalog])})),
 		MarketplaceCommerceAnalyticsConn:  marketplacecommerceanalytics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[MarketplaceCommerceAnalytics])})),
@@ -1425,6 +1464,7 @@ func (c *Config) Client() (interface{}, error) {
 		NetworkFirewallConn:               networkfirewall.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkFirewall])})),
 		NetworkManagerConn:                networkmanager.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NetworkManager])})),
 		NimbleStudioConn:                  nimblestudio.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[NimbleStudio])})),
+		OpenSearchConn:                    opensearchservice.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpenSearch])})),
 		OpsWorksCMConn:                    opsworkscm.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorksCM])})),
 		OpsWorksConn:                      opsworks.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[OpsWorks])})),
 		OrganizationsConn:                 organizations.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Organizations])})),
@@ -1492,6 +1532,7 @@ func (c *Config) Client() (interface{}, error) {
 		SupportConn:                       support.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Support])})),
 		SWFConn:                           swf.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[SWF])})),
 		SyntheticsConn:                    synthetics.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Synthetics])})),
+		TagPolicyConfig:                   c.TagPolicyConfig,
 		TerraformVersion:                  c.TerraformVersion,
 		TextractConn:                      textract.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[Textract])})),
 		TimestreamQueryConn:               timestreamquery.New(sess.Copy(&aws.Config{Endpoint: aws.String(c.Endpoints[TimestreamQuery])})),
@@ -1812,6 +1853,58 @@ func (c *Config) Client() (interface{}, error) {
 	return client, nil
 }
 
+// accountIDAndPartition validates the session's credentials and returns the
+// AWS account ID and partition, honoring the provider's skip settings.
+func (c *Config) accountIDAndPartition(sess *session.Session) (string, string, error) {
+	stsClient := sts.New(sess)
+
+	if !c.SkipCredsValidation {
+		accountID, partition, err := awsbase.GetAccountIDAndPartitionFromSTSGetCallerIdentity(stsClient)
+
+		if err != nil {
+			return "", "", fmt.Errorf("error validating provider credentials: %w", err)
+		}
+
+		if c.AssumeRoleARN == "" {
+			return accountID, partition, nil
+		}
+	}
+
+	if c.AssumeRoleARN != "" {
+		if v, err := arn.Parse(c.AssumeRoleARN); err == nil {
+			return v.AccountID, v.Partition, nil
+		}
+
+		return "", "", nil
+	}
+
+	if !c.SkipRequestingAccountId {
+		credentialsProviderName := ""
+
+		if credentialsValue, err := sess.Config.Credentials.Get(); err == nil {
+			credentialsProviderName = credentialsValue.ProviderName
+		}
+
+		accountID, partition, err := awsbase.GetAccountIDAndPartition(iam.New(sess), stsClient, credentialsProviderName)
+
+		if err != nil {
+			return "", "", fmt.Errorf(
+				"AWS account ID not previously found and failed retrieving via all available methods. "+
+					"See https://www.terraform.io/docs/providers/aws/index.html#skip_requesting_account_id for workaround and implications. "+
+					"Errors: %w", err)
+		}
+
+		return accountID, partition, nil
+	}
+
+	var partition string
+	if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), c.Region); ok {
+		partition = p.ID()
+	}
+
+	return "", partition, nil
+}
+
 func StdUserAgentProducts(terraformVersion string) []*awsbase.UserAgentProduct {
 	return []*awsbase.UserAgentProduct{
 		{Name: "APN", Version: "1.0"},
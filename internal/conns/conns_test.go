@@ -6,6 +6,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/ec2"
 	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) {
@@ -82,6 +83,74 @@ func TestAWSClientRegionalHostname(t *testing.T) {
 	}
 }
 
+func TestAWSClientForResourceType(t *testing.T) {
+	client := &AWSClient{
+		DefaultTagsConfig: &tftags.DefaultConfig{
+			Tags:                 tftags.New(map[string]string{"key1": "value1"}),
+			ExcludeResourceTypes: []string{"aws_s3_bucket_object", "aws_instance"},
+		},
+		Region: "us-west-2", //lintignore:AWSAT003
+		TagPolicyConfig: &tftags.PolicyConfig{
+			RequiredKeys:         []string{"key1"},
+			ExcludeResourceTypes: []string{"aws_instance"},
+		},
+	}
+
+	testCases := []struct {
+		Name                    string
+		ResourceType            string
+		ExpectSameClient        bool
+		ExpectDefaultTagsConfig bool
+		ExpectTagPolicyConfig   bool
+	}{
+		{
+			Name:                    "not excluded",
+			ResourceType:            "aws_vpc",
+			ExpectSameClient:        true,
+			ExpectDefaultTagsConfig: true,
+			ExpectTagPolicyConfig:   true,
+		},
+		{
+			Name:                    "default tags excluded",
+			ResourceType:            "aws_s3_bucket_object",
+			ExpectDefaultTagsConfig: false,
+			ExpectTagPolicyConfig:   true,
+		},
+		{
+			Name:                    "all excluded",
+			ResourceType:            "aws_instance",
+			ExpectDefaultTagsConfig: false,
+			ExpectTagPolicyConfig:   false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got := client.ForResourceType(testCase.ResourceType)
+
+			if (got == client) != testCase.ExpectSameClient {
+				t.Errorf("got same client %t, expected %t", got == client, testCase.ExpectSameClient)
+			}
+
+			if (got.DefaultTagsConfig != nil) != testCase.ExpectDefaultTagsConfig {
+				t.Errorf("got DefaultTagsConfig %v, expected present %t", got.DefaultTagsConfig, testCase.ExpectDefaultTagsConfig)
+			}
+
+			if (got.TagPolicyConfig != nil) != testCase.ExpectTagPolicyConfig {
+				t.Errorf("got TagPolicyConfig %v, expected present %t", got.TagPolicyConfig, testCase.ExpectTagPolicyConfig)
+			}
+
+			if got.Region != client.Region {
+				t.Errorf("got Region %s, expected %s", got.Region, client.Region)
+			}
+		})
+	}
+
+	if client.DefaultTagsConfig == nil || client.TagPolicyConfig == nil {
+		t.Errorf("original client configuration was modified")
+	}
+}
+
 func TestGetSupportedEC2Platforms(t *testing.T) {
 	ec2Endpoints := []*awsbase.MockEndpoint{
 		{
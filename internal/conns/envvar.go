@@ -72,6 +72,30 @@ const (
 	EnvVarAssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used for controlling resource sweepers
+const (
+	// Maximum number of sweepers run, and of resources deleted, at the same time
+	EnvVarSweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+
+	// Lists the resources that would be deleted without deleting them
+	EnvVarSweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Minimum age of resources to delete, as a Go duration
+	EnvVarSweepMinAge = "TF_AWS_SWEEP_MIN_AGE"
+
+	// Comma-separated list of name prefixes of resources to delete
+	EnvVarSweepNamePrefixes = "TF_AWS_SWEEP_NAME_PREFIXES"
+
+	// Regular expression matching the names of resources to delete
+	EnvVarSweepNameRegex = "TF_AWS_SWEEP_NAME_REGEX"
+
+	// Path of the file the JSON sweeper report is written to
+	EnvVarSweepReport = "TF_AWS_SWEEP_REPORT"
+
+	// Comma-separated list of key=value tags of resources to delete
+	EnvVarSweepTags = "TF_AWS_SWEEP_TAGS"
+)
+
 // GetEnvVarWithDefault gets an environment variable value if non-empty or returns the default.
 func GetEnvVarWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
@@ -0,0 +1,191 @@
+package conns
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+const (
+	// RetryModeStandard retries failed requests using the AWS SDK for Go
+	// default exponential backoff.
+	RetryModeStandard = "standard"
+
+	// RetryModeAdaptive retries failed requests as RetryModeStandard does and
+	// additionally applies client-side rate limiting once the AWS APIs start
+	// returning throttling errors.
+	RetryModeAdaptive = "adaptive"
+)
+
+func RetryMode_Values() []string {
+	return []string{
+		RetryModeStandard,
+		RetryModeAdaptive,
+	}
+}
+
+const (
+	adaptiveRetryBeta                = 0.7
+	adaptiveRetryMinFillRate         = 0.5
+	adaptiveRetryFillRateIncrease    = 0.5
+	adaptiveRetryMeasurementInterval = 500 * time.Millisecond
+	adaptiveRetryMeasurementSmooth   = 0.8
+)
+
+// configureRetryMode configures the session's handlers for the specified retry mode.
+// Handlers must be configured before any service clients are created from the session.
+func configureRetryMode(sess *session.Session, mode string) error {
+	switch mode {
+	case "", RetryModeStandard:
+		return nil
+	case RetryModeAdaptive:
+		// The rate limiter is shared by all service clients created from the session.
+		limiter := newAdaptiveRateLimiter()
+
+		sess.Handlers.Send.PushFrontNamed(request.NamedHandler{
+			Name: "terraform-provider-aws.AdaptiveRetryAcquireToken",
+			Fn: func(r *request.Request) {
+				limiter.acquire()
+			},
+		})
+		sess.Handlers.CompleteAttempt.PushBackNamed(request.NamedHandler{
+			Name: "terraform-provider-aws.AdaptiveRetryUpdateRate",
+			Fn: func(r *request.Request) {
+				limiter.update(r.Error != nil && r.IsErrorThrottle())
+			},
+		})
+
+		return nil
+	default:
+		return fmt.Errorf("unsupported retry mode (%s), expected one of: %v", mode, RetryMode_Values())
+	}
+}
+
+// adaptiveRateLimiter is a token bucket whose fill rate is decreased
+// multiplicatively on throttling errors and increased additively on success.
+// Rate limiting is only enabled after the first throttling error.
+type adaptiveRateLimiter struct {
+	mu sync.Mutex
+
+	enabled     bool
+	fillRate    float64
+	capacity    float64
+	maxCapacity float64
+	lastRefill  time.Time
+
+	measuredRate  float64
+	measuredCount int
+	measuredTime  time.Time
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+func newAdaptiveRateLimiter() *adaptiveRateLimiter {
+	l := &adaptiveRateLimiter{
+		now:   time.Now,
+		sleep: time.Sleep,
+	}
+
+	l.lastRefill = l.now()
+	l.measuredTime = l.lastRefill.Truncate(adaptiveRetryMeasurementInterval)
+
+	return l
+}
+
+// acquire blocks until a token is available.
+func (l *adaptiveRateLimiter) acquire() {
+	l.mu.Lock()
+
+	if !l.enabled {
+		l.mu.Unlock()
+		return
+	}
+
+	l.refill()
+
+	var wait time.Duration
+	if l.capacity < 1 {
+		wait = time.Duration((1 - l.capacity) / l.fillRate * float64(time.Second))
+	}
+
+	// Reserve the token now so that concurrent callers queue up behind this one.
+	l.capacity--
+
+	l.mu.Unlock()
+
+	if wait > 0 {
+		l.sleep(wait)
+	}
+}
+
+// update records the result of a request attempt and adjusts the fill rate.
+func (l *adaptiveRateLimiter) update(throttled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.updateMeasuredRate()
+
+	if throttled {
+		rate := l.measuredRate
+
+		if l.enabled {
+			rate = math.Min(rate, l.fillRate)
+		}
+
+		l.enabled = true
+		l.setFillRate(rate * adaptiveRetryBeta)
+
+		return
+	}
+
+	if !l.enabled {
+		return
+	}
+
+	rate := l.fillRate + adaptiveRetryFillRateIncrease
+
+	// Don't let the fill rate run away from the observed request rate.
+	if v := 2 * l.measuredRate; v > 0 {
+		rate = math.Min(rate, v)
+	}
+
+	l.setFillRate(rate)
+}
+
+func (l *adaptiveRateLimiter) refill() {
+	now := l.now()
+
+	if l.fillRate > 0 {
+		l.capacity = math.Min(l.maxCapacity, l.capacity+now.Sub(l.lastRefill).Seconds()*l.fillRate)
+	}
+
+	l.lastRefill = now
+}
+
+func (l *adaptiveRateLimiter) setFillRate(rate float64) {
+	l.refill()
+
+	l.fillRate = math.Max(rate, adaptiveRetryMinFillRate)
+	l.maxCapacity = math.Max(l.fillRate, 1)
+	l.capacity = math.Min(l.capacity, l.maxCapacity)
+}
+
+func (l *adaptiveRateLimiter) updateMeasuredRate() {
+	l.measuredCount++
+
+	bucket := l.now().Truncate(adaptiveRetryMeasurementInterval)
+
+	if !bucket.After(l.measuredTime) {
+		return
+	}
+
+	rate := float64(l.measuredCount) / bucket.Sub(l.measuredTime).Seconds()
+	l.measuredRate = rate*adaptiveRetryMeasurementSmooth + l.measuredRate*(1-adaptiveRetryMeasurementSmooth)
+	l.measuredCount = 0
+	l.measuredTime = bucket
+}
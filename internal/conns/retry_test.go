@@ -0,0 +1,133 @@
+package conns
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+)
+
+func TestConfigureRetryMode(t *testing.T) {
+	testCases := []struct {
+		Name             string
+		Mode             string
+		ExpectError      bool
+		ExpectedHandlers int
+	}{
+		{
+			Name: "empty",
+			Mode: "",
+		},
+		{
+			Name: "standard",
+			Mode: RetryModeStandard,
+		},
+		{
+			Name:             "adaptive",
+			Mode:             RetryModeAdaptive,
+			ExpectedHandlers: 1,
+		},
+		{
+			Name:        "invalid",
+			Mode:        "legacy",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			sess := session.Must(session.NewSession())
+			sendHandlers := sess.Handlers.Send.Len()
+			completeAttemptHandlers := sess.Handlers.CompleteAttempt.Len()
+
+			err := configureRetryMode(sess, testCase.Mode)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, expected := sess.Handlers.Send.Len()-sendHandlers, testCase.ExpectedHandlers; got != expected {
+				t.Errorf("got %d additional Send handlers, expected %d", got, expected)
+			}
+
+			if got, expected := sess.Handlers.CompleteAttempt.Len()-completeAttemptHandlers, testCase.ExpectedHandlers; got != expected {
+				t.Errorf("got %d additional CompleteAttempt handlers, expected %d", got, expected)
+			}
+		})
+	}
+}
+
+func TestAdaptiveRateLimiter(t *testing.T) {
+	now := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	var slept time.Duration
+
+	l := newAdaptiveRateLimiter()
+	l.now = func() time.Time { return now }
+	l.sleep = func(d time.Duration) { slept += d }
+	l.lastRefill = now
+	l.measuredTime = now
+
+	// Rate limiting is disabled until the first throttling error.
+	for i := 0; i < 10; i++ {
+		l.acquire()
+		now = now.Add(100 * time.Millisecond)
+		l.update(false)
+	}
+
+	if l.enabled {
+		t.Fatalf("expected rate limiting to be disabled")
+	}
+
+	if slept != 0 {
+		t.Fatalf("expected no wait, got %s", slept)
+	}
+
+	measuredRate := l.measuredRate
+
+	if measuredRate <= 0 {
+		t.Fatalf("expected a positive measured rate, got %f", measuredRate)
+	}
+
+	l.update(true)
+
+	if !l.enabled {
+		t.Fatalf("expected rate limiting to be enabled")
+	}
+
+	throttledRate := l.fillRate
+
+	if expected := measuredRate * adaptiveRetryBeta; throttledRate > expected {
+		t.Errorf("got fill rate %f, expected at most %f", throttledRate, expected)
+	}
+
+	// Consecutive throttling errors keep reducing the fill rate down to the minimum.
+	for i := 0; i < 50; i++ {
+		l.update(true)
+	}
+
+	if got, expected := l.fillRate, adaptiveRetryMinFillRate; got != expected {
+		t.Errorf("got fill rate %f, expected %f", got, expected)
+	}
+
+	// With the bucket drained, acquiring a token has to wait for it to refill.
+	l.capacity = 0
+	slept = 0
+	l.acquire()
+
+	if expected := time.Duration(float64(time.Second) / adaptiveRetryMinFillRate); slept != expected {
+		t.Errorf("got wait %s, expected %s", slept, expected)
+	}
+
+	// Successful requests increase the fill rate again.
+	before := l.fillRate
+	now = now.Add(time.Second)
+	l.update(false)
+
+	if l.fillRate <= before {
+		t.Errorf("expected fill rate to increase from %f, got %f", before, l.fillRate)
+	}
+}
@@ -0,0 +1,189 @@
+package conns
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+// session returns an AWS Go SDK session for the provider configuration.
+// It replaces awsbase.GetSession, which resolves credentials from the shared
+// configuration (e.g. role_arn, SSO or credential_process profiles) using a
+// default HTTP client, so that those requests also use the custom CA bundle
+// and proxy configured for the provider.
+func (c *Config) session(awsbaseConfig *awsbase.Config) (*session.Session, error) {
+	if c.SkipMetadataApiCheck {
+		os.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+	}
+
+	httpClient, err := c.httpClient()
+
+	if err != nil {
+		return nil, err
+	}
+
+	creds, err := c.credentials(awsbaseConfig, httpClient)
+
+	if err != nil {
+		return nil, err
+	}
+
+	options := session.Options{
+		Config: aws.Config{
+			CredentialsChainVerboseErrors: aws.Bool(true),
+			Credentials:                   creds,
+			EndpointResolver:              awsbaseConfig.EndpointResolver(),
+			HTTPClient:                    httpClient,
+			MaxRetries:                    aws.Int(0),
+			Region:                        aws.String(c.Region),
+		},
+		Profile:           c.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	}
+
+	if logging.IsDebugOrHigher() {
+		options.Config.LogLevel = aws.LogLevel(aws.LogDebugWithHTTPBody | aws.LogDebugWithRequestRetries | aws.LogDebugWithRequestErrors)
+		options.Config.Logger = awsbase.DebugLogger{}
+	}
+
+	sess, err := session.NewSessionWithOptions(options)
+
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+			return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+		}
+
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	if c.MaxRetries > 0 {
+		sess = sess.Copy(&aws.Config{MaxRetries: aws.Int(c.MaxRetries)})
+	}
+
+	// The configured User-Agent products take precedence over the AWS SDK for Go product,
+	// so they are pushed to the front of the build handlers in reverse order.
+	for i := len(awsbaseConfig.UserAgentProducts) - 1; i >= 0; i-- {
+		product := awsbaseConfig.UserAgentProducts[i]
+		sess.Handlers.Build.PushFront(request.MakeAddToUserAgentHandler(product.Name, product.Version, product.Extra...))
+	}
+
+	if v := os.Getenv(awsbase.AppendUserAgentEnvVar); v != "" {
+		log.Printf("[DEBUG] Using additional User-Agent Info: %s", v)
+		sess.Handlers.Build.PushBack(request.MakeAddToUserAgentFreeFormHandler(v))
+	}
+
+	// Stop retrying permanent networking failures, such as a non-existent service endpoint,
+	// which would otherwise be masked by the session's high retry threshold.
+	sess.Handlers.Retry.PushBack(func(r *request.Request) {
+		if r.RetryCount < awsbase.MaxNetworkRetryCount {
+			return
+		}
+
+		if tfawserr.ErrMessageAndOrigErrContain(r.Error, request.ErrCodeRequestError, "send request failed", "no such host") ||
+			tfawserr.ErrMessageAndOrigErrContain(r.Error, request.ErrCodeRequestError, "send request failed", "connection refused") {
+			log.Printf("[WARN] Disabling retries after next request due to networking issue")
+			r.Retryable = aws.Bool(false)
+		}
+	})
+
+	return sess, nil
+}
+
+// httpClient returns the HTTP client used by all AWS API requests, configured
+// with the provider's TLS verification, custom CA bundle and proxy settings.
+func (c *Config) httpClient() (*http.Client, error) {
+	client := cleanhttp.DefaultClient()
+
+	if c.Insecure {
+		client.Transport.(*http.Transport).TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true,
+		}
+	}
+
+	if err := c.configureTransport(client); err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+// credentials returns validated credentials from the provider configuration,
+// environment, shared credentials file or, failing those, the shared configuration
+// and EC2/ECS metadata endpoints.
+func (c *Config) credentials(awsbaseConfig *awsbase.Config, httpClient *http.Client) (*credentials.Credentials, error) {
+	sharedCredentialsFilename, err := homedir.Expand(c.CredsFilename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error expanding shared credentials filename: %w", err)
+	}
+
+	creds := credentials.NewChainCredentials([]credentials.Provider{
+		&credentials.StaticProvider{Value: credentials.Value{
+			AccessKeyID:     c.AccessKey,
+			SecretAccessKey: c.SecretKey,
+			SessionToken:    c.Token,
+		}},
+		&credentials.EnvProvider{},
+		&credentials.SharedCredentialsProvider{
+			Filename: sharedCredentialsFilename,
+			Profile:  c.Profile,
+		},
+	})
+
+	if v, err := creds.Get(); err == nil {
+		log.Printf("[INFO] AWS Auth provider used: %q", v.ProviderName)
+
+		return creds, nil
+	} else if !tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+		return nil, fmt.Errorf("error loading credentials for AWS Provider: %w", err)
+	}
+
+	options := session.Options{
+		Config: aws.Config{
+			CredentialsChainVerboseErrors: aws.Bool(true),
+			EndpointResolver:              awsbaseConfig.EndpointResolver(),
+			MaxRetries:                    aws.Int(0),
+			Region:                        aws.String(c.Region),
+		},
+		Profile:           c.Profile,
+		SharedConfigState: session.SharedConfigEnable,
+	}
+
+	// Only set the HTTP client when it has been customized, as doing so prevents
+	// the EC2 metadata client from lowering its timeout to 1 second.
+	if c.Insecure || c.CustomCABundle != "" || c.HTTPProxy != "" || c.HTTPSProxy != "" || c.NoProxy != "" {
+		options.Config.HTTPClient = httpClient
+	}
+
+	sess, err := session.NewSessionWithOptions(options)
+
+	if err != nil {
+		if tfawserr.ErrCodeEquals(err, "NoCredentialProviders") {
+			return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+		}
+
+		return nil, fmt.Errorf("error creating AWS session: %w", err)
+	}
+
+	v, err := sess.Config.Credentials.Get()
+
+	if err != nil {
+		return nil, awsbaseConfig.NewNoValidCredentialSourcesError(err)
+	}
+
+	log.Printf("[INFO] AWS Auth provider used: %q", v.ProviderName)
+
+	return sess.Config.Credentials, nil
+}
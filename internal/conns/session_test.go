@@ -0,0 +1,130 @@
+package conns
+
+import (
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	awsbase "github.com/hashicorp/aws-sdk-go-base"
+)
+
+func TestConfigCredentials_sharedConfigAssumeRole(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.Form.Get("Action") != "AssumeRole" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		fmt.Fprint(w, `<AssumeRoleResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <AssumeRoleResult>
+    <AssumedRoleUser>
+      <Arn>arn:aws:sts::123456789012:assumed-role/test/session</Arn>
+      <AssumedRoleId>AROAEXAMPLE:session</AssumedRoleId>
+    </AssumedRoleUser>
+    <Credentials>
+      <AccessKeyId>ASIAROLEACCESSKEY</AccessKeyId>
+      <SecretAccessKey>RoleSecretKey</SecretAccessKey>
+      <SessionToken>RoleSessionToken</SessionToken>
+      <Expiration>2099-01-01T00:00:00Z</Expiration>
+    </Credentials>
+  </AssumeRoleResult>
+  <ResponseMetadata>
+    <RequestId>01234567-89ab-cdef-0123-456789abcdef</RequestId>
+  </ResponseMetadata>
+</AssumeRoleResponse>`) //lintignore:AWSAT005
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	caBundle := filepath.Join(dir, "ca-bundle.pem")
+	if err := os.WriteFile(caBundle, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0600); err != nil {
+		t.Fatalf("error writing CA bundle: %s", err)
+	}
+
+	configFile := filepath.Join(dir, "config")
+	if err := os.WriteFile(configFile, []byte(`[profile source]
+aws_access_key_id = AKIASOURCEACCESSKEY
+aws_secret_access_key = SourceSecretKey
+
+[profile role]
+role_arn = arn:aws:iam::123456789012:role/test
+source_profile = source
+`), 0600); err != nil { //lintignore:AWSAT005
+		t.Fatalf("error writing shared configuration file: %s", err)
+	}
+
+	for _, envVar := range []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN", "AWS_PROFILE", CustomCABundleEnvVar, "HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy"} {
+		t.Setenv(envVar, "")
+	}
+	t.Setenv("AWS_CONFIG_FILE", configFile)
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+
+	testCases := []struct {
+		Name           string
+		CustomCABundle string
+		ExpectError    bool
+	}{
+		{
+			Name:        "no custom CA bundle",
+			ExpectError: true,
+		},
+		{
+			Name:           "custom CA bundle",
+			CustomCABundle: caBundle,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			c := &Config{
+				CredsFilename:  filepath.Join(dir, "credentials"),
+				CustomCABundle: testCase.CustomCABundle,
+				Profile:        "role",
+				Region:         "us-west-2", //lintignore:AWSAT003
+			}
+
+			awsbaseConfig := &awsbase.Config{
+				Profile:     c.Profile,
+				Region:      c.Region,
+				StsEndpoint: server.URL,
+			}
+
+			httpClient, err := c.httpClient()
+
+			if err != nil {
+				t.Fatalf("error configuring HTTP client: %s", err)
+			}
+
+			creds, err := c.credentials(awsbaseConfig, httpClient)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			v, err := creds.Get()
+
+			if err != nil {
+				t.Fatalf("error getting credentials: %s", err)
+			}
+
+			if got, expected := v.AccessKeyID, "ASIAROLEACCESSKEY"; got != expected {
+				t.Errorf("got AccessKeyID %s, expected %s", got, expected)
+			}
+		})
+	}
+}
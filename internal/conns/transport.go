@@ -0,0 +1,123 @@
+package conns
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+const (
+	// CustomCABundleEnvVar is the environment variable honored by the AWS SDK
+	// for Go when loading a custom certificate authority bundle.
+	CustomCABundleEnvVar = "AWS_CA_BUNDLE"
+)
+
+// loadCustomCABundle returns a certificate pool containing the system
+// certificate authorities plus those in the specified PEM file.
+func loadCustomCABundle(filename string) (*x509.CertPool, error) {
+	b, err := os.ReadFile(filename)
+
+	if err != nil {
+		return nil, fmt.Errorf("error reading custom CA bundle (%s): %w", filename, err)
+	}
+
+	pool, err := x509.SystemCertPool()
+
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+
+	if !pool.AppendCertsFromPEM(b) {
+		return nil, fmt.Errorf("error loading custom CA bundle (%s): no valid PEM certificates found", filename)
+	}
+
+	return pool, nil
+}
+
+// proxyFunc returns the function used to determine the proxy for a request.
+// Proxy settings are read from the standard environment variables
+// (HTTP_PROXY, HTTPS_PROXY and NO_PROXY) and overridden by any non-empty
+// provider configuration. For backwards compatibility, an HTTP proxy
+// configured without an HTTPS proxy is used for HTTPS requests too.
+func proxyFunc(httpProxy, httpsProxy, noProxy string) (func(*http.Request) (*url.URL, error), error) {
+	for _, v := range []string{httpProxy, httpsProxy} {
+		if v == "" {
+			continue
+		}
+
+		if _, err := url.Parse(v); err != nil {
+			return nil, fmt.Errorf("error parsing proxy URL (%s): %w", v, err)
+		}
+	}
+
+	config := httpproxy.FromEnvironment()
+
+	if httpProxy != "" {
+		config.HTTPProxy = httpProxy
+
+		if httpsProxy == "" {
+			config.HTTPSProxy = httpProxy
+		}
+	}
+
+	if httpsProxy != "" {
+		config.HTTPSProxy = httpsProxy
+	}
+
+	if noProxy != "" {
+		config.NoProxy = noProxy
+	}
+
+	f := config.ProxyFunc()
+
+	return func(r *http.Request) (*url.URL, error) {
+		return f(r.URL)
+	}, nil
+}
+
+// configureTransport applies the custom CA bundle and proxy configuration to
+// the specified HTTP client's transport.
+func (c *Config) configureTransport(client *http.Client) error {
+	if client == nil {
+		return nil
+	}
+
+	transport, ok := client.Transport.(*http.Transport)
+
+	if !ok {
+		return fmt.Errorf("unexpected HTTP transport type: %T", client.Transport)
+	}
+
+	if c.CustomCABundle != "" {
+		pool, err := loadCustomCABundle(c.CustomCABundle)
+
+		if err != nil {
+			return err
+		}
+
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{
+				MinVersion: tls.VersionTLS12,
+			}
+		}
+
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	if c.HTTPProxy != "" || c.HTTPSProxy != "" || c.NoProxy != "" {
+		f, err := proxyFunc(c.HTTPProxy, c.HTTPSProxy, c.NoProxy)
+
+		if err != nil {
+			return err
+		}
+
+		transport.Proxy = f
+	}
+
+	return nil
+}
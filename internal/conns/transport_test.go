@@ -0,0 +1,243 @@
+package conns
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+func TestProxyFunc(t *testing.T) {
+	for _, envVar := range []string{"HTTP_PROXY", "http_proxy", "HTTPS_PROXY", "https_proxy", "NO_PROXY", "no_proxy", "REQUEST_METHOD"} {
+		if v, ok := os.LookupEnv(envVar); ok {
+			os.Unsetenv(envVar)
+			defer os.Setenv(envVar, v)
+		}
+	}
+
+	testCases := []struct {
+		Name       string
+		HTTPProxy  string
+		HTTPSProxy string
+		NoProxy    string
+		RequestURL string
+		Expected   string
+	}{
+		{
+			Name:       "no proxy configured",
+			RequestURL: "https://ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			Expected:   "",
+		},
+		{
+			Name:       "HTTP proxy used for HTTPS requests",
+			HTTPProxy:  "http://http-proxy.example.com:3128",
+			RequestURL: "https://ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			Expected:   "http://http-proxy.example.com:3128",
+		},
+		{
+			Name:       "HTTPS proxy preferred for HTTPS requests",
+			HTTPProxy:  "http://http-proxy.example.com:3128",
+			HTTPSProxy: "http://https-proxy.example.com:3128",
+			RequestURL: "https://ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			Expected:   "http://https-proxy.example.com:3128",
+		},
+		{
+			Name:       "HTTP proxy used for HTTP requests",
+			HTTPProxy:  "http://http-proxy.example.com:3128",
+			HTTPSProxy: "http://https-proxy.example.com:3128",
+			RequestURL: "http://ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			Expected:   "http://http-proxy.example.com:3128",
+		},
+		{
+			Name:       "no proxy domain suffix",
+			HTTPSProxy: "http://https-proxy.example.com:3128",
+			NoProxy:    ".amazonaws.com",
+			RequestURL: "https://ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			Expected:   "",
+		},
+		{
+			Name:       "no proxy other domain",
+			HTTPSProxy: "http://https-proxy.example.com:3128",
+			NoProxy:    "internal.example.com",
+			RequestURL: "https://ec2.us-west-2.amazonaws.com", //lintignore:AWSAT003
+			Expected:   "http://https-proxy.example.com:3128",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			f, err := proxyFunc(testCase.HTTPProxy, testCase.HTTPSProxy, testCase.NoProxy)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			requestURL, err := url.Parse(testCase.RequestURL)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := f(&http.Request{URL: requestURL})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var gotURL string
+			if got != nil {
+				gotURL = got.String()
+			}
+
+			if gotURL != testCase.Expected {
+				t.Errorf("got %q, expected %q", gotURL, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestConfigConfigureTransport(t *testing.T) {
+	dir := t.TempDir()
+
+	validBundle := filepath.Join(dir, "valid.pem")
+	if err := os.WriteFile(validBundle, testCertificatePEM(t), 0600); err != nil {
+		t.Fatalf("error writing CA bundle: %s", err)
+	}
+
+	invalidBundle := filepath.Join(dir, "invalid.pem")
+	if err := os.WriteFile(invalidBundle, []byte("not a certificate"), 0600); err != nil {
+		t.Fatalf("error writing CA bundle: %s", err)
+	}
+
+	testCases := []struct {
+		Name          string
+		Config        *Config
+		ExpectError   bool
+		ExpectCAs     bool
+		ExpectedProxy string
+	}{
+		{
+			Name:   "defaults",
+			Config: &Config{},
+		},
+		{
+			Name: "custom CA bundle",
+			Config: &Config{
+				CustomCABundle: validBundle,
+			},
+			ExpectCAs: true,
+		},
+		{
+			Name: "invalid custom CA bundle",
+			Config: &Config{
+				CustomCABundle: invalidBundle,
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "missing custom CA bundle",
+			Config: &Config{
+				CustomCABundle: filepath.Join(dir, "missing.pem"),
+			},
+			ExpectError: true,
+		},
+		{
+			Name: "HTTPS proxy",
+			Config: &Config{
+				HTTPSProxy: "http://https-proxy.example.com:3128",
+				NoProxy:    "169.254.169.254",
+			},
+			ExpectedProxy: "http://https-proxy.example.com:3128",
+		},
+		{
+			Name: "invalid HTTPS proxy",
+			Config: &Config{
+				HTTPSProxy: "http://invalid proxy:3128",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			client := cleanhttp.DefaultClient()
+
+			err := testCase.Config.configureTransport(client)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			transport := client.Transport.(*http.Transport)
+
+			if got := transport.TLSClientConfig != nil && transport.TLSClientConfig.RootCAs != nil; got != testCase.ExpectCAs {
+				t.Errorf("got custom root CAs %t, expected %t", got, testCase.ExpectCAs)
+			}
+
+			if testCase.ExpectedProxy == "" {
+				return
+			}
+
+			requestURL, err := url.Parse("https://sts.amazonaws.com")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, err := transport.Proxy(&http.Request{URL: requestURL})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got == nil || got.String() != testCase.ExpectedProxy {
+				t.Errorf("got proxy %v, expected %s", got, testCase.ExpectedProxy)
+			}
+		})
+	}
+}
+
+func testCertificatePEM(t *testing.T) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+
+	if err != nil {
+		t.Fatalf("error generating key: %s", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+
+	if err != nil {
+		t.Fatalf("error creating certificate: %s", err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
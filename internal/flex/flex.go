@@ -52,6 +52,11 @@ func ExpandStringSet(configured *schema.Set) []*string {
 	return ExpandStringList(configured.List()) // nosemgrep: helper-schema-Set-extraneous-ExpandStringList-with-List
 }
 
+// Takes the result of schema.Set of strings and returns a []string
+func ExpandStringValueSet(configured *schema.Set) []string {
+	return aws.StringValueSlice(ExpandStringSet(configured))
+}
+
 func FlattenStringSet(list []*string) *schema.Set {
 	return schema.NewSet(schema.HashString, FlattenStringList(list)) // nosemgrep: helper-schema-Set-extraneous-NewSet-with-FlattenStringList
 }
@@ -0,0 +1,535 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	filename = "find_gen.go"
+
+	sdkServicePackagePrefix = "github.com/aws/aws-sdk-go/service/"
+)
+
+var (
+	listOps    = flag.String("ListOps", "", "ListOps")
+	paginator  = flag.String("Paginator", "NextToken", "name of the pagination token field")
+	export     = flag.Bool("Export", false, "whether to export the finder functions")
+	awsService = flag.String("AWSService", "", "name of the AWS Go SDK service package, if not imported by exactly one file")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	Parameters         string
+	DestinationPackage string
+
+	Finders []FinderSpec
+	Imports []string
+}
+
+// FinderSpec describes the finders generated for an AWS Go SDK List/Describe operation.
+type FinderSpec struct {
+	FindAllName string
+	FindOneName string
+
+	AWSName    string
+	RecvType   string
+	ParamType  string
+	ResultType string
+	ElemType   string
+	Field      string
+
+	PagesFunc string
+	Paginator string
+
+	NotFoundErrorCodes []string
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *listOps == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	destinationPackage, sourcePackage, err := packageNames(*awsService)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	g := Generator{
+		paginator: *paginator,
+	}
+
+	g.parsePackage(sourcePackage)
+
+	templateData := TemplateData{
+		Parameters:         strings.Join(os.Args[1:], " "),
+		DestinationPackage: destinationPackage,
+	}
+
+	ops := strings.Split(*listOps, ",")
+	sort.Strings(ops)
+
+	imports := map[string]struct{}{
+		sourcePackage: {},
+		"github.com/hashicorp/terraform-provider-aws/internal/tfresource": {},
+	}
+
+	for _, op := range ops {
+		finder := g.finderSpec(op, *export)
+
+		if len(finder.NotFoundErrorCodes) > 0 {
+			imports["github.com/hashicorp/aws-sdk-go-base/tfawserr"] = struct{}{}
+			imports["github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"] = struct{}{}
+		}
+
+		if finder.PagesFunc == "" && finder.Paginator != "" {
+			imports["github.com/aws/aws-sdk-go/aws"] = struct{}{}
+		}
+
+		templateData.Finders = append(templateData.Finders, finder)
+	}
+
+	for path := range imports {
+		templateData.Imports = append(templateData.Imports, path)
+	}
+
+	sort.Strings(templateData.Imports)
+
+	tmpl := template.Must(template.New("finders").Parse(finderTemplate))
+
+	if err := tmpl.Execute(&g.buf, templateData); err != nil {
+		log.Fatalf("error writing finders: %s", err)
+	}
+
+	src := g.format()
+
+	err = os.WriteFile(filename, src, 0644)
+	if err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+// packageNames returns the name of the package in the working directory and the
+// import path of the AWS Go SDK service package that it uses.
+func packageNames(awsService string) (string, string, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != filename
+	}, parser.ImportsOnly)
+
+	if err != nil {
+		return "", "", fmt.Errorf("parsing package: %w", err)
+	}
+
+	if len(pkgs) != 1 {
+		return "", "", fmt.Errorf("%d packages found", len(pkgs))
+	}
+
+	var destinationPackage string
+	imports := make(map[string]struct{})
+
+	for name, pkg := range pkgs {
+		destinationPackage = name
+
+		for _, file := range pkg.Files {
+			for _, spec := range file.Imports {
+				path, err := strconv.Unquote(spec.Path.Value)
+
+				if err != nil {
+					continue
+				}
+
+				if strings.HasPrefix(path, sdkServicePackagePrefix) {
+					imports[path] = struct{}{}
+				}
+			}
+		}
+	}
+
+	if awsService != "" {
+		return destinationPackage, sdkServicePackagePrefix + awsService, nil
+	}
+
+	if len(imports) != 1 {
+		return "", "", fmt.Errorf("%d AWS Go SDK service packages imported, use -AWSService", len(imports))
+	}
+
+	for path := range imports {
+		return destinationPackage, path, nil
+	}
+
+	return "", "", nil
+}
+
+type Generator struct {
+	buf       bytes.Buffer
+	pkg       *Package
+	paginator string
+}
+
+type Package struct {
+	name    string
+	methods map[string]*ast.FuncDecl
+	types   map[string]*ast.TypeSpec
+	consts  map[string]struct{}
+}
+
+func (g *Generator) parsePackage(sourcePackage string) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax,
+	}
+	pkgs, err := packages.Load(cfg, sourcePackage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+	g.addPackage(pkgs[0])
+}
+
+func (g *Generator) addPackage(pkg *packages.Package) {
+	g.pkg = &Package{
+		name:    pkg.Name,
+		methods: make(map[string]*ast.FuncDecl),
+		types:   make(map[string]*ast.TypeSpec),
+		consts:  make(map[string]struct{}),
+	}
+
+	for _, file := range pkg.Syntax {
+		for _, decl := range file.Decls {
+			switch decl := decl.(type) {
+			case *ast.FuncDecl:
+				if decl.Recv != nil {
+					g.pkg.methods[decl.Name.Name] = decl
+				}
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						g.pkg.types[spec.Name.Name] = spec
+					case *ast.ValueSpec:
+						if decl.Tok == token.CONST {
+							for _, name := range spec.Names {
+								g.pkg.consts[name.Name] = struct{}{}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+// finderSpec returns the finders for an operation specified as
+// <function-name>[.<field-name>][:<error-code>[|<error-code>]].
+func (g *Generator) finderSpec(op string, export bool) FinderSpec {
+	var codes []string
+
+	if parts := strings.SplitN(op, ":", 2); len(parts) == 2 {
+		op = parts[0]
+		codes = strings.Split(parts[1], "|")
+	}
+
+	var field string
+
+	if parts := strings.SplitN(op, ".", 2); len(parts) == 2 {
+		op = parts[0]
+		field = parts[1]
+	}
+
+	function, ok := g.pkg.methods[op]
+
+	if !ok {
+		log.Fatalf("function \"%s\" not found", op)
+	}
+
+	resultTypeName := g.typeName(function.Type.Results)
+	field, elemTypeName := g.itemsField(resultTypeName, field)
+
+	spec := FinderSpec{
+		AWSName:    op,
+		RecvType:   g.expandTypeField(function.Recv),
+		ParamType:  g.expandTypeField(function.Type.Params),  // Assumes there is a single input parameter
+		ResultType: g.expandTypeField(function.Type.Results), // Assumes we can take the first return parameter
+		ElemType:   fmt.Sprintf("*%s.%s", g.pkg.name, elemTypeName),
+		Field:      field,
+	}
+
+	if _, ok := g.pkg.methods[op+"PagesWithContext"]; ok {
+		spec.PagesFunc = op + "PagesWithContext"
+	} else if g.hasField(g.typeName(function.Type.Params), g.paginator) && g.hasField(resultTypeName, g.paginator) {
+		spec.Paginator = g.paginator
+	}
+
+	for _, code := range codes {
+		if code = strings.TrimSpace(code); code == "" {
+			continue
+		}
+
+		if _, ok := g.pkg.consts[code]; ok {
+			spec.NotFoundErrorCodes = append(spec.NotFoundErrorCodes, fmt.Sprintf("%s.%s", g.pkg.name, code))
+		} else {
+			spec.NotFoundErrorCodes = append(spec.NotFoundErrorCodes, strconv.Quote(code))
+		}
+	}
+
+	findAllName := fmt.Sprintf("Find%s", field)
+	findOneName := fmt.Sprintf("Find%s", elemTypeName)
+
+	if findAllName == findOneName {
+		findAllName = fmt.Sprintf("%sList", findAllName)
+	}
+
+	if !export {
+		findAllName = fmt.Sprintf("%s%s", strings.ToLower(findAllName[0:1]), findAllName[1:])
+		findOneName = fmt.Sprintf("%s%s", strings.ToLower(findOneName[0:1]), findOneName[1:])
+	}
+
+	spec.FindAllName = findAllName
+	spec.FindOneName = findOneName
+
+	return spec
+}
+
+// itemsField returns the name of the output field containing the returned items
+// and the items' type name. If no field name is specified, the output must contain
+// exactly one slice of structure pointers.
+func (g *Generator) itemsField(typeName, fieldName string) (string, string) {
+	structType := g.structType(typeName)
+	var candidates [][2]string
+
+	for _, f := range structType.Fields.List {
+		array, ok := f.Type.(*ast.ArrayType)
+
+		if !ok {
+			continue
+		}
+
+		star, ok := array.Elt.(*ast.StarExpr)
+
+		if !ok {
+			continue
+		}
+
+		ident, ok := star.X.(*ast.Ident)
+
+		if !ok {
+			continue
+		}
+
+		if _, ok := g.pkg.types[ident.Name]; !ok {
+			continue
+		}
+
+		for _, name := range f.Names {
+			if fieldName == "" || name.Name == fieldName {
+				candidates = append(candidates, [2]string{name.Name, ident.Name})
+			}
+		}
+	}
+
+	if len(candidates) != 1 {
+		if fieldName != "" {
+			log.Fatalf("field \"%s\" of \"%s\" is not a slice of structures", fieldName, typeName)
+		}
+
+		log.Fatalf("%d slices of structures found in \"%s\", specify the field name", len(candidates), typeName)
+	}
+
+	return candidates[0][0], candidates[0][1]
+}
+
+func (g *Generator) hasField(typeName, fieldName string) bool {
+	for _, f := range g.structType(typeName).Fields.List {
+		for _, name := range f.Names {
+			if name.Name == fieldName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (g *Generator) structType(typeName string) *ast.StructType {
+	spec, ok := g.pkg.types[typeName]
+
+	if !ok {
+		log.Fatalf("type \"%s\" not found", typeName)
+	}
+
+	structType, ok := spec.Type.(*ast.StructType)
+
+	if !ok {
+		log.Fatalf("type \"%s\" is not a structure", typeName)
+	}
+
+	return structType
+}
+
+func (g *Generator) typeName(field *ast.FieldList) string {
+	typeValue := field.List[0].Type
+	if star, ok := typeValue.(*ast.StarExpr); ok {
+		if ident, ok := star.X.(*ast.Ident); ok {
+			return ident.Name
+		}
+	}
+
+	log.Fatalf("Unexpected type expression: (%[1]T) %[1]v", typeValue)
+	return ""
+}
+
+func (g *Generator) expandTypeField(field *ast.FieldList) string {
+	return fmt.Sprintf("*%s.%s", g.pkg.name, g.typeName(field))
+}
+
+const finderTemplate = `// Code generated by "internal/generate/finder/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .DestinationPackage }}
+
+import (
+	"context"
+
+{{ range .Imports }}	"{{ . }}"
+{{ end }})
+{{ range .Finders }}
+// {{ .FindAllName }} returns all the items returned by {{ .AWSName }} for which filter, if not nil, returns true.
+func {{ .FindAllName }}(conn {{ .RecvType }}, input {{ .ParamType }}, filter func({{ .ElemType }}) bool) ([]{{ .ElemType }}, error) {
+	return {{ .FindAllName }}WithContext(context.Background(), conn, input, filter)
+}
+
+func {{ .FindAllName }}WithContext(ctx context.Context, conn {{ .RecvType }}, input {{ .ParamType }}, filter func({{ .ElemType }}) bool) ([]{{ .ElemType }}, error) {
+	var output []{{ .ElemType }}
+
+{{- if .PagesFunc }}
+
+	err := conn.{{ .PagesFunc }}(ctx, input, func(page {{ .ResultType }}, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.{{ .Field }} {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+{{- else if .Paginator }}
+
+	var err error
+
+	for {
+		var page {{ .ResultType }}
+
+		page, err = conn.{{ .AWSName }}WithContext(ctx, input)
+
+		if err != nil || page == nil {
+			break
+		}
+
+		for _, v := range page.{{ .Field }} {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		if aws.StringValue(page.{{ .Paginator }}) == "" {
+			break
+		}
+
+		input.{{ .Paginator }} = page.{{ .Paginator }}
+	}
+{{- else }}
+
+	page, err := conn.{{ .AWSName }}WithContext(ctx, input)
+
+	if err == nil && page != nil {
+		for _, v := range page.{{ .Field }} {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+	}
+{{- end }}
+{{- if .NotFoundErrorCodes }}
+
+	if tfawserr.ErrCodeEquals(err, {{ range $i, $code := .NotFoundErrorCodes }}{{ if $i }}, {{ end }}{{ $code }}{{ end }}) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+{{- end }}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// {{ .FindOneName }} returns the single item returned by {{ .AWSName }} for which filter, if not nil, returns true.
+// Returns NotFoundError if there is not exactly one such item.
+func {{ .FindOneName }}(conn {{ .RecvType }}, input {{ .ParamType }}, filter func({{ .ElemType }}) bool) ({{ .ElemType }}, error) {
+	return {{ .FindOneName }}WithContext(context.Background(), conn, input, filter)
+}
+
+func {{ .FindOneName }}WithContext(ctx context.Context, conn {{ .RecvType }}, input {{ .ParamType }}, filter func({{ .ElemType }}) bool) ({{ .ElemType }}, error) {
+	output, err := {{ .FindAllName }}WithContext(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+{{ end }}`
+
+func (g *Generator) format() []byte {
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		return g.buf.Bytes()
+	}
+	return src
+}
@@ -515,7 +515,6 @@ func init() {
 	awsServiceNames["lookoutforvision"] = "LookoutForVision"
 	awsServiceNames["lookoutmetrics"] = "LookoutMetrics"
 	awsServiceNames["machinelearning"] = "MachineLearning"
-	awsServiceNames["macie"] = "Macie"
 	awsServiceNames["macie2"] = "Macie2"
 	awsServiceNames["managedblockchain"] = "ManagedBlockchain"
 	awsServiceNames["marketplacecatalog"] = "MarketplaceCatalog"
@@ -543,6 +542,7 @@ func init() {
 	awsServiceNames["networkfirewall"] = "NetworkFirewall"
 	awsServiceNames["networkmanager"] = "NetworkManager"
 	awsServiceNames["nimblestudio"] = "NimbleStudio"
+	awsServiceNames["opensearchservice"] = "OpenSearchService"
 	awsServiceNames["opsworks"] = "OpsWorks"
 	awsServiceNames["opsworkscm"] = "OpsWorksCM"
 	awsServiceNames["organizations"] = "Organizations"
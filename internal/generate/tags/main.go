@@ -1022,7 +1022,6 @@ func init() {
 	awsServiceNames["lookoutforvision"] = "LookoutForVision"
 	awsServiceNames["lookoutmetrics"] = "LookoutMetrics"
 	awsServiceNames["machinelearning"] = "MachineLearning"
-	awsServiceNames["macie"] = "Macie"
 	awsServiceNames["macie2"] = "Macie2"
 	awsServiceNames["managedblockchain"] = "ManagedBlockchain"
 	awsServiceNames["marketplacecatalog"] = "MarketplaceCatalog"
@@ -1050,6 +1049,7 @@ func init() {
 	awsServiceNames["networkfirewall"] = "NetworkFirewall"
 	awsServiceNames["networkmanager"] = "NetworkManager"
 	awsServiceNames["nimblestudio"] = "NimbleStudio"
+	awsServiceNames["opensearchservice"] = "OpenSearchService"
 	awsServiceNames["opsworks"] = "OpsWorks"
 	awsServiceNames["opsworkscm"] = "OpsWorksCM"
 	awsServiceNames["organizations"] = "Organizations"
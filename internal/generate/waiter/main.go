@@ -0,0 +1,479 @@
+//go:build ignore
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+var (
+	name                      = flag.String("Name", "", "name of the resource, e.g. Cluster")
+	finder                    = flag.String("Finder", "", "name of the finder function")
+	statusField               = flag.String("StatusField", "", "name of the status field")
+	statusReasonField         = flag.String("StatusReasonField", "", "name of the status reason field")
+	createPending             = flag.String("CreatePending", "", "comma-separated list of pending states while creating")
+	createTarget              = flag.String("CreateTarget", "", "comma-separated list of target states when created")
+	updatePending             = flag.String("UpdatePending", "", "comma-separated list of pending states while updating")
+	updateTarget              = flag.String("UpdateTarget", "", "comma-separated list of target states when updated")
+	deletePending             = flag.String("DeletePending", "", "comma-separated list of pending states while deleting")
+	delay                     = flag.Duration("Delay", 0, "time to wait before starting checks")
+	minTimeout                = flag.Duration("MinTimeout", 0, "smallest time to wait between checks")
+	pollInterval              = flag.Duration("PollInterval", 0, "time to wait between checks, overriding MinTimeout and backoff")
+	continuousTargetOccurence = flag.Int("ContinuousTargetOccurence", 0, "number of times the target state has to occur continuously")
+	export                    = flag.Bool("Export", false, "whether to export the generated functions")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go [flags]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	Parameters string
+	Package    string
+	StdImports []string
+	Imports    []string
+
+	StatusFunc        string
+	Finder            string
+	ConnType          string
+	Params            []Param
+	ResultType        string
+	StatusField       string
+	StatusReasonField string
+
+	Waiters  []Waiter
+	WaitOpts []string
+}
+
+type Param struct {
+	Name string
+	Type string
+}
+
+type Waiter struct {
+	Name    string
+	State   string
+	Pending []string
+	Target  []string
+	Deleted bool
+}
+
+func main() {
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *name == "" || *finder == "" || *statusField == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != outputFilename(*name)
+	}, 0)
+
+	if err != nil {
+		log.Fatalf("error parsing package: %s", err)
+	}
+
+	if len(pkgs) != 1 {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+
+	var pkg *ast.Package
+	for _, v := range pkgs {
+		pkg = v
+	}
+
+	funcDecl, file := findFunc(pkg, *finder)
+
+	if funcDecl == nil {
+		log.Fatalf("function \"%s\" not found", *finder)
+	}
+
+	params := funcDecl.Type.Params.List
+	results := funcDecl.Type.Results
+
+	if len(params) == 0 || results == nil || len(results.List) != 2 {
+		log.Fatalf("function \"%s\" must have signature func(conn, ...) (result, error)", *finder)
+	}
+
+	consts := packageConsts(pkg)
+
+	templateData := TemplateData{
+		Parameters:        strings.Join(os.Args[1:], " "),
+		Package:           pkg.Name,
+		StatusFunc:        funcName("status" + *name),
+		Finder:            *finder,
+		ConnType:          types.ExprString(params[0].Type),
+		ResultType:        types.ExprString(results.List[0].Type),
+		StatusField:       *statusField,
+		StatusReasonField: *statusReasonField,
+	}
+
+	imports := map[string]struct{}{
+		"context":                       {},
+		"time":                          {},
+		"github.com/aws/aws-sdk-go/aws": {},
+		"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource":    {},
+		"github.com/hashicorp/terraform-provider-aws/internal/tfresource": {},
+	}
+
+	if *statusReasonField != "" {
+		imports["errors"] = struct{}{}
+	}
+
+	typeExprs := []ast.Expr{params[0].Type, results.List[0].Type}
+
+	for i, field := range params {
+		if i == 0 && len(field.Names) <= 1 {
+			continue
+		}
+
+		for j, ident := range field.Names {
+			if i == 0 && j == 0 {
+				continue
+			}
+
+			templateData.Params = append(templateData.Params, Param{
+				Name: ident.Name,
+				Type: types.ExprString(field.Type),
+			})
+		}
+
+		typeExprs = append(typeExprs, field.Type)
+	}
+
+	for _, path := range referencedImports(file, typeExprs) {
+		imports[path] = struct{}{}
+	}
+
+	for path := range imports {
+		// Standard library import paths have no dot in the first element.
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			templateData.Imports = append(templateData.Imports, path)
+		} else {
+			templateData.StdImports = append(templateData.StdImports, path)
+		}
+	}
+
+	sort.Strings(templateData.StdImports)
+	sort.Strings(templateData.Imports)
+
+	if *createTarget != "" {
+		templateData.Waiters = append(templateData.Waiters, Waiter{
+			Name:    funcName(fmt.Sprintf("wait%sCreated", *name)),
+			State:   "created",
+			Pending: stateExprs(*createPending, consts),
+			Target:  stateExprs(*createTarget, consts),
+		})
+	}
+
+	if *updateTarget != "" {
+		templateData.Waiters = append(templateData.Waiters, Waiter{
+			Name:    funcName(fmt.Sprintf("wait%sUpdated", *name)),
+			State:   "updated",
+			Pending: stateExprs(*updatePending, consts),
+			Target:  stateExprs(*updateTarget, consts),
+		})
+	}
+
+	if *deletePending != "" {
+		templateData.Waiters = append(templateData.Waiters, Waiter{
+			Name:    funcName(fmt.Sprintf("wait%sDeleted", *name)),
+			State:   "deleted",
+			Pending: stateExprs(*deletePending, consts),
+			Deleted: true,
+		})
+	}
+
+	if *continuousTargetOccurence > 0 {
+		templateData.WaitOpts = append(templateData.WaitOpts, fmt.Sprintf("ContinuousTargetOccurence: %d", *continuousTargetOccurence))
+	}
+
+	if *delay > 0 {
+		templateData.WaitOpts = append(templateData.WaitOpts, fmt.Sprintf("Delay: %s", durationExpr(*delay)))
+	}
+
+	if *minTimeout > 0 {
+		templateData.WaitOpts = append(templateData.WaitOpts, fmt.Sprintf("MinTimeout: %s", durationExpr(*minTimeout)))
+	}
+
+	if *pollInterval > 0 {
+		templateData.WaitOpts = append(templateData.WaitOpts, fmt.Sprintf("PollInterval: %s", durationExpr(*pollInterval)))
+	}
+
+	var buf bytes.Buffer
+	tmpl := template.Must(template.New("waiter").Parse(waiterTemplate))
+
+	if err := tmpl.Execute(&buf, templateData); err != nil {
+		log.Fatalf("error executing template: %s", err)
+	}
+
+	src, err := format.Source(buf.Bytes())
+
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		src = buf.Bytes()
+	}
+
+	if err := os.WriteFile(outputFilename(*name), src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+var snakeCaseRegexp = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// outputFilename returns the name of the generated file, e.g. cluster_wait_gen.go.
+func outputFilename(name string) string {
+	return fmt.Sprintf("%s_wait_gen.go", strings.ToLower(snakeCaseRegexp.ReplaceAllString(name, "${1}_${2}")))
+}
+
+func funcName(name string) string {
+	if *export {
+		return strings.ToUpper(name[0:1]) + name[1:]
+	}
+
+	return name
+}
+
+func findFunc(pkg *ast.Package, name string) (*ast.FuncDecl, *ast.File) {
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Name.Name == name {
+				return funcDecl, file
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func packageConsts(pkg *ast.Package) map[string]struct{} {
+	consts := make(map[string]struct{})
+
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			if genDecl, ok := decl.(*ast.GenDecl); ok && genDecl.Tok == token.CONST {
+				for _, spec := range genDecl.Specs {
+					for _, ident := range spec.(*ast.ValueSpec).Names {
+						consts[ident.Name] = struct{}{}
+					}
+				}
+			}
+		}
+	}
+
+	return consts
+}
+
+// referencedImports returns the import paths of the packages referenced by the type expressions.
+func referencedImports(file *ast.File, exprs []ast.Expr) []string {
+	names := make(map[string]struct{})
+
+	for _, expr := range exprs {
+		ast.Inspect(expr, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if ident, ok := sel.X.(*ast.Ident); ok {
+					names[ident.Name] = struct{}{}
+				}
+			}
+
+			return true
+		})
+	}
+
+	var paths []string
+
+	for _, spec := range file.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+
+		if err != nil {
+			continue
+		}
+
+		name := path[strings.LastIndex(path, "/")+1:]
+
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		if _, ok := names[name]; ok {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+// stateExprs returns the Go expressions for the comma-separated states.
+// Package constants and qualified identifiers are used as-is, anything else is quoted.
+func stateExprs(s string, consts map[string]struct{}) []string {
+	var exprs []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v == "" {
+			continue
+		}
+
+		if _, ok := consts[v]; ok || strings.Contains(v, ".") && token.IsIdentifier(v[strings.LastIndex(v, ".")+1:]) {
+			exprs = append(exprs, v)
+		} else {
+			exprs = append(exprs, strconv.Quote(v))
+		}
+	}
+
+	return exprs
+}
+
+func durationExpr(d time.Duration) string {
+	for _, unit := range []struct {
+		d    time.Duration
+		name string
+	}{
+		{time.Hour, "time.Hour"},
+		{time.Minute, "time.Minute"},
+		{time.Second, "time.Second"},
+		{time.Millisecond, "time.Millisecond"},
+	} {
+		if d%unit.d == 0 {
+			return fmt.Sprintf("%d * %s", d/unit.d, unit.name)
+		}
+	}
+
+	return fmt.Sprintf("%d", d)
+}
+
+const waiterTemplate = `// Code generated by "internal/generate/waiter/main.go {{ .Parameters }}"; DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+{{ range .StdImports }}	"{{ . }}"
+{{ end }}
+{{ range .Imports }}	"{{ . }}"
+{{ end }})
+
+// {{ .StatusFunc }} fetches the resource and its {{ .StatusField }}.
+// Returns a nil result if the resource is not found.
+func {{ .StatusFunc }}(conn {{ .ConnType }}{{ range .Params }}, {{ .Name }} {{ .Type }}{{ end }}) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := {{ .Finder }}(conn{{ range .Params }}, {{ .Name }}{{ end }})
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.{{ .StatusField }}), nil
+	}
+}
+{{ range .Waiters }}
+// {{ .Name }} waits for the resource to be {{ .State }}.
+func {{ .Name }}(conn {{ $.ConnType }}{{ range $.Params }}, {{ .Name }} {{ .Type }}{{ end }}, timeout time.Duration) ({{ $.ResultType }}, error) {
+	return {{ .Name }}WithContext(context.Background(), conn{{ range $.Params }}, {{ .Name }}{{ end }}, timeout)
+}
+
+func {{ .Name }}WithContext(ctx context.Context, conn {{ $.ConnType }}{{ range $.Params }}, {{ .Name }} {{ .Type }}{{ end }}, timeout time.Duration) ({{ $.ResultType }}, error) {
+	pending := []string{ {{- range $i, $v := .Pending }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}} }
+{{- if not .Deleted }}
+	target := []string{ {{- range $i, $v := .Target }}{{ if $i }}, {{ end }}{{ $v }}{{ end -}} }
+{{- end }}
+
+	var output {{ $.ResultType }}
+{{- if not .Deleted }}
+	var notFoundTick int
+{{- end }}
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := {{ $.StatusFunc }}(conn{{ range $.Params }}, {{ .Name }}{{ end }})()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+{{- if .Deleted }}
+			output = nil
+
+			return true, nil
+{{- else }}
+			// Allow for eventual consistency, as resource.StateChangeConf does.
+			if notFoundTick++; notFoundTick > 20 {
+				return false, &resource.NotFoundError{Retries: notFoundTick}
+			}
+
+			return false, nil
+{{- end }}
+		}
+
+{{- if not .Deleted }}
+
+		notFoundTick = 0
+{{- end }}
+
+		output = outputRaw.({{ $.ResultType }})
+{{- if not .Deleted }}
+
+		for _, v := range target {
+			if status == v {
+				return true, nil
+			}
+		}
+{{- end }}
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+{{- if .Deleted }}
+			ExpectedState: pending,
+{{- else }}
+			ExpectedState: target,
+{{- end }}
+		}
+	}, tfresource.WaitOpts{
+{{- range $.WaitOpts }}
+		{{ . }},
+{{- end }}
+	})
+{{- if $.StatusReasonField }}
+
+	if err != nil && output != nil {
+		if reason := aws.StringValue(output.{{ $.StatusReasonField }}); reason != "" {
+			tfresource.SetLastError(err, errors.New(reason))
+		}
+	}
+{{- end }}
+
+	return output, err
+}
+{{ end }}`
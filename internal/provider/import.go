@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// resourceARNImportIDs maps resource types that can also be imported by ARN
+// to the function that converts the resource ARN into the resource's import ID.
+// Resources whose import ID is already the ARN are not listed.
+var resourceARNImportIDs = map[string]verify.ARNImportIDFunc{
+	"aws_cloudwatch_log_group": logGroupARNImportID,
+	"aws_dynamodb_table":       verify.ARNImportIDResource("dynamodb", "table/"),
+	"aws_ebs_volume":           verify.ARNImportIDResource("ec2", "volume/"),
+	"aws_ecr_repository":       verify.ARNImportIDResource("ecr", "repository/"),
+	"aws_ecs_cluster":          verify.ARNImportIDResource("ecs", "cluster/"),
+	"aws_iam_group":            verify.ARNImportIDResourceName("iam", "group/"),
+	"aws_iam_instance_profile": verify.ARNImportIDResourceName("iam", "instance-profile/"),
+	"aws_iam_role":             verify.ARNImportIDResourceName("iam", "role/"),
+	"aws_iam_user":             verify.ARNImportIDResourceName("iam", "user/"),
+	"aws_instance":             verify.ARNImportIDResource("ec2", "instance/"),
+	"aws_internet_gateway":     verify.ARNImportIDResource("ec2", "internet-gateway/"),
+	"aws_kinesis_stream":       verify.ARNImportIDResource("kinesis", "stream/"),
+	"aws_kms_key":              verify.ARNImportIDResource("kms", "key/"),
+	"aws_lambda_function":      lambdaFunctionARNImportID,
+	"aws_launch_template":      verify.ARNImportIDResource("ec2", "launch-template/"),
+	"aws_nat_gateway":          verify.ARNImportIDResource("ec2", "natgateway/"),
+	"aws_network_interface":    verify.ARNImportIDResource("ec2", "network-interface/"),
+	"aws_route_table":          verify.ARNImportIDResource("ec2", "route-table/"),
+	"aws_s3_bucket":            s3BucketARNImportID,
+	"aws_security_group":       verify.ARNImportIDResource("ec2", "security-group/"),
+	"aws_sqs_queue":            sqsQueueARNImportID,
+	"aws_subnet":               verify.ARNImportIDResource("ec2", "subnet/"),
+	"aws_vpc":                  verify.ARNImportIDResource("ec2", "vpc/"),
+}
+
+// wrapImporterForARN allows the resource to be imported by ARN as well as by its import ID.
+func wrapImporterForARN(r *schema.Resource, f verify.ARNImportIDFunc) {
+	next := r.Importer.StateContext
+
+	if next == nil {
+		if state := r.Importer.State; state != nil {
+			next = func(_ context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				return state(d, meta)
+			}
+		} else {
+			next = schema.ImportStatePassthroughContext
+		}
+	}
+
+	r.Importer = &schema.ResourceImporter{
+		StateContext: verify.ImportByARN(f, next),
+	}
+}
+
+// logGroupARNImportID handles both the "log-group:<name>" and "log-group:<name>:*" ARN forms.
+func logGroupARNImportID(v arn.ARN, meta interface{}) (string, error) {
+	v.Resource = strings.TrimSuffix(v.Resource, ":*")
+
+	return verify.ARNImportIDResource("logs", "log-group:")(v, meta)
+}
+
+// lambdaFunctionARNImportID rejects qualified function ARNs, as a version or alias
+// is not part of the function resource.
+func lambdaFunctionARNImportID(v arn.ARN, meta interface{}) (string, error) {
+	id, err := verify.ARNImportIDResource("lambda", "function:")(v, meta)
+
+	if err != nil {
+		return "", err
+	}
+
+	if strings.Contains(id, ":") {
+		return "", fmt.Errorf("unexpected qualified function ARN (%s), expected unqualified function ARN", v)
+	}
+
+	return id, nil
+}
+
+// s3BucketARNImportID rejects S3 object ARNs.
+func s3BucketARNImportID(v arn.ARN, meta interface{}) (string, error) {
+	if strings.Contains(v.Resource, "/") {
+		return "", fmt.Errorf("unexpected resource (%s), expected bucket name", v.Resource)
+	}
+
+	return verify.ARNImportIDResource("s3", "")(v, meta)
+}
+
+// sqsQueueARNImportID converts the queue ARN into the queue URL.
+func sqsQueueARNImportID(v arn.ARN, meta interface{}) (string, error) {
+	name, err := verify.ARNImportIDResource("sqs", "")(v, meta)
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("https://%s/%s/%s", meta.(*conns.AWSClient).RegionalHostname("sqs"), v.AccountID, name), nil
+}
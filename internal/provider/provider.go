@@ -1,12 +1,15 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"log"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/accessanalyzer"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/account"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/acm"
@@ -97,7 +100,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lexmodels"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/lightsail"
-	"github.com/hashicorp/terraform-provider-aws/internal/service/macie"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/macie2"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediaconvert"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mediapackage"
@@ -108,6 +110,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/mwaa"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/neptune"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/networkfirewall"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/networkmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/opensearch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/opsworks"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/outposts"
@@ -246,6 +250,12 @@ func Provider() *schema.Provider {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types to which the default tags are not applied",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -262,6 +272,33 @@ func Provider() *schema.Provider {
 				Description: descriptions["http_proxy"],
 			},
 
+			"https_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["https_proxy"],
+			},
+
+			"no_proxy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: descriptions["no_proxy"],
+			},
+
+			"custom_ca_bundle": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc(conns.CustomCABundleEnvVar, nil),
+				Description: descriptions["custom_ca_bundle"],
+			},
+
+			"retry_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("AWS_RETRY_MODE", conns.RetryModeStandard),
+				ValidateFunc: validation.StringInSlice(conns.RetryMode_Values(), false),
+				Description:  descriptions["retry_mode"],
+			},
+
 			"endpoints": endpointsSchema(),
 
 			"ignore_tags": {
@@ -296,6 +333,48 @@ func Provider() *schema.Provider {
 				Description: descriptions["insecure"],
 			},
 
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with rules that resource tags are validated against at plan time.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Description: "Values permitted for a tag key",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"values": {
+										Type:     schema.TypeSet,
+										Required: true,
+										MinItems: 1,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"exclude_resource_types": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types that are not validated",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Tag keys that every resource must have",
+						},
+					},
+				},
+			},
+
 			"skip_credentials_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -368,7 +447,8 @@ func Provider() *schema.Provider {
 			"aws_batch_compute_environment": batch.DataSourceComputeEnvironment(),
 			"aws_batch_job_queue":           batch.DataSourceJobQueue(),
 
-			"aws_cloudcontrolapi_resource": cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resource":  cloudcontrol.DataSourceResource(),
+			"aws_cloudcontrolapi_resources": cloudcontrol.DataSourceResources(),
 
 			"aws_cloudformation_export": cloudformation.DataSourceExport(),
 			"aws_cloudformation_stack":  cloudformation.DataSourceStack(),
@@ -591,6 +671,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_alias":               lambda.DataSourceAlias(),
 			"aws_lambda_code_signing_config": lambda.DataSourceCodeSigningConfig(),
 			"aws_lambda_function":            lambda.DataSourceFunction(),
+			"aws_lambda_function_url":        lambda.DataSourceFunctionURL(),
 			"aws_lambda_invocation":          lambda.DataSourceInvocation(),
 			"aws_lambda_layer_version":       lambda.DataSourceLayerVersion(),
 
@@ -600,6 +681,8 @@ func Provider() *schema.Provider {
 			"aws_lex_slot_type": lexmodels.DataSourceSlotType(),
 
 			"aws_arn":                     meta.DataSourceARN(),
+			"aws_arn_build":               meta.DataSourceARNBuild(),
+			"aws_arn_parse":               meta.DataSourceARNParse(),
 			"aws_billing_service_account": meta.DataSourceBillingServiceAccount(),
 			"aws_default_tags":            meta.DataSourceDefaultTags(),
 			"aws_ip_ranges":               meta.DataSourceIPRanges(),
@@ -612,6 +695,13 @@ func Provider() *schema.Provider {
 			"aws_neptune_engine_version":        neptune.DataSourceEngineVersion(),
 			"aws_neptune_orderable_db_instance": neptune.DataSourceOrderableDBInstance(),
 
+			"aws_networkmanager_devices":         networkmanager.DataSourceDevices(),
+			"aws_networkmanager_global_networks": networkmanager.DataSourceGlobalNetworks(),
+			"aws_networkmanager_links":           networkmanager.DataSourceLinks(),
+			"aws_networkmanager_sites":           networkmanager.DataSourceSites(),
+
+			"aws_opensearch_domain": opensearch.DataSourceDomain(),
+
 			"aws_organizations_delegated_administrators": organizations.DataSourceDelegatedAdministrators(),
 			"aws_organizations_delegated_services":       organizations.DataSourceDelegatedServices(),
 			"aws_organizations_organization":             organizations.DataSourceOrganization(),
@@ -727,6 +817,8 @@ func Provider() *schema.Provider {
 			"aws_accessanalyzer_analyzer": accessanalyzer.ResourceAnalyzer(),
 
 			"aws_account_alternate_contact": account.ResourceAlternateContact(),
+			"aws_account_primary_contact":   account.ResourcePrimaryContact(),
+			"aws_account_region":            account.ResourceRegion(),
 
 			"aws_acm_certificate":            acm.ResourceCertificate(),
 			"aws_acm_certificate_validation": acm.ResourceCertificateValidation(),
@@ -825,14 +917,15 @@ func Provider() *schema.Provider {
 			"aws_athena_named_query": athena.ResourceNamedQuery(),
 			"aws_athena_workgroup":   athena.ResourceWorkGroup(),
 
-			"aws_autoscaling_attachment":     autoscaling.ResourceAttachment(),
-			"aws_autoscaling_group":          autoscaling.ResourceGroup(),
-			"aws_autoscaling_group_tag":      autoscaling.ResourceGroupTag(),
-			"aws_autoscaling_lifecycle_hook": autoscaling.ResourceLifecycleHook(),
-			"aws_autoscaling_notification":   autoscaling.ResourceNotification(),
-			"aws_autoscaling_policy":         autoscaling.ResourcePolicy(),
-			"aws_autoscaling_schedule":       autoscaling.ResourceSchedule(),
-			"aws_launch_configuration":       autoscaling.ResourceLaunchConfiguration(),
+			"aws_autoscaling_attachment":                autoscaling.ResourceAttachment(),
+			"aws_autoscaling_group":                     autoscaling.ResourceGroup(),
+			"aws_autoscaling_group_tag":                 autoscaling.ResourceGroupTag(),
+			"aws_autoscaling_lifecycle_hook":            autoscaling.ResourceLifecycleHook(),
+			"aws_autoscaling_notification":              autoscaling.ResourceNotification(),
+			"aws_autoscaling_policy":                    autoscaling.ResourcePolicy(),
+			"aws_autoscaling_schedule":                  autoscaling.ResourceSchedule(),
+			"aws_autoscaling_traffic_source_attachment": autoscaling.ResourceTrafficSourceAttachment(),
+			"aws_launch_configuration":                  autoscaling.ResourceLaunchConfiguration(),
 
 			"aws_autoscalingplans_scaling_plan": autoscalingplans.ResourceScalingPlan(),
 
@@ -1032,103 +1125,112 @@ func Provider() *schema.Provider {
 			"aws_dynamodb_table_item":                    dynamodb.ResourceTableItem(),
 			"aws_dynamodb_tag":                           dynamodb.ResourceTag(),
 
-			"aws_ami":                                             ec2.ResourceAMI(),
-			"aws_ami_copy":                                        ec2.ResourceAMICopy(),
-			"aws_ami_from_instance":                               ec2.ResourceAMIFromInstance(),
-			"aws_ami_launch_permission":                           ec2.ResourceAMILaunchPermission(),
-			"aws_customer_gateway":                                ec2.ResourceCustomerGateway(),
-			"aws_default_network_acl":                             ec2.ResourceDefaultNetworkACL(),
-			"aws_default_route_table":                             ec2.ResourceDefaultRouteTable(),
-			"aws_default_security_group":                          ec2.ResourceDefaultSecurityGroup(),
-			"aws_default_subnet":                                  ec2.ResourceDefaultSubnet(),
-			"aws_default_vpc":                                     ec2.ResourceDefaultVPC(),
-			"aws_default_vpc_dhcp_options":                        ec2.ResourceDefaultVPCDHCPOptions(),
-			"aws_ebs_default_kms_key":                             ec2.ResourceEBSDefaultKMSKey(),
-			"aws_ebs_encryption_by_default":                       ec2.ResourceEBSEncryptionByDefault(),
-			"aws_ebs_snapshot":                                    ec2.ResourceEBSSnapshot(),
-			"aws_ebs_snapshot_copy":                               ec2.ResourceEBSSnapshotCopy(),
-			"aws_ebs_snapshot_import":                             ec2.ResourceEBSSnapshotImport(),
-			"aws_ebs_volume":                                      ec2.ResourceEBSVolume(),
-			"aws_ec2_availability_zone_group":                     ec2.ResourceAvailabilityZoneGroup(),
-			"aws_ec2_capacity_reservation":                        ec2.ResourceCapacityReservation(),
-			"aws_ec2_carrier_gateway":                             ec2.ResourceCarrierGateway(),
-			"aws_ec2_client_vpn_authorization_rule":               ec2.ResourceClientVPNAuthorizationRule(),
-			"aws_ec2_client_vpn_endpoint":                         ec2.ResourceClientVPNEndpoint(),
-			"aws_ec2_client_vpn_network_association":              ec2.ResourceClientVPNNetworkAssociation(),
-			"aws_ec2_client_vpn_route":                            ec2.ResourceClientVPNRoute(),
-			"aws_ec2_fleet":                                       ec2.ResourceFleet(),
-			"aws_ec2_host":                                        ec2.ResourceHost(),
-			"aws_ec2_local_gateway_route":                         ec2.ResourceLocalGatewayRoute(),
-			"aws_ec2_local_gateway_route_table_vpc_association":   ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
-			"aws_ec2_managed_prefix_list":                         ec2.ResourceManagedPrefixList(),
-			"aws_ec2_managed_prefix_list_entry":                   ec2.ResourceManagedPrefixListEntry(),
-			"aws_ec2_subnet_cidr_reservation":                     ec2.ResourceSubnetCIDRReservation(),
-			"aws_ec2_tag":                                         ec2.ResourceTag(),
-			"aws_ec2_traffic_mirror_filter":                       ec2.ResourceTrafficMirrorFilter(),
-			"aws_ec2_traffic_mirror_filter_rule":                  ec2.ResourceTrafficMirrorFilterRule(),
-			"aws_ec2_traffic_mirror_session":                      ec2.ResourceTrafficMirrorSession(),
-			"aws_ec2_traffic_mirror_target":                       ec2.ResourceTrafficMirrorTarget(),
-			"aws_ec2_transit_gateway":                             ec2.ResourceTransitGateway(),
-			"aws_ec2_transit_gateway_peering_attachment":          ec2.ResourceTransitGatewayPeeringAttachment(),
-			"aws_ec2_transit_gateway_peering_attachment_accepter": ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
-			"aws_ec2_transit_gateway_prefix_list_reference":       ec2.ResourceTransitGatewayPrefixListReference(),
-			"aws_ec2_transit_gateway_route":                       ec2.ResourceTransitGatewayRoute(),
-			"aws_ec2_transit_gateway_route_table":                 ec2.ResourceTransitGatewayRouteTable(),
-			"aws_ec2_transit_gateway_route_table_association":     ec2.ResourceTransitGatewayRouteTableAssociation(),
-			"aws_ec2_transit_gateway_route_table_propagation":     ec2.ResourceTransitGatewayRouteTablePropagation(),
-			"aws_ec2_transit_gateway_vpc_attachment":              ec2.ResourceTransitGatewayVPCAttachment(),
-			"aws_ec2_transit_gateway_vpc_attachment_accepter":     ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
-			"aws_egress_only_internet_gateway":                    ec2.ResourceEgressOnlyInternetGateway(),
-			"aws_eip":                                             ec2.ResourceEIP(),
-			"aws_eip_association":                                 ec2.ResourceEIPAssociation(),
-			"aws_flow_log":                                        ec2.ResourceFlowLog(),
-			"aws_instance":                                        ec2.ResourceInstance(),
-			"aws_internet_gateway":                                ec2.ResourceInternetGateway(),
-			"aws_key_pair":                                        ec2.ResourceKeyPair(),
-			"aws_launch_template":                                 ec2.ResourceLaunchTemplate(),
-			"aws_main_route_table_association":                    ec2.ResourceMainRouteTableAssociation(),
-			"aws_nat_gateway":                                     ec2.ResourceNatGateway(),
-			"aws_network_acl":                                     ec2.ResourceNetworkACL(),
-			"aws_network_acl_rule":                                ec2.ResourceNetworkACLRule(),
-			"aws_network_interface":                               ec2.ResourceNetworkInterface(),
-			"aws_network_interface_attachment":                    ec2.ResourceNetworkInterfaceAttachment(),
-			"aws_network_interface_sg_attachment":                 ec2.ResourceNetworkInterfaceSGAttachment(),
-			"aws_placement_group":                                 ec2.ResourcePlacementGroup(),
-			"aws_route":                                           ec2.ResourceRoute(),
-			"aws_route_table":                                     ec2.ResourceRouteTable(),
-			"aws_route_table_association":                         ec2.ResourceRouteTableAssociation(),
-			"aws_security_group":                                  ec2.ResourceSecurityGroup(),
-			"aws_security_group_rule":                             ec2.ResourceSecurityGroupRule(),
-			"aws_snapshot_create_volume_permission":               ec2.ResourceSnapshotCreateVolumePermission(),
-			"aws_spot_datafeed_subscription":                      ec2.ResourceSpotDataFeedSubscription(),
-			"aws_spot_fleet_request":                              ec2.ResourceSpotFleetRequest(),
-			"aws_spot_instance_request":                           ec2.ResourceSpotInstanceRequest(),
-			"aws_subnet":                                          ec2.ResourceSubnet(),
-			"aws_volume_attachment":                               ec2.ResourceVolumeAttachment(),
-			"aws_vpc":                                             ec2.ResourceVPC(),
-			"aws_vpc_dhcp_options":                                ec2.ResourceVPCDHCPOptions(),
-			"aws_vpc_dhcp_options_association":                    ec2.ResourceVPCDHCPOptionsAssociation(),
-			"aws_vpc_endpoint":                                    ec2.ResourceVPCEndpoint(),
-			"aws_vpc_endpoint_connection_notification":            ec2.ResourceVPCEndpointConnectionNotification(),
-			"aws_vpc_endpoint_route_table_association":            ec2.ResourceVPCEndpointRouteTableAssociation(),
-			"aws_vpc_endpoint_service":                            ec2.ResourceVPCEndpointService(),
-			"aws_vpc_endpoint_service_allowed_principal":          ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
-			"aws_vpc_endpoint_subnet_association":                 ec2.ResourceVPCEndpointSubnetAssociation(),
-			"aws_vpc_ipam":                                        ec2.ResourceVPCIpam(),
-			"aws_vpc_ipam_pool":                                   ec2.ResourceVPCIpamPool(),
-			"aws_vpc_ipam_pool_cidr_allocation":                   ec2.ResourceVPCIpamPoolCidrAllocation(),
-			"aws_vpc_ipam_pool_cidr":                              ec2.ResourceVPCIpamPoolCidr(),
-			"aws_vpc_ipam_scope":                                  ec2.ResourceVPCIpamScope(),
-			"aws_vpc_ipv4_cidr_block_association":                 ec2.ResourceVPCIPv4CIDRBlockAssociation(),
-			"aws_vpc_ipv6_cidr_block_association":                 ec2.ResourceVPCIPv6CIDRBlockAssociation(),
-			"aws_vpc_peering_connection":                          ec2.ResourceVPCPeeringConnection(),
-			"aws_vpc_peering_connection_accepter":                 ec2.ResourceVPCPeeringConnectionAccepter(),
-			"aws_vpc_peering_connection_options":                  ec2.ResourceVPCPeeringConnectionOptions(),
-			"aws_vpn_connection":                                  ec2.ResourceVPNConnection(),
-			"aws_vpn_connection_route":                            ec2.ResourceVPNConnectionRoute(),
-			"aws_vpn_gateway":                                     ec2.ResourceVPNGateway(),
-			"aws_vpn_gateway_attachment":                          ec2.ResourceVPNGatewayAttachment(),
-			"aws_vpn_gateway_route_propagation":                   ec2.ResourceVPNGatewayRoutePropagation(),
+			"aws_ami":                                              ec2.ResourceAMI(),
+			"aws_ami_copy":                                         ec2.ResourceAMICopy(),
+			"aws_ami_from_instance":                                ec2.ResourceAMIFromInstance(),
+			"aws_ami_launch_permission":                            ec2.ResourceAMILaunchPermission(),
+			"aws_customer_gateway":                                 ec2.ResourceCustomerGateway(),
+			"aws_default_network_acl":                              ec2.ResourceDefaultNetworkACL(),
+			"aws_default_route_table":                              ec2.ResourceDefaultRouteTable(),
+			"aws_default_security_group":                           ec2.ResourceDefaultSecurityGroup(),
+			"aws_default_subnet":                                   ec2.ResourceDefaultSubnet(),
+			"aws_default_vpc":                                      ec2.ResourceDefaultVPC(),
+			"aws_default_vpc_dhcp_options":                         ec2.ResourceDefaultVPCDHCPOptions(),
+			"aws_ebs_default_kms_key":                              ec2.ResourceEBSDefaultKMSKey(),
+			"aws_ebs_encryption_by_default":                        ec2.ResourceEBSEncryptionByDefault(),
+			"aws_ebs_snapshot":                                     ec2.ResourceEBSSnapshot(),
+			"aws_ebs_snapshot_copy":                                ec2.ResourceEBSSnapshotCopy(),
+			"aws_ebs_snapshot_import":                              ec2.ResourceEBSSnapshotImport(),
+			"aws_ebs_volume":                                       ec2.ResourceEBSVolume(),
+			"aws_ec2_availability_zone_group":                      ec2.ResourceAvailabilityZoneGroup(),
+			"aws_ec2_capacity_reservation":                         ec2.ResourceCapacityReservation(),
+			"aws_ec2_carrier_gateway":                              ec2.ResourceCarrierGateway(),
+			"aws_ec2_client_vpn_authorization_rule":                ec2.ResourceClientVPNAuthorizationRule(),
+			"aws_ec2_client_vpn_endpoint":                          ec2.ResourceClientVPNEndpoint(),
+			"aws_ec2_client_vpn_network_association":               ec2.ResourceClientVPNNetworkAssociation(),
+			"aws_ec2_client_vpn_route":                             ec2.ResourceClientVPNRoute(),
+			"aws_ec2_fleet":                                        ec2.ResourceFleet(),
+			"aws_ec2_host":                                         ec2.ResourceHost(),
+			"aws_ec2_instance_metadata_defaults":                   ec2.ResourceInstanceMetadataDefaults(),
+			"aws_ec2_local_gateway_route":                          ec2.ResourceLocalGatewayRoute(),
+			"aws_ec2_local_gateway_route_table_vpc_association":    ec2.ResourceLocalGatewayRouteTableVPCAssociation(),
+			"aws_ec2_managed_prefix_list":                          ec2.ResourceManagedPrefixList(),
+			"aws_ec2_managed_prefix_list_entry":                    ec2.ResourceManagedPrefixListEntry(),
+			"aws_ec2_subnet_cidr_reservation":                      ec2.ResourceSubnetCIDRReservation(),
+			"aws_ec2_tag":                                          ec2.ResourceTag(),
+			"aws_ec2_traffic_mirror_filter":                        ec2.ResourceTrafficMirrorFilter(),
+			"aws_ec2_traffic_mirror_filter_rule":                   ec2.ResourceTrafficMirrorFilterRule(),
+			"aws_ec2_traffic_mirror_session":                       ec2.ResourceTrafficMirrorSession(),
+			"aws_ec2_traffic_mirror_target":                        ec2.ResourceTrafficMirrorTarget(),
+			"aws_ec2_transit_gateway":                              ec2.ResourceTransitGateway(),
+			"aws_ec2_transit_gateway_connect":                      ec2.ResourceTransitGatewayConnect(),
+			"aws_ec2_transit_gateway_connect_peer":                 ec2.ResourceTransitGatewayConnectPeer(),
+			"aws_ec2_transit_gateway_multicast_domain":             ec2.ResourceTransitGatewayMulticastDomain(),
+			"aws_ec2_transit_gateway_multicast_domain_association": ec2.ResourceTransitGatewayMulticastDomainAssociation(),
+			"aws_ec2_transit_gateway_multicast_group_member":       ec2.ResourceTransitGatewayMulticastGroupMember(),
+			"aws_ec2_transit_gateway_multicast_group_source":       ec2.ResourceTransitGatewayMulticastGroupSource(),
+			"aws_ec2_transit_gateway_peering_attachment":           ec2.ResourceTransitGatewayPeeringAttachment(),
+			"aws_ec2_transit_gateway_peering_attachment_accepter":  ec2.ResourceTransitGatewayPeeringAttachmentAccepter(),
+			"aws_ec2_transit_gateway_policy_table":                 ec2.ResourceTransitGatewayPolicyTable(),
+			"aws_ec2_transit_gateway_policy_table_association":     ec2.ResourceTransitGatewayPolicyTableAssociation(),
+			"aws_ec2_transit_gateway_prefix_list_reference":        ec2.ResourceTransitGatewayPrefixListReference(),
+			"aws_ec2_transit_gateway_route":                        ec2.ResourceTransitGatewayRoute(),
+			"aws_ec2_transit_gateway_route_table":                  ec2.ResourceTransitGatewayRouteTable(),
+			"aws_ec2_transit_gateway_route_table_association":      ec2.ResourceTransitGatewayRouteTableAssociation(),
+			"aws_ec2_transit_gateway_route_table_propagation":      ec2.ResourceTransitGatewayRouteTablePropagation(),
+			"aws_ec2_transit_gateway_vpc_attachment":               ec2.ResourceTransitGatewayVPCAttachment(),
+			"aws_ec2_transit_gateway_vpc_attachment_accepter":      ec2.ResourceTransitGatewayVPCAttachmentAccepter(),
+			"aws_egress_only_internet_gateway":                     ec2.ResourceEgressOnlyInternetGateway(),
+			"aws_eip":                                              ec2.ResourceEIP(),
+			"aws_eip_association":                                  ec2.ResourceEIPAssociation(),
+			"aws_flow_log":                                         ec2.ResourceFlowLog(),
+			"aws_instance":                                         ec2.ResourceInstance(),
+			"aws_internet_gateway":                                 ec2.ResourceInternetGateway(),
+			"aws_key_pair":                                         ec2.ResourceKeyPair(),
+			"aws_launch_template":                                  ec2.ResourceLaunchTemplate(),
+			"aws_main_route_table_association":                     ec2.ResourceMainRouteTableAssociation(),
+			"aws_nat_gateway":                                      ec2.ResourceNatGateway(),
+			"aws_network_acl":                                      ec2.ResourceNetworkACL(),
+			"aws_network_acl_rule":                                 ec2.ResourceNetworkACLRule(),
+			"aws_network_interface":                                ec2.ResourceNetworkInterface(),
+			"aws_network_interface_attachment":                     ec2.ResourceNetworkInterfaceAttachment(),
+			"aws_network_interface_sg_attachment":                  ec2.ResourceNetworkInterfaceSGAttachment(),
+			"aws_placement_group":                                  ec2.ResourcePlacementGroup(),
+			"aws_route":                                            ec2.ResourceRoute(),
+			"aws_route_table":                                      ec2.ResourceRouteTable(),
+			"aws_route_table_association":                          ec2.ResourceRouteTableAssociation(),
+			"aws_security_group":                                   ec2.ResourceSecurityGroup(),
+			"aws_security_group_rule":                              ec2.ResourceSecurityGroupRule(),
+			"aws_snapshot_create_volume_permission":                ec2.ResourceSnapshotCreateVolumePermission(),
+			"aws_spot_datafeed_subscription":                       ec2.ResourceSpotDataFeedSubscription(),
+			"aws_spot_fleet_request":                               ec2.ResourceSpotFleetRequest(),
+			"aws_spot_instance_request":                            ec2.ResourceSpotInstanceRequest(),
+			"aws_subnet":                                           ec2.ResourceSubnet(),
+			"aws_volume_attachment":                                ec2.ResourceVolumeAttachment(),
+			"aws_vpc":                                              ec2.ResourceVPC(),
+			"aws_vpc_dhcp_options":                                 ec2.ResourceVPCDHCPOptions(),
+			"aws_vpc_dhcp_options_association":                     ec2.ResourceVPCDHCPOptionsAssociation(),
+			"aws_vpc_endpoint":                                     ec2.ResourceVPCEndpoint(),
+			"aws_vpc_endpoint_connection_notification":             ec2.ResourceVPCEndpointConnectionNotification(),
+			"aws_vpc_endpoint_route_table_association":             ec2.ResourceVPCEndpointRouteTableAssociation(),
+			"aws_vpc_endpoint_service":                             ec2.ResourceVPCEndpointService(),
+			"aws_vpc_endpoint_service_allowed_principal":           ec2.ResourceVPCEndpointServiceAllowedPrincipal(),
+			"aws_vpc_endpoint_subnet_association":                  ec2.ResourceVPCEndpointSubnetAssociation(),
+			"aws_vpc_ipam":                                         ec2.ResourceVPCIpam(),
+			"aws_vpc_ipam_pool":                                    ec2.ResourceVPCIpamPool(),
+			"aws_vpc_ipam_pool_cidr_allocation":                    ec2.ResourceVPCIpamPoolCidrAllocation(),
+			"aws_vpc_ipam_pool_cidr":                               ec2.ResourceVPCIpamPoolCidr(),
+			"aws_vpc_ipam_scope":                                   ec2.ResourceVPCIpamScope(),
+			"aws_vpc_ipv4_cidr_block_association":                  ec2.ResourceVPCIPv4CIDRBlockAssociation(),
+			"aws_vpc_ipv6_cidr_block_association":                  ec2.ResourceVPCIPv6CIDRBlockAssociation(),
+			"aws_vpc_peering_connection":                           ec2.ResourceVPCPeeringConnection(),
+			"aws_vpc_peering_connection_accepter":                  ec2.ResourceVPCPeeringConnectionAccepter(),
+			"aws_vpc_peering_connection_options":                   ec2.ResourceVPCPeeringConnectionOptions(),
+			"aws_vpn_connection":                                   ec2.ResourceVPNConnection(),
+			"aws_vpn_connection_route":                             ec2.ResourceVPNConnectionRoute(),
+			"aws_vpn_gateway":                                      ec2.ResourceVPNGateway(),
+			"aws_vpn_gateway_attachment":                           ec2.ResourceVPNGatewayAttachment(),
+			"aws_vpn_gateway_route_propagation":                    ec2.ResourceVPNGatewayRoutePropagation(),
 
 			"aws_ecr_lifecycle_policy":                ecr.ResourceLifecyclePolicy(),
 			"aws_ecr_pull_through_cache_rule":         ecr.ResourcePullThroughCacheRule(),
@@ -1155,11 +1257,14 @@ func Provider() *schema.Provider {
 			"aws_efs_file_system_policy": efs.ResourceFileSystemPolicy(),
 			"aws_efs_mount_target":       efs.ResourceMountTarget(),
 
-			"aws_eks_addon":                    eks.ResourceAddon(),
-			"aws_eks_cluster":                  eks.ResourceCluster(),
-			"aws_eks_fargate_profile":          eks.ResourceFargateProfile(),
-			"aws_eks_identity_provider_config": eks.ResourceIdentityProviderConfig(),
-			"aws_eks_node_group":               eks.ResourceNodeGroup(),
+			"aws_eks_access_entry":              eks.ResourceAccessEntry(),
+			"aws_eks_access_policy_association": eks.ResourceAccessPolicyAssociation(),
+			"aws_eks_addon":                     eks.ResourceAddon(),
+			"aws_eks_cluster":                   eks.ResourceCluster(),
+			"aws_eks_fargate_profile":           eks.ResourceFargateProfile(),
+			"aws_eks_identity_provider_config":  eks.ResourceIdentityProviderConfig(),
+			"aws_eks_node_group":                eks.ResourceNodeGroup(),
+			"aws_eks_pod_identity_association":  eks.ResourcePodIdentityAssociation(),
 
 			"aws_elasticache_cluster":                  elasticache.ResourceCluster(),
 			"aws_elasticache_global_replication_group": elasticache.ResourceGlobalReplicationGroup(),
@@ -1290,6 +1395,10 @@ func Provider() *schema.Provider {
 			"aws_iam_user_policy_attachment":  iam.ResourceUserPolicyAttachment(),
 			"aws_iam_user_ssh_key":            iam.ResourceUserSSHKey(),
 
+			"aws_identitystore_group":            identitystore.ResourceGroup(),
+			"aws_identitystore_group_membership": identitystore.ResourceGroupMembership(),
+			"aws_identitystore_user":             identitystore.ResourceUser(),
+
 			"aws_imagebuilder_component":                    imagebuilder.ResourceComponent(),
 			"aws_imagebuilder_distribution_configuration":   imagebuilder.ResourceDistributionConfiguration(),
 			"aws_imagebuilder_image":                        imagebuilder.ResourceImage(),
@@ -1343,6 +1452,7 @@ func Provider() *schema.Provider {
 			"aws_lambda_event_source_mapping":           lambda.ResourceEventSourceMapping(),
 			"aws_lambda_function":                       lambda.ResourceFunction(),
 			"aws_lambda_function_event_invoke_config":   lambda.ResourceFunctionEventInvokeConfig(),
+			"aws_lambda_function_url":                   lambda.ResourceFunctionURL(),
 			"aws_lambda_layer_version":                  lambda.ResourceLayerVersion(),
 			"aws_lambda_layer_version_permission":       lambda.ResourceLayerVersionPermission(),
 			"aws_lambda_permission":                     lambda.ResourcePermission(),
@@ -1363,9 +1473,6 @@ func Provider() *schema.Provider {
 			"aws_lightsail_static_ip":             lightsail.ResourceStaticIP(),
 			"aws_lightsail_static_ip_attachment":  lightsail.ResourceStaticIPAttachment(),
 
-			"aws_macie_member_account_association": macie.ResourceMemberAccountAssociation(),
-			"aws_macie_s3_bucket_association":      macie.ResourceS3BucketAssociation(),
-
 			"aws_macie2_account":                    macie2.ResourceAccount(),
 			"aws_macie2_classification_job":         macie2.ResourceClassificationJob(),
 			"aws_macie2_custom_data_identifier":     macie2.ResourceCustomDataIdentifier(),
@@ -1404,6 +1511,18 @@ func Provider() *schema.Provider {
 			"aws_networkfirewall_resource_policy":       networkfirewall.ResourceResourcePolicy(),
 			"aws_networkfirewall_rule_group":            networkfirewall.ResourceRuleGroup(),
 
+			"aws_networkmanager_customer_gateway_association": networkmanager.ResourceCustomerGatewayAssociation(),
+			"aws_networkmanager_device":                       networkmanager.ResourceDevice(),
+			"aws_networkmanager_global_network":               networkmanager.ResourceGlobalNetwork(),
+			"aws_networkmanager_link":                         networkmanager.ResourceLink(),
+			"aws_networkmanager_link_association":             networkmanager.ResourceLinkAssociation(),
+			"aws_networkmanager_site":                         networkmanager.ResourceSite(),
+			"aws_networkmanager_transit_gateway_registration": networkmanager.ResourceTransitGatewayRegistration(),
+
+			"aws_opensearch_domain":              opensearch.ResourceDomain(),
+			"aws_opensearch_domain_policy":       opensearch.ResourceDomainPolicy(),
+			"aws_opensearch_domain_saml_options": opensearch.ResourceDomainSAMLOptions(),
+
 			"aws_opsworks_application":      opsworks.ResourceApplication(),
 			"aws_opsworks_custom_layer":     opsworks.ResourceCustomLayer(),
 			"aws_opsworks_ganglia_layer":    opsworks.ResourceGangliaLayer(),
@@ -1516,18 +1635,28 @@ func Provider() *schema.Provider {
 			"aws_route53_resolver_rule":                            route53resolver.ResourceRule(),
 			"aws_route53_resolver_rule_association":                route53resolver.ResourceRuleAssociation(),
 
-			"aws_s3_bucket":                                   s3.ResourceBucket(),
-			"aws_s3_bucket_analytics_configuration":           s3.ResourceBucketAnalyticsConfiguration(),
-			"aws_s3_bucket_intelligent_tiering_configuration": s3.ResourceBucketIntelligentTieringConfiguration(),
-			"aws_s3_bucket_inventory":                         s3.ResourceBucketInventory(),
-			"aws_s3_bucket_metric":                            s3.ResourceBucketMetric(),
-			"aws_s3_bucket_notification":                      s3.ResourceBucketNotification(),
-			"aws_s3_bucket_object":                            s3.ResourceBucketObject(),
-			"aws_s3_bucket_ownership_controls":                s3.ResourceBucketOwnershipControls(),
-			"aws_s3_bucket_policy":                            s3.ResourceBucketPolicy(),
-			"aws_s3_bucket_public_access_block":               s3.ResourceBucketPublicAccessBlock(),
-			"aws_s3_bucket_replication_configuration":         s3.ResourceBucketReplicationConfiguration(),
-			"aws_s3_object_copy":                              s3.ResourceObjectCopy(),
+			"aws_s3_bucket":                                      s3.ResourceBucket(),
+			"aws_s3_bucket_accelerate_configuration":             s3.ResourceBucketAccelerateConfiguration(),
+			"aws_s3_bucket_acl":                                  s3.ResourceBucketACL(),
+			"aws_s3_bucket_analytics_configuration":              s3.ResourceBucketAnalyticsConfiguration(),
+			"aws_s3_bucket_cors_configuration":                   s3.ResourceBucketCorsConfiguration(),
+			"aws_s3_bucket_intelligent_tiering_configuration":    s3.ResourceBucketIntelligentTieringConfiguration(),
+			"aws_s3_bucket_inventory":                            s3.ResourceBucketInventory(),
+			"aws_s3_bucket_lifecycle_configuration":              s3.ResourceBucketLifecycleConfiguration(),
+			"aws_s3_bucket_logging":                              s3.ResourceBucketLogging(),
+			"aws_s3_bucket_metric":                               s3.ResourceBucketMetric(),
+			"aws_s3_bucket_notification":                         s3.ResourceBucketNotification(),
+			"aws_s3_bucket_object":                               s3.ResourceBucketObject(),
+			"aws_s3_bucket_object_lock_configuration":            s3.ResourceBucketObjectLockConfiguration(),
+			"aws_s3_bucket_ownership_controls":                   s3.ResourceBucketOwnershipControls(),
+			"aws_s3_bucket_policy":                               s3.ResourceBucketPolicy(),
+			"aws_s3_bucket_public_access_block":                  s3.ResourceBucketPublicAccessBlock(),
+			"aws_s3_bucket_replication_configuration":            s3.ResourceBucketReplicationConfiguration(),
+			"aws_s3_bucket_request_payment_configuration":        s3.ResourceBucketRequestPaymentConfiguration(),
+			"aws_s3_bucket_server_side_encryption_configuration": s3.ResourceBucketServerSideEncryptionConfiguration(),
+			"aws_s3_bucket_versioning":                           s3.ResourceBucketVersioning(),
+			"aws_s3_bucket_website_configuration":                s3.ResourceBucketWebsiteConfiguration(),
+			"aws_s3_object_copy":                                 s3.ResourceObjectCopy(),
 
 			"aws_s3_access_point":                             s3control.ResourceAccessPoint(),
 			"aws_s3control_access_point_policy":               s3control.ResourceAccessPointPolicy(),
@@ -1656,10 +1785,12 @@ func Provider() *schema.Provider {
 			"aws_ssm_patch_group":               ssm.ResourcePatchGroup(),
 			"aws_ssm_resource_data_sync":        ssm.ResourceResourceDataSync(),
 
-			"aws_ssoadmin_account_assignment":           ssoadmin.ResourceAccountAssignment(),
-			"aws_ssoadmin_managed_policy_attachment":    ssoadmin.ResourceManagedPolicyAttachment(),
-			"aws_ssoadmin_permission_set":               ssoadmin.ResourcePermissionSet(),
-			"aws_ssoadmin_permission_set_inline_policy": ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_account_assignment":                 ssoadmin.ResourceAccountAssignment(),
+			"aws_ssoadmin_customer_managed_policy_attachment": ssoadmin.ResourceCustomerManagedPolicyAttachment(),
+			"aws_ssoadmin_managed_policy_attachment":          ssoadmin.ResourceManagedPolicyAttachment(),
+			"aws_ssoadmin_permission_set":                     ssoadmin.ResourcePermissionSet(),
+			"aws_ssoadmin_permission_set_inline_policy":       ssoadmin.ResourcePermissionSetInlinePolicy(),
+			"aws_ssoadmin_permissions_boundary_attachment":    ssoadmin.ResourcePermissionsBoundaryAttachment(),
 
 			"aws_storagegateway_cache":                   storagegateway.ResourceCache(),
 			"aws_storagegateway_cached_iscsi_volume":     storagegateway.ResourceCachediSCSIVolume(),
@@ -1731,6 +1862,20 @@ func Provider() *schema.Provider {
 		},
 	}
 
+	// Provider-level tag configuration can exclude specific resource types,
+	// so taggable resources receive the client for their own resource type.
+	for typeName, r := range provider.ResourcesMap {
+		if _, ok := r.Schema["tags_all"]; ok {
+			wrapResourceForResourceType(typeName, r)
+		}
+	}
+
+	for typeName, f := range resourceARNImportIDs {
+		if r, ok := provider.ResourcesMap[typeName]; ok && r.Importer != nil {
+			wrapImporterForARN(r, f)
+		}
+	}
+
 	provider.ConfigureFunc = func(d *schema.ResourceData) (interface{}, error) {
 		terraformVersion := provider.TerraformVersion
 		if terraformVersion == "" {
@@ -1773,6 +1918,20 @@ func init() {
 		"http_proxy": "The address of an HTTP proxy to use when accessing the AWS API. " +
 			"Can also be configured using the `HTTP_PROXY` or `HTTPS_PROXY` environment variables.",
 
+		"https_proxy": "The address of an HTTP proxy to use for HTTPS requests to the AWS API. " +
+			"Takes precedence over `http_proxy` for HTTPS requests. " +
+			"Can also be configured using the `HTTPS_PROXY` environment variable.",
+
+		"no_proxy": "Comma-separated list of hosts, domain suffixes, IP addresses or CIDR blocks " +
+			"that should not use the proxy. Can also be configured using the `NO_PROXY` environment variable.",
+
+		"custom_ca_bundle": "File containing custom root and intermediate certificates to trust, in addition " +
+			"to the system certificate authorities. Can also be configured using the `AWS_CA_BUNDLE` environment variable.",
+
+		"retry_mode": "Specifies how retries are attempted. Valid values are `standard` and `adaptive`. " +
+			"`adaptive` additionally applies client-side rate limiting once throttling errors are returned. " +
+			"Can also be configured using the `AWS_RETRY_MODE` environment variable.",
+
 		"endpoint": "Use this to override the default service endpoint URL",
 
 		"insecure": "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, " +
@@ -1812,8 +1971,13 @@ func providerConfigure(d *schema.ResourceData, terraformVersion string) (interfa
 		Endpoints:               make(map[string]string),
 		MaxRetries:              d.Get("max_retries").(int),
 		IgnoreTagsConfig:        expandProviderIgnoreTags(d.Get("ignore_tags").([]interface{})),
+		TagPolicyConfig:         expandProviderTagPolicy(d.Get("tag_policy").([]interface{})),
 		Insecure:                d.Get("insecure").(bool),
 		HTTPProxy:               d.Get("http_proxy").(string),
+		HTTPSProxy:              d.Get("https_proxy").(string),
+		NoProxy:                 d.Get("no_proxy").(string),
+		CustomCABundle:          d.Get("custom_ca_bundle").(string),
+		RetryMode:               d.Get("retry_mode").(string),
 		SkipCredsValidation:     d.Get("skip_credentials_validation").(bool),
 		SkipGetEC2Platforms:     d.Get("skip_get_ec2_platforms").(bool),
 		SkipRegionValidation:    d.Get("skip_region_validation").(bool),
@@ -2012,9 +2176,51 @@ func expandProviderDefaultTags(l []interface{}) *tftags.DefaultConfig {
 	if v, ok := m["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(v)
 	}
+
+	if v, ok := m["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		defaultConfig.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
 	return defaultConfig
 }
 
+func expandProviderTagPolicy(l []interface{}) *tftags.PolicyConfig {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+	m := l[0].(map[string]interface{})
+
+	if v, ok := m["allowed_values"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.AllowedValues = make(map[string][]string)
+
+		for _, tfMapRaw := range v.List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			key := tfMap["key"].(string)
+
+			if values, ok := tfMap["values"].(*schema.Set); ok {
+				policyConfig.AllowedValues[key] = append(policyConfig.AllowedValues[key], flex.ExpandStringValueSet(values)...)
+			}
+		}
+	}
+
+	if v, ok := m["exclude_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.ExcludeResourceTypes = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := m["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	return policyConfig
+}
+
 func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -2033,3 +2239,82 @@ func expandProviderIgnoreTags(l []interface{}) *tftags.IgnoreConfig {
 
 	return ignoreConfig
 }
+
+// resourceTypeMeta returns the provider meta to pass to the specified resource type's functions.
+func resourceTypeMeta(typeName string, meta interface{}) interface{} {
+	if client, ok := meta.(*conns.AWSClient); ok && client != nil {
+		return client.ForResourceType(typeName)
+	}
+
+	return meta
+}
+
+// wrapResourceForResourceType wraps the resource's CRUD and CustomizeDiff functions
+// so that they are passed the provider meta for the specified resource type.
+func wrapResourceForResourceType(typeName string, r *schema.Resource) {
+	if f := r.Create; f != nil {
+		r.Create = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, resourceTypeMeta(typeName, meta))
+		}
+	}
+	if f := r.Read; f != nil {
+		r.Read = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, resourceTypeMeta(typeName, meta))
+		}
+	}
+	if f := r.Update; f != nil {
+		r.Update = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, resourceTypeMeta(typeName, meta))
+		}
+	}
+	if f := r.Delete; f != nil {
+		r.Delete = func(d *schema.ResourceData, meta interface{}) error {
+			return f(d, resourceTypeMeta(typeName, meta))
+		}
+	}
+	if f := r.CreateContext; f != nil {
+		r.CreateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceTypeMeta(typeName, meta))
+		}
+	}
+	if f := r.ReadContext; f != nil {
+		r.ReadContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceTypeMeta(typeName, meta))
+		}
+	}
+	if f := r.UpdateContext; f != nil {
+		r.UpdateContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceTypeMeta(typeName, meta))
+		}
+	}
+	if f := r.DeleteContext; f != nil {
+		r.DeleteContext = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceTypeMeta(typeName, meta))
+		}
+	}
+	if f := r.CreateWithoutTimeout; f != nil {
+		r.CreateWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceTypeMeta(typeName, meta))
+		}
+	}
+	if f := r.ReadWithoutTimeout; f != nil {
+		r.ReadWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceTypeMeta(typeName, meta))
+		}
+	}
+	if f := r.UpdateWithoutTimeout; f != nil {
+		r.UpdateWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceTypeMeta(typeName, meta))
+		}
+	}
+	if f := r.DeleteWithoutTimeout; f != nil {
+		r.DeleteWithoutTimeout = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			return f(ctx, d, resourceTypeMeta(typeName, meta))
+		}
+	}
+	if f := r.CustomizeDiff; f != nil {
+		r.CustomizeDiff = func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+			return f(ctx, d, resourceTypeMeta(typeName, meta))
+		}
+	}
+}
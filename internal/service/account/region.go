@@ -0,0 +1,205 @@
+package account
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceRegion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRegionUpdate,
+		ReadContext:   resourceRegionRead,
+		UpdateContext: resourceRegionUpdate,
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"opt_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+		},
+	}
+}
+
+func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn
+
+	accountID := d.Get("account_id").(string)
+	regionName := d.Get("region_name").(string)
+	id := RegionCreateResourceID(accountID, regionName)
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	// Wait for any in-progress change to settle before deciding what to do.
+	status, err := waitRegionOptStatusSettled(ctx, conn, accountID, regionName, timeout)
+
+	if err != nil {
+		return diag.Errorf("error waiting for Account Region (%s) opt status to settle: %s", id, err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+
+	switch {
+	case status == account.RegionOptStatusEnabledByDefault:
+		if !enabled {
+			return diag.Errorf("Account Region (%s) is enabled by default and cannot be disabled", id)
+		}
+	case enabled && status != account.RegionOptStatusEnabled:
+		input := &account.EnableRegionInput{
+			RegionName: aws.String(regionName),
+		}
+
+		if accountID != "" {
+			input.AccountId = aws.String(accountID)
+		}
+
+		log.Printf("[DEBUG] Enabling Account Region: %s", input)
+		_, err := conn.EnableRegionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error enabling Account Region (%s): %s", id, err)
+		}
+
+		if _, err := waitRegionEnabled(ctx, conn, accountID, regionName, timeout); err != nil {
+			return diag.Errorf("error waiting for Account Region (%s) enable: %s", id, err)
+		}
+	case !enabled && status != account.RegionOptStatusDisabled:
+		input := &account.DisableRegionInput{
+			RegionName: aws.String(regionName),
+		}
+
+		if accountID != "" {
+			input.AccountId = aws.String(accountID)
+		}
+
+		log.Printf("[DEBUG] Disabling Account Region: %s", input)
+		_, err := conn.DisableRegionWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error disabling Account Region (%s): %s", id, err)
+		}
+
+		if _, err := waitRegionDisabled(ctx, conn, accountID, regionName, timeout); err != nil {
+			return diag.Errorf("error waiting for Account Region (%s) disable: %s", id, err)
+		}
+	}
+
+	if d.IsNewResource() {
+		d.SetId(id)
+	}
+
+	return resourceRegionRead(ctx, d, meta)
+}
+
+func resourceRegionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AccountConn
+
+	accountID, regionName, err := RegionParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindRegionOptStatus(ctx, conn, accountID, regionName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Account Region (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Account Region (%s): %s", d.Id(), err)
+	}
+
+	status := aws.StringValue(output.RegionOptStatus)
+
+	d.Set("account_id", accountID)
+	d.Set("enabled", status == account.RegionOptStatusEnabled || status == account.RegionOptStatusEnabledByDefault)
+	d.Set("opt_status", status)
+	d.Set("region_name", output.RegionName)
+
+	return nil
+}
+
+func FindRegionOptStatus(ctx context.Context, conn *account.Account, accountID, regionName string) (*account.GetRegionOptStatusOutput, error) {
+	input := &account.GetRegionOptStatusInput{
+		RegionName: aws.String(regionName),
+	}
+
+	if accountID != "" {
+		input.AccountId = aws.String(accountID)
+	}
+
+	output, err := conn.GetRegionOptStatusWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RegionOptStatus == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+const regionResourceIDSeparator = "/"
+
+func RegionCreateResourceID(accountID, regionName string) string {
+	parts := []string{accountID, regionName}
+	id := strings.Join(parts, regionResourceIDSeparator)
+
+	return id
+}
+
+func RegionParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, regionResourceIDSeparator)
+
+	switch len(parts) {
+	case 1:
+		return "", parts[0], nil
+	case 2:
+		return parts[0], parts[1], nil
+	default:
+		return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected RegionName or AccountID%[2]sRegionName", id, regionResourceIDSeparator)
+	}
+}
@@ -0,0 +1,113 @@
+package account_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfaccount "github.com/hashicorp/terraform-provider-aws/internal/service/account"
+)
+
+func TestAccAccountRegion_enabledByDefault(t *testing.T) {
+	resourceName := "aws_account_region.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, account.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccountRegionConfig(acctest.Region(), true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountRegionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "opt_status", account.RegionOptStatusEnabledByDefault),
+					resource.TestCheckResourceAttr(resourceName, "region_name", acctest.Region()),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAccountRegion_optIn(t *testing.T) {
+	key := "AWS_ACCOUNT_OPT_IN_REGION"
+	regionName := os.Getenv(key)
+	if regionName == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resourceName := "aws_account_region.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, account.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccountRegionConfig(regionName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountRegionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "opt_status", account.RegionOptStatusEnabled),
+				),
+			},
+			{
+				Config: testAccountRegionConfig(regionName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckAccountRegionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "opt_status", account.RegionOptStatusDisabled),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccountRegionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Account Region ID is set")
+		}
+
+		accountID, regionName, err := tfaccount.RegionParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		ctx := context.TODO()
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AccountConn
+
+		_, err = tfaccount.FindRegionOptStatus(ctx, conn, accountID, regionName)
+
+		return err
+	}
+}
+
+func testAccountRegionConfig(regionName string, enabled bool) string {
+	return fmt.Sprintf(`
+resource "aws_account_region" "test" {
+  region_name = %[1]q
+  enabled     = %[2]t
+}
+`, regionName, enabled)
+}
@@ -1,15 +1,17 @@
-package redshift
+package account
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/aws/aws-sdk-go/service/account"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
-func statusCluster(conn *redshift.Redshift, id string) resource.StateRefreshFunc {
+func statusRegionOptStatus(ctx context.Context, conn *account.Account, accountID, regionName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		output, err := FindClusterByID(conn, id)
+		output, err := FindRegionOptStatus(ctx, conn, accountID, regionName)
 
 		if tfresource.NotFound(err) {
 			return nil, "", nil
@@ -19,6 +21,6 @@ func statusCluster(conn *redshift.Redshift, id string) resource.StateRefreshFunc
 			return nil, "", err
 		}
 
-		return output, aws.StringValue(output.ClusterStatus), nil
+		return output, aws.StringValue(output.RegionOptStatus), nil
 	}
 }
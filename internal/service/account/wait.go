@@ -0,0 +1,72 @@
+package account
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/account"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	regionOptStatusDelay      = 5 * time.Second
+	regionOptStatusMinTimeout = 10 * time.Second
+)
+
+func waitRegionEnabled(ctx context.Context, conn *account.Account, accountID, regionName string, timeout time.Duration) (*account.GetRegionOptStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{account.RegionOptStatusEnabling},
+		Target:     []string{account.RegionOptStatusEnabled, account.RegionOptStatusEnabledByDefault},
+		Refresh:    statusRegionOptStatus(ctx, conn, accountID, regionName),
+		Timeout:    timeout,
+		Delay:      regionOptStatusDelay,
+		MinTimeout: regionOptStatusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*account.GetRegionOptStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitRegionDisabled(ctx context.Context, conn *account.Account, accountID, regionName string, timeout time.Duration) (*account.GetRegionOptStatusOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{account.RegionOptStatusDisabling},
+		Target:     []string{account.RegionOptStatusDisabled},
+		Refresh:    statusRegionOptStatus(ctx, conn, accountID, regionName),
+		Timeout:    timeout,
+		Delay:      regionOptStatusDelay,
+		MinTimeout: regionOptStatusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*account.GetRegionOptStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// waitRegionOptStatusSettled waits until a region is neither being enabled nor disabled and returns its opt status.
+func waitRegionOptStatusSettled(ctx context.Context, conn *account.Account, accountID, regionName string, timeout time.Duration) (string, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{account.RegionOptStatusEnabling, account.RegionOptStatusDisabling},
+		Target:     []string{account.RegionOptStatusEnabled, account.RegionOptStatusEnabledByDefault, account.RegionOptStatusDisabled},
+		Refresh:    statusRegionOptStatus(ctx, conn, accountID, regionName),
+		Timeout:    timeout,
+		MinTimeout: regionOptStatusMinTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*account.GetRegionOptStatusOutput); ok {
+		return aws.StringValue(output.RegionOptStatus), err
+	}
+
+	return "", err
+}
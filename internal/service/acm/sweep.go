@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_acm_certificate", &resource.Sweeper{
 		Name: "aws_acm_certificate",
 		F:    sweepCertificates,
 	})
@@ -28,7 +28,8 @@ func sweepCertificates(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ACMConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListCertificatesPages(&acm.ListCertificatesInput{}, func(page *acm.ListCertificatesOutput, lastPage bool) bool {
 		if page == nil {
@@ -42,9 +43,7 @@ func sweepCertificates(region string) error {
 				CertificateArn: aws.String(arn),
 			})
 			if err != nil {
-				sweeperErr := fmt.Errorf("error describing ACM certificate (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				errs = multierror.Append(errs, fmt.Errorf("error describing ACM certificate (%s): %w", arn, err))
 				continue
 			}
 
@@ -53,28 +52,28 @@ func sweepCertificates(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting ACM certificate: %s", arn)
-			_, err = conn.DeleteCertificate(&acm.DeleteCertificateInput{
-				CertificateArn: aws.String(arn),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting ACM certificate (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			r := ResourceCertificate()
+			d := r.Data(nil)
+			d.SetId(arn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping ACM certificate sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-		}
-		return fmt.Errorf("error retrieving ACM certificates: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing ACM certificates for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping ACM certificates for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping ACM certificate sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/acmpca"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -17,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_acmpca_certificate_authority", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_acmpca_certificate_authority", &resource.Sweeper{
 		Name: "aws_acmpca_certificate_authority",
 		F:    sweepCertificateAuthorities,
 	})
@@ -29,59 +28,38 @@ func sweepCertificateAuthorities(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ACMPCAConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	certificateAuthorities, err := listCertificateAuthorities(conn)
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping ACM PCA Certificate Authorities sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving ACM PCA Certificate Authorities: %w", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing ACM PCA Certificate Authorities for %s: %w", region, err))
 	}
-	if len(certificateAuthorities) == 0 {
-		log.Print("[DEBUG] No ACM PCA Certificate Authorities to sweep")
-		return nil
-	}
-
-	var sweeperErrs *multierror.Error
 
 	for _, certificateAuthority := range certificateAuthorities {
-		arn := aws.StringValue(certificateAuthority.Arn)
-
-		if aws.StringValue(certificateAuthority.Status) == acmpca.CertificateAuthorityStatusActive {
-			log.Printf("[INFO] Disabling ACM PCA Certificate Authority: %s", arn)
-			_, err := conn.UpdateCertificateAuthority(&acmpca.UpdateCertificateAuthorityInput{
-				CertificateAuthorityArn: aws.String(arn),
-				Status:                  aws.String(acmpca.CertificateAuthorityStatusDisabled),
-			})
-			if tfawserr.ErrMessageContains(err, acmpca.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error disabling ACM PCA Certificate Authority (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-		}
-
-		log.Printf("[INFO] Deleting ACM PCA Certificate Authority: %s", arn)
-		_, err := conn.DeleteCertificateAuthority(&acmpca.DeleteCertificateAuthorityInput{
-			CertificateAuthorityArn:     aws.String(arn),
-			PermanentDeletionTimeInDays: aws.Int64(7),
-		})
-		if tfawserr.ErrMessageContains(err, acmpca.ErrCodeResourceNotFoundException, "") {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting ACM PCA Certificate Authority (%s): %w", arn, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+		if aws.StringValue(certificateAuthority.Status) == acmpca.CertificateAuthorityStatusDeleted {
 			continue
 		}
+
+		r := ResourceCertificateAuthority()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(certificateAuthority.Arn))
+		d.Set("permanent_deletion_time_in_days", 7)
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping ACM PCA Certificate Authorities for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping ACM PCA Certificate Authorities sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func listCertificateAuthorities(conn *acmpca.ACMPCA) ([]*acmpca.CertificateAuthority, error) {
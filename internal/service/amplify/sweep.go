@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_amplify_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_amplify_app", &resource.Sweeper{
 		Name: "aws_amplify_app",
 		F:    sweepApps,
 	})
@@ -29,7 +29,8 @@ func sweepApps(region string) error {
 	}
 	conn := client.(*conns.AWSClient).AmplifyConn
 	input := &amplify.ListAppsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = listAppsPages(conn, input, func(page *amplify.ListAppsOutput, lastPage bool) bool {
 		if page == nil {
@@ -40,26 +41,27 @@ func sweepApps(region string) error {
 			r := ResourceApp()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(app.AppId))
-			err = r.Delete(d, client)
+			d.Set("name", app.Name)
+			d.Set("tags_all", aws.StringValueMap(app.Tags))
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Amplify Apps sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Amplify Apps for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Amplify Apps: %w", err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Amplify Apps for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Amplify Apps sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
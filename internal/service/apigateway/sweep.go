@@ -10,7 +10,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigateway"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -18,12 +17,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_rest_api", &resource.Sweeper{
 		Name: "aws_api_gateway_rest_api",
 		F:    sweepRestAPIs,
 	})
 
-	resource.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_api_gateway_vpc_link", &resource.Sweeper{
 		Name: "aws_api_gateway_vpc_link",
 		F:    sweepVPCLinks,
 	})
@@ -35,40 +34,44 @@ func sweepRestAPIs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).APIGatewayConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.GetRestApisPages(&apigateway.GetRestApisInput{}, func(page *apigateway.GetRestApisOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, item := range page.Items {
-			input := &apigateway.DeleteRestApiInput{
-				RestApiId: item.Id,
-			}
-			log.Printf("[INFO] Deleting API Gateway REST API: %s", input)
-			// TooManyRequestsException: Too Many Requests can take over a minute to resolve itself
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteRestApi(input)
-				if err != nil {
-					if tfawserr.ErrMessageContains(err, apigateway.ErrCodeTooManyRequestsException, "") {
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete API Gateway REST API %s: %s", *item.Name, err)
-				continue
+			r := ResourceRestAPI()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(item.Id))
+			d.Set("name", item.Name)
+			d.Set("tags_all", aws.StringValueMap(item.Tags))
+			if item.CreatedDate != nil {
+				d.Set("created_date", item.CreatedDate.Format(time.RFC3339))
 			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping API Gateway REST API sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving API Gateway REST APIs: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing API Gateway REST APIs for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping API Gateway REST APIs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping API Gateway REST API sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepVPCLinks(region string) error {
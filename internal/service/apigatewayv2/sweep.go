@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/apigatewayv2"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -17,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_api", &resource.Sweeper{
 		Name: "aws_apigatewayv2_api",
 		F:    sweepAPIs,
 		Dependencies: []string{
@@ -25,12 +24,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_domain_name", &resource.Sweeper{
 		Name: "aws_apigatewayv2_domain_name",
 		F:    sweepDomainNames,
 	})
 
-	resource.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_apigatewayv2_vpc_link", &resource.Sweeper{
 		Name: "aws_apigatewayv2_vpc_link",
 		F:    sweepVPCLinks,
 	})
@@ -43,32 +42,25 @@ func sweepAPIs(region string) error {
 	}
 	conn := client.(*conns.AWSClient).APIGatewayV2Conn
 	input := &apigatewayv2.GetApisInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.GetApis(input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping API Gateway v2 API sweep for %s: %s", region, err)
-			return nil
-		}
+
 		if err != nil {
-			return fmt.Errorf("error retrieving API Gateway v2 APIs: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing API Gateway v2 APIs for %s: %w", region, err))
+			break
 		}
 
 		for _, api := range output.Items {
-			log.Printf("[INFO] Deleting API Gateway v2 API: %s", aws.StringValue(api.ApiId))
-			_, err := conn.DeleteApi(&apigatewayv2.DeleteApiInput{
-				ApiId: api.ApiId,
-			})
-			if tfawserr.ErrMessageContains(err, apigatewayv2.ErrCodeNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting API Gateway v2 API (%s): %w", aws.StringValue(api.ApiId), err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			r := ResourceAPI()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(api.ApiId))
+			d.Set("name", api.Name)
+			d.Set("tags_all", aws.StringValueMap(api.Tags))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -77,7 +69,16 @@ func sweepAPIs(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping API Gateway v2 APIs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping API Gateway v2 API sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepDomainNames(region string) error {
@@ -87,7 +88,8 @@ func sweepDomainNames(region string) error {
 	}
 	conn := client.(*conns.AWSClient).APIGatewayV2Conn
 	input := &apigatewayv2.GetDomainNamesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = getDomainNamesPages(conn, input, func(page *apigatewayv2.GetDomainNamesOutput, lastPage bool) bool {
 		if page == nil {
@@ -98,28 +100,28 @@ func sweepDomainNames(region string) error {
 			r := ResourceDomainName()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(domainName.DomainName))
-			err = r.Delete(d, client)
+			d.Set("tags_all", aws.StringValueMap(domainName.Tags))
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping API Gateway v2 domain names sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing API Gateway v2 domain names for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing API Gateway v2 domain names: %w", err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping API Gateway v2 domain names for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping API Gateway v2 domain names sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepVPCLinks(region string) error {
@@ -129,43 +131,25 @@ func sweepVPCLinks(region string) error {
 	}
 	conn := client.(*conns.AWSClient).APIGatewayV2Conn
 	input := &apigatewayv2.GetVpcLinksInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.GetVpcLinks(input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping API Gateway v2 VPC Link sweep for %s: %s", region, err)
-			return nil
-		}
+
 		if err != nil {
-			return fmt.Errorf("error retrieving API Gateway v2 VPC Links: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing API Gateway v2 VPC Links for %s: %w", region, err))
+			break
 		}
 
 		for _, link := range output.Items {
-			log.Printf("[INFO] Deleting API Gateway v2 VPC Link: %s", aws.StringValue(link.VpcLinkId))
-			_, err := conn.DeleteVpcLink(&apigatewayv2.DeleteVpcLinkInput{
-				VpcLinkId: link.VpcLinkId,
-			})
-			if tfawserr.ErrMessageContains(err, apigatewayv2.ErrCodeNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting API Gateway v2 VPC Link (%s): %w", aws.StringValue(link.VpcLinkId), err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-
-			_, err = WaitVPCLinkDeleted(conn, aws.StringValue(link.VpcLinkId))
-			if tfawserr.ErrMessageContains(err, apigatewayv2.ErrCodeNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error waiting for API Gateway v2 VPC Link (%s) deletion: %w", aws.StringValue(link.VpcLinkId), err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			r := ResourceVPCLink()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(link.VpcLinkId))
+			d.Set("name", link.Name)
+			d.Set("tags_all", aws.StringValueMap(link.Tags))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -174,5 +158,14 @@ func sweepVPCLinks(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping API Gateway v2 VPC Links for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping API Gateway v2 VPC Link sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
@@ -6,6 +6,7 @@ package appmesh
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appmesh"
@@ -16,12 +17,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_gateway_route", &resource.Sweeper{
 		Name: "aws_appmesh_gateway_route",
 		F:    sweepGatewayRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_mesh", &resource.Sweeper{
 		Name: "aws_appmesh_mesh",
 		F:    sweepMeshes,
 		Dependencies: []string{
@@ -32,12 +33,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_route", &resource.Sweeper{
 		Name: "aws_appmesh_route",
 		F:    sweepRoutes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_gateway", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_gateway",
 		F:    sweepVirtualGateways,
 		Dependencies: []string{
@@ -45,12 +46,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_node", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_node",
 		F:    sweepVirtualNodes,
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_router", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_router",
 		F:    sweepVirtualRouters,
 		Dependencies: []string{
@@ -58,7 +59,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appmesh_virtual_service", &resource.Sweeper{
 		Name: "aws_appmesh_virtual_service",
 		F:    sweepVirtualServices,
 	})
@@ -70,8 +71,8 @@ func sweepGatewayRoutes(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).AppMeshConn
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
@@ -81,7 +82,7 @@ func sweepGatewayRoutes(region string) error {
 		for _, mesh := range page.Meshes {
 			meshName := aws.StringValue(mesh.MeshName)
 
-			err = conn.ListVirtualGatewaysPages(&appmesh.ListVirtualGatewaysInput{MeshName: mesh.MeshName}, func(page *appmesh.ListVirtualGatewaysOutput, lastPage bool) bool {
+			err := conn.ListVirtualGatewaysPages(&appmesh.ListVirtualGatewaysInput{MeshName: mesh.MeshName}, func(page *appmesh.ListVirtualGatewaysOutput, lastPage bool) bool {
 				if page == nil {
 					return !lastPage
 				}
@@ -89,35 +90,28 @@ func sweepGatewayRoutes(region string) error {
 				for _, virtualGateway := range page.VirtualGateways {
 					virtualGatewayName := aws.StringValue(virtualGateway.VirtualGatewayName)
 
-					err = conn.ListGatewayRoutesPages(&appmesh.ListGatewayRoutesInput{MeshName: mesh.MeshName, VirtualGatewayName: virtualGateway.VirtualGatewayName}, func(page *appmesh.ListGatewayRoutesOutput, lastPage bool) bool {
+					err := conn.ListGatewayRoutesPages(&appmesh.ListGatewayRoutesInput{MeshName: mesh.MeshName, VirtualGatewayName: virtualGateway.VirtualGatewayName}, func(page *appmesh.ListGatewayRoutesOutput, lastPage bool) bool {
 						if page == nil {
 							return !lastPage
 						}
 
 						for _, gatewayRoute := range page.GatewayRoutes {
-							gatewayRouteName := aws.StringValue(gatewayRoute.GatewayRouteName)
-
-							log.Printf("[INFO] Deleting App Mesh service mesh (%s) virtual gateway (%s) gateway route: %s", meshName, virtualGatewayName, gatewayRouteName)
 							r := ResourceGatewayRoute()
 							d := r.Data(nil)
 							d.SetId("????????????????") // ID not used in Delete.
+							d.Set("created_date", aws.TimeValue(gatewayRoute.CreatedAt).Format(time.RFC3339))
 							d.Set("mesh_name", meshName)
-							d.Set("name", gatewayRouteName)
+							d.Set("name", gatewayRoute.GatewayRouteName)
 							d.Set("virtual_gateway_name", virtualGatewayName)
-							err := r.Delete(d, client)
 
-							if err != nil {
-								log.Printf("[ERROR] %s", err)
-								sweeperErrs = multierror.Append(sweeperErrs, err)
-								continue
-							}
+							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 						}
 
 						return !lastPage
 					})
 
 					if err != nil {
-						sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving App Mesh service mesh (%s) virtual gateway (%s) gateway routes: %w", meshName, virtualGatewayName, err))
+						errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service mesh (%s) virtual gateway (%s) gateway routes for %s: %w", meshName, virtualGatewayName, region, err))
 					}
 				}
 
@@ -125,29 +119,37 @@ func sweepGatewayRoutes(region string) error {
 			})
 
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving App Mesh service mesh (%s) virtual gateways: %w", meshName, err))
+				errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service mesh (%s) virtual gateways for %s: %w", meshName, region, err))
 			}
 		}
 
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Appmesh virtual gateway sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving App Mesh virtual gateways: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service meshes for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Mesh gateway routes for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Mesh gateway route sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepMeshes(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).AppMeshConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
@@ -155,39 +157,42 @@ func sweepMeshes(region string) error {
 		}
 
 		for _, mesh := range page.Meshes {
-			name := aws.StringValue(mesh.MeshName)
-
-			input := &appmesh.DeleteMeshInput{
-				MeshName: aws.String(name),
-			}
-
-			log.Printf("[INFO] Deleting Appmesh Mesh: %s", name)
-			_, err := conn.DeleteMesh(input)
+			r := ResourceMesh()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(mesh.MeshName))
+			d.Set("created_date", aws.TimeValue(mesh.CreatedAt).Format(time.RFC3339))
+			d.Set("name", mesh.MeshName)
 
-			if err != nil {
-				log.Printf("[ERROR] Error deleting Appmesh Mesh (%s): %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Appmesh Mesh sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving Appmesh Meshes: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service meshes for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Mesh service meshes for %s: %w", region, err))
 	}
 
-	return nil
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Mesh service mesh sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepRoutes(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).AppMeshConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
@@ -195,49 +200,38 @@ func sweepRoutes(region string) error {
 		}
 
 		for _, mesh := range page.Meshes {
-			listVirtualRoutersInput := &appmesh.ListVirtualRoutersInput{
-				MeshName: mesh.MeshName,
-			}
 			meshName := aws.StringValue(mesh.MeshName)
 
-			err := conn.ListVirtualRoutersPages(listVirtualRoutersInput, func(page *appmesh.ListVirtualRoutersOutput, lastPage bool) bool {
+			err := conn.ListVirtualRoutersPages(&appmesh.ListVirtualRoutersInput{MeshName: mesh.MeshName}, func(page *appmesh.ListVirtualRoutersOutput, lastPage bool) bool {
 				if page == nil {
 					return !lastPage
 				}
 
 				for _, virtualRouter := range page.VirtualRouters {
-					listRoutesInput := &appmesh.ListRoutesInput{
-						MeshName:          mesh.MeshName,
-						VirtualRouterName: virtualRouter.VirtualRouterName,
-					}
 					virtualRouterName := aws.StringValue(virtualRouter.VirtualRouterName)
 
-					err := conn.ListRoutesPages(listRoutesInput, func(page *appmesh.ListRoutesOutput, lastPage bool) bool {
+					err := conn.ListRoutesPages(&appmesh.ListRoutesInput{MeshName: mesh.MeshName, VirtualRouterName: virtualRouter.VirtualRouterName}, func(page *appmesh.ListRoutesOutput, lastPage bool) bool {
 						if page == nil {
 							return !lastPage
 						}
 
 						for _, route := range page.Routes {
-							input := &appmesh.DeleteRouteInput{
-								MeshName:          mesh.MeshName,
-								RouteName:         route.RouteName,
-								VirtualRouterName: virtualRouter.VirtualRouterName,
-							}
-							routeName := aws.StringValue(route.RouteName)
-
-							log.Printf("[INFO] Deleting Appmesh Mesh (%s) Virtual Router (%s) Route: %s", meshName, virtualRouterName, routeName)
-							_, err := conn.DeleteRoute(input)
-
-							if err != nil {
-								log.Printf("[ERROR] Error deleting Appmesh Mesh (%s) Virtual Router (%s) Route (%s): %s", meshName, virtualRouterName, routeName, err)
-							}
+							r := ResourceRoute()
+							d := r.Data(nil)
+							d.SetId("????????????????") // ID not used in Delete.
+							d.Set("created_date", aws.TimeValue(route.CreatedAt).Format(time.RFC3339))
+							d.Set("mesh_name", meshName)
+							d.Set("name", route.RouteName)
+							d.Set("virtual_router_name", virtualRouterName)
+
+							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 						}
 
 						return !lastPage
 					})
 
 					if err != nil {
-						log.Printf("[ERROR] Error retrieving Appmesh Mesh (%s) Virtual Router (%s) Routes: %s", meshName, virtualRouterName, err)
+						errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service mesh (%s) virtual router (%s) routes for %s: %w", meshName, virtualRouterName, region, err))
 					}
 				}
 
@@ -245,21 +239,27 @@ func sweepRoutes(region string) error {
 			})
 
 			if err != nil {
-				log.Printf("[ERROR] Error retrieving Appmesh Mesh (%s) Virtual Routers: %s", meshName, err)
+				errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service mesh (%s) virtual routers for %s: %w", meshName, region, err))
 			}
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Appmesh Mesh sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving Appmesh Meshes: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service meshes for %s: %w", region, err))
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Mesh routes for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Mesh route sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepVirtualGateways(region string) error {
@@ -268,8 +268,8 @@ func sweepVirtualGateways(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).AppMeshConn
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
@@ -279,48 +279,47 @@ func sweepVirtualGateways(region string) error {
 		for _, mesh := range page.Meshes {
 			meshName := aws.StringValue(mesh.MeshName)
 
-			err = conn.ListVirtualGatewaysPages(&appmesh.ListVirtualGatewaysInput{MeshName: mesh.MeshName}, func(page *appmesh.ListVirtualGatewaysOutput, lastPage bool) bool {
+			err := conn.ListVirtualGatewaysPages(&appmesh.ListVirtualGatewaysInput{MeshName: mesh.MeshName}, func(page *appmesh.ListVirtualGatewaysOutput, lastPage bool) bool {
 				if page == nil {
 					return !lastPage
 				}
 
-				for _, virtualGateway := range page.VirtualGateways {
-					virtualGatewayName := aws.StringValue(virtualGateway.VirtualGatewayName)
-
-					log.Printf("[INFO] Deleting App Mesh service mesh (%s) virtual gateway: %s", meshName, virtualGatewayName)
+				for _, v := range page.VirtualGateways {
 					r := ResourceVirtualGateway()
 					d := r.Data(nil)
 					d.SetId("????????????????") // ID not used in Delete.
+					d.Set("created_date", aws.TimeValue(v.CreatedAt).Format(time.RFC3339))
 					d.Set("mesh_name", meshName)
-					d.Set("name", virtualGatewayName)
-					err := r.Delete(d, client)
+					d.Set("name", v.VirtualGatewayName)
 
-					if err != nil {
-						log.Printf("[ERROR] %s", err)
-						sweeperErrs = multierror.Append(sweeperErrs, err)
-						continue
-					}
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 
 				return !lastPage
 			})
 
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving App Mesh service mesh (%s) virtual gateways: %w", meshName, err))
+				errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service mesh (%s) virtual gateways for %s: %w", meshName, region, err))
 			}
 		}
 
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Appmesh virtual gateway sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving App Mesh virtual gateways: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service meshes for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Mesh virtual gateways for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Mesh virtual gateway sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepVirtualNodes(region string) error {
@@ -329,8 +328,8 @@ func sweepVirtualNodes(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).AppMeshConn
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
@@ -338,61 +337,59 @@ func sweepVirtualNodes(region string) error {
 		}
 
 		for _, mesh := range page.Meshes {
-			listVirtualNodesInput := &appmesh.ListVirtualNodesInput{
-				MeshName: mesh.MeshName,
-			}
 			meshName := aws.StringValue(mesh.MeshName)
 
-			err := conn.ListVirtualNodesPages(listVirtualNodesInput, func(page *appmesh.ListVirtualNodesOutput, lastPage bool) bool {
+			err := conn.ListVirtualNodesPages(&appmesh.ListVirtualNodesInput{MeshName: mesh.MeshName}, func(page *appmesh.ListVirtualNodesOutput, lastPage bool) bool {
 				if page == nil {
 					return !lastPage
 				}
 
-				for _, virtualNode := range page.VirtualNodes {
-					input := &appmesh.DeleteVirtualNodeInput{
-						MeshName:        mesh.MeshName,
-						VirtualNodeName: virtualNode.VirtualNodeName,
-					}
-					virtualNodeName := aws.StringValue(virtualNode.VirtualNodeName)
-
-					log.Printf("[INFO] Deleting Appmesh Mesh (%s) Virtual Node: %s", meshName, virtualNodeName)
-					_, err := conn.DeleteVirtualNode(input)
+				for _, v := range page.VirtualNodes {
+					r := ResourceVirtualNode()
+					d := r.Data(nil)
+					d.SetId("????????????????") // ID not used in Delete.
+					d.Set("created_date", aws.TimeValue(v.CreatedAt).Format(time.RFC3339))
+					d.Set("mesh_name", meshName)
+					d.Set("name", v.VirtualNodeName)
 
-					if err != nil {
-						sweeperErr := fmt.Errorf("error deleting Appmesh Mesh (%s) Virtual Node (%s): %w", meshName, virtualNodeName, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 
 				return !lastPage
 			})
 
 			if err != nil {
-				log.Printf("[ERROR] Error retrieving Appmesh Mesh (%s) Virtual Nodes: %s", meshName, err)
+				errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service mesh (%s) virtual nodes for %s: %w", meshName, region, err))
 			}
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Appmesh Virtual Node sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving Appmesh Virtual Nodes: %w", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service meshes for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Mesh virtual nodes for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Mesh virtual node sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepVirtualRouters(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).AppMeshConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
@@ -400,58 +397,59 @@ func sweepVirtualRouters(region string) error {
 		}
 
 		for _, mesh := range page.Meshes {
-			listVirtualRoutersInput := &appmesh.ListVirtualRoutersInput{
-				MeshName: mesh.MeshName,
-			}
 			meshName := aws.StringValue(mesh.MeshName)
 
-			err := conn.ListVirtualRoutersPages(listVirtualRoutersInput, func(page *appmesh.ListVirtualRoutersOutput, lastPage bool) bool {
+			err := conn.ListVirtualRoutersPages(&appmesh.ListVirtualRoutersInput{MeshName: mesh.MeshName}, func(page *appmesh.ListVirtualRoutersOutput, lastPage bool) bool {
 				if page == nil {
 					return !lastPage
 				}
 
-				for _, virtualRouter := range page.VirtualRouters {
-					input := &appmesh.DeleteVirtualRouterInput{
-						MeshName:          mesh.MeshName,
-						VirtualRouterName: virtualRouter.VirtualRouterName,
-					}
-					virtualRouterName := aws.StringValue(virtualRouter.VirtualRouterName)
-
-					log.Printf("[INFO] Deleting Appmesh Mesh (%s) Virtual Router: %s", meshName, virtualRouterName)
-					_, err := conn.DeleteVirtualRouter(input)
+				for _, v := range page.VirtualRouters {
+					r := ResourceVirtualRouter()
+					d := r.Data(nil)
+					d.SetId("????????????????") // ID not used in Delete.
+					d.Set("created_date", aws.TimeValue(v.CreatedAt).Format(time.RFC3339))
+					d.Set("mesh_name", meshName)
+					d.Set("name", v.VirtualRouterName)
 
-					if err != nil {
-						log.Printf("[ERROR] Error deleting Appmesh Mesh (%s) Virtual Router (%s): %s", meshName, virtualRouterName, err)
-					}
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 
 				return !lastPage
 			})
 
 			if err != nil {
-				log.Printf("[ERROR] Error retrieving Appmesh Mesh (%s) Virtual Routers: %s", meshName, err)
+				errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service mesh (%s) virtual routers for %s: %w", meshName, region, err))
 			}
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Appmesh Virtual Router sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving Appmesh Virtual Routers: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service meshes for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Mesh virtual routers for %s: %w", region, err))
 	}
 
-	return nil
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Mesh virtual router sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepVirtualServices(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).AppMeshConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListMeshesPages(&appmesh.ListMeshesInput{}, func(page *appmesh.ListMeshesOutput, lastPage bool) bool {
 		if page == nil {
@@ -459,48 +457,47 @@ func sweepVirtualServices(region string) error {
 		}
 
 		for _, mesh := range page.Meshes {
-			listVirtualServicesInput := &appmesh.ListVirtualServicesInput{
-				MeshName: mesh.MeshName,
-			}
 			meshName := aws.StringValue(mesh.MeshName)
 
-			err := conn.ListVirtualServicesPages(listVirtualServicesInput, func(page *appmesh.ListVirtualServicesOutput, lastPage bool) bool {
+			err := conn.ListVirtualServicesPages(&appmesh.ListVirtualServicesInput{MeshName: mesh.MeshName}, func(page *appmesh.ListVirtualServicesOutput, lastPage bool) bool {
 				if page == nil {
 					return !lastPage
 				}
 
-				for _, virtualService := range page.VirtualServices {
-					input := &appmesh.DeleteVirtualServiceInput{
-						MeshName:           mesh.MeshName,
-						VirtualServiceName: virtualService.VirtualServiceName,
-					}
-					virtualServiceName := aws.StringValue(virtualService.VirtualServiceName)
-
-					log.Printf("[INFO] Deleting Appmesh Mesh (%s) Virtual Service: %s", meshName, virtualServiceName)
-					_, err := conn.DeleteVirtualService(input)
+				for _, v := range page.VirtualServices {
+					r := ResourceVirtualService()
+					d := r.Data(nil)
+					d.SetId("????????????????") // ID not used in Delete.
+					d.Set("created_date", aws.TimeValue(v.CreatedAt).Format(time.RFC3339))
+					d.Set("mesh_name", meshName)
+					d.Set("name", v.VirtualServiceName)
 
-					if err != nil {
-						log.Printf("[ERROR] Error deleting Appmesh Mesh (%s) Virtual Service (%s): %s", meshName, virtualServiceName, err)
-					}
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 
 				return !lastPage
 			})
 
 			if err != nil {
-				log.Printf("[ERROR] Error retrieving Appmesh Mesh (%s) Virtual Services: %s", meshName, err)
+				errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service mesh (%s) virtual services for %s: %w", meshName, region, err))
 			}
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Appmesh Virtual Service sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving Appmesh Virtual Services: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing App Mesh service meshes for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping App Mesh virtual services for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping App Mesh virtual service sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
@@ -9,13 +9,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/appsync"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_appsync_graphql_api", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_appsync_graphql_api", &resource.Sweeper{
 		Name: "aws_appsync_graphql_api",
 		F:    sweepGraphQLAPIs,
 	})
@@ -29,30 +30,25 @@ func sweepGraphQLAPIs(region string) error {
 	conn := client.(*conns.AWSClient).AppSyncConn
 
 	input := &appsync.ListGraphqlApisInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.ListGraphqlApis(input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping AppSync GraphQL API sweep for %s: %s", region, err)
-			return nil
-		}
 
 		if err != nil {
-			return fmt.Errorf("Error retrieving AppSync GraphQL APIs: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing AppSync GraphQL APIs for %s: %w", region, err))
+			break
 		}
 
 		for _, graphAPI := range output.GraphqlApis {
-			id := aws.StringValue(graphAPI.ApiId)
-			input := &appsync.DeleteGraphqlApiInput{
-				ApiId: graphAPI.ApiId,
-			}
+			r := ResourceGraphQLAPI()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(graphAPI.ApiId))
+			d.Set("name", graphAPI.Name)
+			d.Set("tags_all", aws.StringValueMap(graphAPI.Tags))
 
-			log.Printf("[INFO] Deleting AppSync GraphQL API %s", id)
-			_, err := conn.DeleteGraphqlApi(input)
-
-			if err != nil {
-				return fmt.Errorf("error deleting AppSync GraphQL API (%s): %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -62,5 +58,14 @@ func sweepGraphQLAPIs(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping AppSync GraphQL APIs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping AppSync GraphQL API sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
@@ -3,3 +3,25 @@ package autoscaling
 const (
 	TagResourceTypeGroup = `auto-scaling-group`
 )
+
+const (
+	TrafficSourceStateAdding    = "Adding"
+	TrafficSourceStateAdded     = "Added"
+	TrafficSourceStateInService = "InService"
+	TrafficSourceStateRemoving  = "Removing"
+	TrafficSourceStateRemoved   = "Removed"
+)
+
+const (
+	TrafficSourceTypeELB        = "elb"
+	TrafficSourceTypeELBV2      = "elbv2"
+	TrafficSourceTypeVPCLattice = "vpc-lattice"
+)
+
+func TrafficSourceType_Values() []string {
+	return []string{
+		TrafficSourceTypeELB,
+		TrafficSourceTypeELBV2,
+		TrafficSourceTypeVPCLattice,
+	}
+}
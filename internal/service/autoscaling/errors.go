@@ -0,0 +1,5 @@
+package autoscaling
+
+const (
+	ErrCodeValidationError = "ValidationError"
+)
@@ -537,7 +537,8 @@ func ResourceGroup() *schema.Resource {
 										Type:     schema.TypeList,
 										Optional: true,
 										Elem: &schema.Schema{
-											Type: schema.TypeInt,
+											Type:         schema.TypeInt,
+											ValidateFunc: validation.IntBetween(1, 100),
 										},
 									},
 									"instance_warmup": {
@@ -551,6 +552,11 @@ func ResourceGroup() *schema.Resource {
 										Default:      90,
 										ValidateFunc: validation.IntBetween(0, 100),
 									},
+									"skip_matching": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
 								},
 							},
 						},
@@ -589,6 +595,20 @@ func ResourceGroup() *schema.Resource {
 							Optional: true,
 							Default:  -1,
 						},
+						"instance_reuse_policy": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"reuse_on_scale_in": {
+										Type:     schema.TypeBool,
+										Optional: true,
+										Default:  false,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -2085,6 +2105,18 @@ func FlattenWarmPoolConfiguration(warmPoolConfiguration *autoscaling.WarmPoolCon
 		"max_group_prepared_capacity": maxGroupPreparedCapacity,
 	}
 
+	if warmPoolConfiguration.InstanceReusePolicy != nil {
+		m["instance_reuse_policy"] = flattenWarmPoolInstanceReusePolicy(warmPoolConfiguration.InstanceReusePolicy)
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWarmPoolInstanceReusePolicy(instanceReusePolicy *autoscaling.InstanceReusePolicy) []interface{} {
+	m := map[string]interface{}{
+		"reuse_on_scale_in": aws.BoolValue(instanceReusePolicy.ReuseOnScaleIn),
+	}
+
 	return []interface{}{m}
 }
 
@@ -2183,9 +2215,23 @@ func CreatePutWarmPoolInput(asgName string, l []interface{}) *autoscaling.PutWar
 		input.MaxGroupPreparedCapacity = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := m["instance_reuse_policy"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		input.InstanceReusePolicy = expandWarmPoolInstanceReusePolicy(v[0].(map[string]interface{}))
+	}
+
 	return &input
 }
 
+func expandWarmPoolInstanceReusePolicy(m map[string]interface{}) *autoscaling.InstanceReusePolicy {
+	instanceReusePolicy := &autoscaling.InstanceReusePolicy{}
+
+	if v, ok := m["reuse_on_scale_in"].(bool); ok {
+		instanceReusePolicy.ReuseOnScaleIn = aws.Bool(v)
+	}
+
+	return instanceReusePolicy
+}
+
 func CreateGroupInstanceRefreshInput(asgName string, l []interface{}) *autoscaling.StartInstanceRefreshInput {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -2233,6 +2279,10 @@ func expandAutoScalingGroupInstanceRefreshPreferences(l []interface{}) *autoscal
 		refreshPreferences.MinHealthyPercentage = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := m["skip_matching"].(bool); ok {
+		refreshPreferences.SkipMatching = aws.Bool(v)
+	}
+
 	return refreshPreferences
 }
 
@@ -984,6 +984,7 @@ func TestAccAutoScalingGroup_InstanceRefresh_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.min_healthy_percentage", "0"),
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_delay", ""),
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_percentages.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.skip_matching", "false"),
 				),
 			},
 			{
@@ -1002,6 +1003,7 @@ func TestAccAutoScalingGroup_InstanceRefresh_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_percentages.2", "25"),
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_percentages.3", "50"),
 					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.checkpoint_percentages.4", "100"),
+					resource.TestCheckResourceAttr(resourceName, "instance_refresh.0.preferences.0.skip_matching", "true"),
 				),
 			},
 			{
@@ -1125,6 +1127,8 @@ func TestAccAutoScalingGroup_warmPool(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.pool_state", "Stopped"),
 					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.min_size", "0"),
 					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.max_group_prepared_capacity", "2"),
+					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.instance_reuse_policy.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "warm_pool.0.instance_reuse_policy.0.reuse_on_scale_in", "true"),
 				),
 			},
 			{
@@ -4546,6 +4550,7 @@ resource "aws_autoscaling_group" "test" {
       min_healthy_percentage = 50
       checkpoint_delay       = 25
       checkpoint_percentages = [1, 20, 25, 50, 100]
+      skip_matching          = true
     }
   }
 }
@@ -4759,6 +4764,10 @@ resource "aws_autoscaling_group" "test" {
     pool_state                  = "Stopped"
     min_size                    = 0
     max_group_prepared_capacity = 2
+
+    instance_reuse_policy {
+      reuse_on_scale_in = true
+    }
   }
 }
 `
@@ -5072,6 +5081,24 @@ func TestCreateAutoScalingGroupInstanceRefreshInput(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "skip_matching",
+			input: []interface{}{map[string]interface{}{
+				"strategy": "Rolling",
+				"preferences": []interface{}{
+					map[string]interface{}{
+						"skip_matching": true,
+					},
+				},
+			}},
+			expected: &autoscaling.StartInstanceRefreshInput{
+				AutoScalingGroupName: aws.String(asgName),
+				Strategy:             aws.String("Rolling"),
+				Preferences: &autoscaling.RefreshPreferences{
+					SkipMatching: aws.Bool(true),
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -5152,6 +5179,22 @@ func TestPutWarmPoolInput(t *testing.T) {
 				MaxGroupPreparedCapacity: aws.Int64(2),
 			},
 		},
+		{
+			name: "instance reuse policy",
+			input: []interface{}{map[string]interface{}{
+				"pool_state": "Hibernated",
+				"instance_reuse_policy": []interface{}{map[string]interface{}{
+					"reuse_on_scale_in": true,
+				}},
+			}},
+			expected: &autoscaling.PutWarmPoolInput{
+				AutoScalingGroupName: aws.String(asgName),
+				PoolState:            aws.String("Hibernated"),
+				InstanceReusePolicy: &autoscaling.InstanceReusePolicy{
+					ReuseOnScaleIn: aws.Bool(true),
+				},
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -5212,6 +5255,23 @@ func TestFlattenWarmPoolConfiguration(t *testing.T) {
 				"max_group_prepared_capacity": int64(5),
 			}},
 		},
+		{
+			name: "instance reuse policy",
+			input: &autoscaling.WarmPoolConfiguration{
+				PoolState: aws.String("Stopped"),
+				InstanceReusePolicy: &autoscaling.InstanceReusePolicy{
+					ReuseOnScaleIn: aws.Bool(true),
+				},
+			},
+			expected: []interface{}{map[string]interface{}{
+				"pool_state":                  "Stopped",
+				"min_size":                    int64(0),
+				"max_group_prepared_capacity": int64(-1),
+				"instance_reuse_policy": []interface{}{map[string]interface{}{
+					"reuse_on_scale_in": true,
+				}},
+			}},
+		},
 	}
 
 	for _, testCase := range testCases {
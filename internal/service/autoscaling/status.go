@@ -4,6 +4,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func statusInstanceRefresh(conn *autoscaling.AutoScaling, asgName, instanceRefreshId string) resource.StateRefreshFunc {
@@ -26,3 +27,19 @@ func statusInstanceRefresh(conn *autoscaling.AutoScaling, asgName, instanceRefre
 		return instanceRefresh, aws.StringValue(instanceRefresh.Status), nil
 	}
 }
+
+func statusTrafficSourceAttachment(conn *autoscaling.AutoScaling, asgName, trafficSourceType, trafficSourceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindTrafficSourceAttachmentByThreePartKey(conn, asgName, trafficSourceType, trafficSourceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
@@ -6,24 +6,22 @@ package autoscaling
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
-	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/autoscaling"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_autoscaling_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_autoscaling_group", &resource.Sweeper{
 		Name: "aws_autoscaling_group",
 		F:    sweepGroups,
 	})
 
-	resource.AddTestSweepers("aws_launch_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_launch_configuration", &resource.Sweeper{
 		Name:         "aws_launch_configuration",
 		Dependencies: []string{"aws_autoscaling_group"},
 		F:            sweepLaunchConfigurations,
@@ -36,50 +34,41 @@ func sweepGroups(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).AutoScalingConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	resp, err := conn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Auto Scaling Group sweep for %s: %s", region, err)
-			return nil
+	err = conn.DescribeAutoScalingGroupsPages(&autoscaling.DescribeAutoScalingGroupsInput{}, func(page *autoscaling.DescribeAutoScalingGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
-		return fmt.Errorf("Error retrieving Auto Scaling Groups in Sweeper: %s", err)
-	}
 
-	if len(resp.AutoScalingGroups) == 0 {
-		log.Print("[DEBUG] No Auto Scaling Groups to sweep")
-		return nil
-	}
+		for _, asg := range page.AutoScalingGroups {
+			r := ResourceGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(asg.AutoScalingGroupName))
+			d.Set("force_delete", true)
+			d.Set("name", asg.AutoScalingGroupName)
 
-	for _, asg := range resp.AutoScalingGroups {
-		deleteopts := autoscaling.DeleteAutoScalingGroupInput{
-			AutoScalingGroupName: asg.AutoScalingGroupName,
-			ForceDelete:          aws.Bool(true),
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			if _, err := conn.DeleteAutoScalingGroup(&deleteopts); err != nil {
-				if awserr, ok := err.(awserr.Error); ok {
-					switch awserr.Code() {
-					case "InvalidGroup.NotFound":
-						return nil
-					case "ResourceInUse", "ScalingActivityInProgress":
-						return resource.RetryableError(awserr)
-					}
-				}
-
-				// Didn't recognize the error, so shouldn't retry.
-				return resource.NonRetryableError(err)
-			}
-			// Successful delete
-			return nil
-		})
-		if err != nil {
-			return err
-		}
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Auto Scaling Groups for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Auto Scaling Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Auto Scaling Group sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepLaunchConfigurations(region string) error {
@@ -88,36 +77,38 @@ func sweepLaunchConfigurations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).AutoScalingConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	resp, err := conn.DescribeLaunchConfigurations(&autoscaling.DescribeLaunchConfigurationsInput{})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping AutoScaling Launch Configuration sweep for %s: %s", region, err)
-			return nil
+	err = conn.DescribeLaunchConfigurationsPages(&autoscaling.DescribeLaunchConfigurationsInput{}, func(page *autoscaling.DescribeLaunchConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, lc := range page.LaunchConfigurations {
+			r := ResourceLaunchConfiguration()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(lc.LaunchConfigurationName))
+			d.Set("name", lc.LaunchConfigurationName)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-		return fmt.Errorf("Error retrieving launch configuration: %s", err)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Auto Scaling Launch Configurations for %s: %w", region, err))
 	}
 
-	if len(resp.LaunchConfigurations) == 0 {
-		log.Print("[DEBUG] No aws launch configurations to sweep")
-		return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Auto Scaling Launch Configurations for %s: %w", region, err))
 	}
 
-	for _, lc := range resp.LaunchConfigurations {
-		name := aws.StringValue(lc.LaunchConfigurationName)
-
-		log.Printf("[INFO] Deleting Launch Configuration: %s", name)
-		_, err := conn.DeleteLaunchConfiguration(
-			&autoscaling.DeleteLaunchConfigurationInput{
-				LaunchConfigurationName: aws.String(name),
-			})
-		if err != nil {
-			if tfawserr.ErrMessageContains(err, "InvalidConfiguration.NotFound", "") || tfawserr.ErrMessageContains(err, "ValidationError", "") {
-				return nil
-			}
-			return err
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Auto Scaling Launch Configuration sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
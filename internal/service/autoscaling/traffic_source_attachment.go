@@ -0,0 +1,203 @@
+package autoscaling
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceTrafficSourceAttachment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTrafficSourceAttachmentCreate,
+		Read:   resourceTrafficSourceAttachmentRead,
+		Delete: resourceTrafficSourceAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"autoscaling_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"traffic_source": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(TrafficSourceType_Values(), false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceTrafficSourceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AutoScalingConn
+
+	asgName := d.Get("autoscaling_group_name").(string)
+	trafficSource := expandTrafficSourceIdentifier(d.Get("traffic_source").([]interface{})[0].(map[string]interface{}))
+	trafficSourceType := aws.StringValue(trafficSource.Type)
+	trafficSourceID := aws.StringValue(trafficSource.Identifier)
+	id := TrafficSourceAttachmentCreateResourceID(asgName, trafficSourceType, trafficSourceID)
+	input := &autoscaling.AttachTrafficSourcesInput{
+		AutoScalingGroupName: aws.String(asgName),
+		TrafficSources:       []*autoscaling.TrafficSourceIdentifier{trafficSource},
+	}
+
+	log.Printf("[DEBUG] Creating Auto Scaling Traffic Source Attachment: %s", input)
+	_, err := conn.AttachTrafficSources(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Auto Scaling Traffic Source Attachment (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitTrafficSourceAttachmentCreated(conn, asgName, trafficSourceType, trafficSourceID); err != nil {
+		return fmt.Errorf("error waiting for Auto Scaling Traffic Source Attachment (%s) create: %w", id, err)
+	}
+
+	return resourceTrafficSourceAttachmentRead(d, meta)
+}
+
+func resourceTrafficSourceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AutoScalingConn
+
+	asgName, trafficSourceType, trafficSourceID, err := TrafficSourceAttachmentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	output, err := FindTrafficSourceAttachmentByThreePartKey(conn, asgName, trafficSourceType, trafficSourceID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Auto Scaling Traffic Source Attachment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Auto Scaling Traffic Source Attachment (%s): %w", d.Id(), err)
+	}
+
+	d.Set("autoscaling_group_name", asgName)
+	if err := d.Set("traffic_source", []interface{}{flattenTrafficSourceState(output)}); err != nil {
+		return fmt.Errorf("error setting traffic_source: %w", err)
+	}
+
+	return nil
+}
+
+func resourceTrafficSourceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AutoScalingConn
+
+	asgName, trafficSourceType, trafficSourceID, err := TrafficSourceAttachmentParseResourceID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting Auto Scaling Traffic Source Attachment: %s", d.Id())
+	_, err = conn.DetachTrafficSources(&autoscaling.DetachTrafficSourcesInput{
+		AutoScalingGroupName: aws.String(asgName),
+		TrafficSources: []*autoscaling.TrafficSourceIdentifier{{
+			Identifier: aws.String(trafficSourceID),
+			Type:       aws.String(trafficSourceType),
+		}},
+	})
+
+	if tfawserr.ErrMessageContains(err, ErrCodeValidationError, "not found") {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Auto Scaling Traffic Source Attachment (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitTrafficSourceAttachmentDeleted(conn, asgName, trafficSourceType, trafficSourceID); err != nil {
+		return fmt.Errorf("error waiting for Auto Scaling Traffic Source Attachment (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+const trafficSourceAttachmentIDSeparator = ","
+
+func TrafficSourceAttachmentCreateResourceID(asgName, trafficSourceType, trafficSourceID string) string {
+	parts := []string{asgName, trafficSourceType, trafficSourceID}
+	id := strings.Join(parts, trafficSourceAttachmentIDSeparator)
+
+	return id
+}
+
+func TrafficSourceAttachmentParseResourceID(id string) (string, string, string, error) {
+	parts := strings.SplitN(id, trafficSourceAttachmentIDSeparator, 3)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected AUTOSCALING-GROUP-NAME%[2]sTYPE%[2]sIDENTIFIER", id, trafficSourceAttachmentIDSeparator)
+}
+
+func expandTrafficSourceIdentifier(tfMap map[string]interface{}) *autoscaling.TrafficSourceIdentifier {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &autoscaling.TrafficSourceIdentifier{}
+
+	if v, ok := tfMap["identifier"].(string); ok && v != "" {
+		apiObject.Identifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenTrafficSourceState(apiObject *autoscaling.TrafficSourceState) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.Identifier; v != nil {
+		tfMap["identifier"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -0,0 +1,210 @@
+package autoscaling_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccAutoScalingTrafficSourceAttachment_elbv2(t *testing.T) {
+	resourceName := "aws_autoscaling_traffic_source_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficSourceAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficSourceAttachmentConfig_targetGroup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficSourceAttachmentExists(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "autoscaling_group_name", "aws_autoscaling_group.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_source.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_source.0.identifier", "aws_lb_target_group.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_source.0.type", "elbv2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingTrafficSourceAttachment_disappears(t *testing.T) {
+	resourceName := "aws_autoscaling_traffic_source_attachment.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficSourceAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficSourceAttachmentConfig_targetGroup(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficSourceAttachmentExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfautoscaling.ResourceTrafficSourceAttachment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingTrafficSourceAttachment_multiple(t *testing.T) {
+	resourceName1 := "aws_autoscaling_traffic_source_attachment.test.0"
+	resourceName2 := "aws_autoscaling_traffic_source_attachment.test.1"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, autoscaling.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficSourceAttachmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrafficSourceAttachmentConfig_multiple(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficSourceAttachmentExists(resourceName1),
+					testAccCheckTrafficSourceAttachmentExists(resourceName2),
+					resource.TestCheckResourceAttrPair(resourceName1, "traffic_source.0.identifier", "aws_lb_target_group.test.0", "arn"),
+					resource.TestCheckResourceAttrPair(resourceName2, "traffic_source.0.identifier", "aws_lb_target_group.test.1", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTrafficSourceAttachmentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_autoscaling_traffic_source_attachment" {
+			continue
+		}
+
+		asgName, trafficSourceType, trafficSourceID, err := tfautoscaling.TrafficSourceAttachmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfautoscaling.FindTrafficSourceAttachmentByThreePartKey(conn, asgName, trafficSourceType, trafficSourceID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Auto Scaling Traffic Source Attachment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckTrafficSourceAttachmentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Auto Scaling Traffic Source Attachment ID is set")
+		}
+
+		asgName, trafficSourceType, trafficSourceID, err := tfautoscaling.TrafficSourceAttachmentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingConn
+
+		_, err = tfautoscaling.FindTrafficSourceAttachmentByThreePartKey(conn, asgName, trafficSourceType, trafficSourceID)
+
+		return err
+	}
+}
+
+func testAccTrafficSourceAttachmentConfigBase(rName string, targetGroupCount int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHvmEbsAmi(),
+		acctest.ConfigVpcWithSubnets(1),
+		fmt.Sprintf(`
+resource "aws_lb_target_group" "test" {
+  count = %[2]d
+
+  port     = 80
+  protocol = "HTTP"
+  vpc_id   = aws_vpc.test.id
+}
+
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  instance_type = "t3.micro"
+}
+
+resource "aws_autoscaling_group" "test" {
+  name                = %[1]q
+  vpc_zone_identifier = aws_subnet.test[*].id
+  max_size            = 0
+  min_size            = 0
+  desired_capacity    = 0
+
+  launch_template {
+    id = aws_launch_template.test.id
+  }
+
+  lifecycle {
+    ignore_changes = [load_balancers, target_group_arns]
+  }
+}
+`, rName, targetGroupCount))
+}
+
+func testAccTrafficSourceAttachmentConfig_targetGroup(rName string) string {
+	return acctest.ConfigCompose(testAccTrafficSourceAttachmentConfigBase(rName, 1), `
+resource "aws_autoscaling_traffic_source_attachment" "test" {
+  autoscaling_group_name = aws_autoscaling_group.test.id
+
+  traffic_source {
+    identifier = aws_lb_target_group.test[0].arn
+    type       = "elbv2"
+  }
+}
+`)
+}
+
+func testAccTrafficSourceAttachmentConfig_multiple(rName string) string {
+	return acctest.ConfigCompose(testAccTrafficSourceAttachmentConfigBase(rName, 2), `
+resource "aws_autoscaling_traffic_source_attachment" "test" {
+  count = 2
+
+  autoscaling_group_name = aws_autoscaling_group.test.id
+
+  traffic_source {
+    identifier = aws_lb_target_group.test[count.index].arn
+    type       = "elbv2"
+  }
+}
+`)
+}
@@ -42,3 +42,42 @@ func waitInstanceRefreshCancelled(conn *autoscaling.AutoScaling, asgName, instan
 
 	return nil, err
 }
+
+const (
+	trafficSourceAttachmentCreatedTimeout = 10 * time.Minute
+	trafficSourceAttachmentDeletedTimeout = 10 * time.Minute
+)
+
+func waitTrafficSourceAttachmentCreated(conn *autoscaling.AutoScaling, asgName, trafficSourceType, trafficSourceID string) (*autoscaling.TrafficSourceState, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{TrafficSourceStateAdding},
+		Target:  []string{TrafficSourceStateAdded, TrafficSourceStateInService},
+		Refresh: statusTrafficSourceAttachment(conn, asgName, trafficSourceType, trafficSourceID),
+		Timeout: trafficSourceAttachmentCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*autoscaling.TrafficSourceState); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTrafficSourceAttachmentDeleted(conn *autoscaling.AutoScaling, asgName, trafficSourceType, trafficSourceID string) (*autoscaling.TrafficSourceState, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{TrafficSourceStateRemoving},
+		Target:  []string{},
+		Refresh: statusTrafficSourceAttachment(conn, asgName, trafficSourceType, trafficSourceID),
+		Timeout: trafficSourceAttachmentDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*autoscaling.TrafficSourceState); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -9,29 +9,31 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/backup"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_lock_configuration", &resource.Sweeper{
 		Name: "aws_backup_vault_lock_configuration",
 		F:    sweepVaultLockConfiguration,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_notifications", &resource.Sweeper{
 		Name: "aws_backup_vault_notifications",
 		F:    sweepVaultNotifications,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault_policy", &resource.Sweeper{
 		Name: "aws_backup_vault_policy",
 		F:    sweepVaultPolicies,
 	})
 
-	resource.AddTestSweepers("aws_backup_vault", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_backup_vault", &resource.Sweeper{
 		Name: "aws_backup_vault",
 		F:    sweepVaults,
 		Dependencies: []string{
@@ -190,8 +192,8 @@ func sweepVaults(region string) error {
 	}
 	conn := client.(*conns.AWSClient).BackupConn
 	input := &backup.ListBackupVaultsInput{}
-	var sweeperErrs *multierror.Error
 	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListBackupVaultsPages(input, func(page *backup.ListBackupVaultsOutput, lastPage bool) bool {
 		if page == nil {
@@ -199,38 +201,7 @@ func sweepVaults(region string) error {
 		}
 
 		for _, vault := range page.BackupVaultList {
-			failedToDeleteRecoveryPoint := false
 			name := aws.StringValue(vault.BackupVaultName)
-			input := &backup.ListRecoveryPointsByBackupVaultInput{
-				BackupVaultName: aws.String(name),
-			}
-
-			err := conn.ListRecoveryPointsByBackupVaultPages(input, func(page *backup.ListRecoveryPointsByBackupVaultOutput, lastPage bool) bool {
-				if page == nil {
-					return !lastPage
-				}
-
-				for _, recoveryPoint := range page.RecoveryPoints {
-					arn := aws.StringValue(recoveryPoint.RecoveryPointArn)
-
-					log.Printf("[INFO] Deleting Recovery Point (%s) in Backup Vault (%s)", arn, name)
-					_, err := conn.DeleteRecoveryPoint(&backup.DeleteRecoveryPointInput{
-						BackupVaultName:  aws.String(name),
-						RecoveryPointArn: aws.String(arn),
-					})
-
-					if err != nil {
-						log.Printf("[WARN] Failed to delete Recovery Point (%s) in Backup Vault (%s): %s", arn, name, err)
-						failedToDeleteRecoveryPoint = true
-					}
-				}
-
-				return !lastPage
-			})
-
-			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Reovery Points in Backup Vault (%s) for %s: %w", name, region, err))
-			}
 
 			// Ignore Default and Automatic EFS Backup Vaults in region (cannot be deleted)
 			if name == "Default" || name == "aws/efs/automatic-backup-vault" {
@@ -238,16 +209,10 @@ func sweepVaults(region string) error {
 				continue
 			}
 
-			// Backup Vault deletion only supported when empty
-			// Reference: https://docs.aws.amazon.com/aws-backup/latest/devguide/API_DeleteBackupVault.html
-			if failedToDeleteRecoveryPoint {
-				log.Printf("[INFO] Skipping Backup Vault (%s): not empty", name)
-				continue
-			}
-
-			r := ResourceVault()
+			r := resourceVaultWithRecoveryPoints()
 			d := r.Data(nil)
 			d.SetId(name)
+			d.Set("name", name)
 
 			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
@@ -255,18 +220,73 @@ func sweepVaults(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Backup Vaults sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Backup Vaults for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Backup Vaults for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Backup Vaults sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// resourceVaultWithRecoveryPoints is a Backup Vault whose recovery points are deleted
+// before the vault itself, as Backup Vault deletion is only supported when empty.
+// Reference: https://docs.aws.amazon.com/aws-backup/latest/devguide/API_DeleteBackupVault.html
+func resourceVaultWithRecoveryPoints() *schema.Resource {
+	r := ResourceVault()
+	r.Delete = resourceVaultWithRecoveryPointsDelete
+
+	return r
+}
+
+func resourceVaultWithRecoveryPointsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).BackupConn
+	var errs *multierror.Error
+
+	input := &backup.ListRecoveryPointsByBackupVaultInput{
+		BackupVaultName: aws.String(d.Id()),
+	}
+
+	err := conn.ListRecoveryPointsByBackupVaultPages(input, func(page *backup.ListRecoveryPointsByBackupVaultOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, recoveryPoint := range page.RecoveryPoints {
+			arn := aws.StringValue(recoveryPoint.RecoveryPointArn)
+
+			log.Printf("[INFO] Deleting Recovery Point (%s) in Backup Vault (%s)", arn, d.Id())
+			_, err := conn.DeleteRecoveryPoint(&backup.DeleteRecoveryPointInput{
+				BackupVaultName:  aws.String(d.Id()),
+				RecoveryPointArn: aws.String(arn),
+			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error deleting Recovery Point (%s) in Backup Vault (%s): %w", arn, d.Id(), err))
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, backup.ErrCodeResourceNotFoundException) {
+		return nil
 	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Backup Vaults for %s: %w", region, err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing Recovery Points in Backup Vault (%s): %w", d.Id(), err))
 	}
 
-	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error sweeping Backup Vaults for %s: %w", region, err))
+	if err := errs.ErrorOrNil(); err != nil {
+		return err
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return resourceVaultDelete(d, meta)
 }
@@ -14,12 +14,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_compute_environment", &resource.Sweeper{
 		Name: "aws_batch_compute_environment",
 		Dependencies: []string{
 			"aws_batch_job_queue",
@@ -27,7 +28,7 @@ func init() {
 		F: sweepComputeEnvironments,
 	})
 
-	resource.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_definition", &resource.Sweeper{
 		Name: "aws_batch_job_definition",
 		F:    sweepJobDefinitions,
 		Dependencies: []string{
@@ -35,7 +36,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_batch_job_queue", &resource.Sweeper{
 		Name: "aws_batch_job_queue",
 		F:    sweepJobQueues,
 	})
@@ -49,12 +50,10 @@ func sweepComputeEnvironments(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).BatchConn
-	iamconn := client.(*conns.AWSClient).IAMConn
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &batch.DescribeComputeEnvironmentsInput{}
-	r := ResourceComputeEnvironment()
 
 	err = conn.DescribeComputeEnvironmentsPages(input, func(page *batch.DescribeComputeEnvironmentsOutput, lastPage bool) bool {
 		if page == nil {
@@ -64,105 +63,115 @@ func sweepComputeEnvironments(region string) error {
 		for _, computeEnvironment := range page.ComputeEnvironments {
 			name := aws.StringValue(computeEnvironment.ComputeEnvironmentName)
 
+			r := resourceComputeEnvironmentWithServiceRole()
 			d := r.Data(nil)
 			d.SetId(name)
 			d.Set("compute_environment_name", name)
+			d.Set("service_role", computeEnvironment.ServiceRole)
+			d.Set("status", computeEnvironment.Status)
+			d.Set("tags_all", aws.StringValueMap(computeEnvironment.Tags))
 
-			// Reference: https://aws.amazon.com/premiumsupport/knowledge-center/batch-invalid-compute-environment/
-			//
-			// When a Compute Environment becomes INVALID, it is typically because the associated
-			// IAM Role has disappeared. There is no automatic resolution via the API, except to
-			// associate a new IAM Role that is valid, then delete the Compute Environment.
-			//
-			// We avoid doing this in the resource because it would be very unexpected behavior
-			// for the resource and this issue should be fixed in the API (e.g. Service Linked Role).
-			//
-			// To save writing much more logic around IAM Role deletion, we allow the
-			// aws_iam_role sweeper to handle cleaning these up.
-			if aws.StringValue(computeEnvironment.Status) == batch.CEStatusInvalid {
-				// Reusing the IAM Role name to prevent collisions and inventing a naming scheme
-				serviceRoleARN, err := arn.Parse(aws.StringValue(computeEnvironment.ServiceRole))
-
-				if err != nil {
-					sweeperErr := fmt.Errorf("error parsing Batch Compute Environment (%s) Service Role ARN (%s): %w", name, aws.StringValue(computeEnvironment.ServiceRole), err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
-
-				servicePrincipal := fmt.Sprintf("%s.%s", batch.EndpointsID, sweep.PartitionDNSSuffix(region))
-				serviceRoleName := strings.TrimPrefix(serviceRoleARN.Resource, "role/")
-				serviceRolePolicyARN := arn.ARN{
-					AccountID: "aws",
-					Partition: sweep.Partition(region),
-					Resource:  "policy/service-role/AWSBatchServiceRole",
-					Service:   iam.ServiceName,
-				}.String()
-
-				iamCreateRoleInput := &iam.CreateRoleInput{
-					AssumeRolePolicyDocument: aws.String(fmt.Sprintf("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\": \"%s\"},\"Action\":\"sts:AssumeRole\"}]}", servicePrincipal)),
-					RoleName:                 aws.String(serviceRoleName),
-				}
-
-				_, err = iamconn.CreateRole(iamCreateRoleInput)
-
-				if err != nil {
-					sweeperErr := fmt.Errorf("error creating IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
-
-				iamGetRoleInput := &iam.GetRoleInput{
-					RoleName: aws.String(serviceRoleName),
-				}
-
-				err = iamconn.WaitUntilRoleExists(iamGetRoleInput)
-
-				if err != nil {
-					sweeperErr := fmt.Errorf("error waiting for IAM Role (%s) creation for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
-
-				iamAttachRolePolicyInput := &iam.AttachRolePolicyInput{
-					PolicyArn: aws.String(serviceRolePolicyARN),
-					RoleName:  aws.String(serviceRoleName),
-				}
-
-				_, err = iamconn.AttachRolePolicy(iamAttachRolePolicyInput)
-
-				if err != nil {
-					sweeperErr := fmt.Errorf("error attaching Batch IAM Policy (%s) to IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRolePolicyARN, serviceRoleName, name, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					continue
-				}
-			}
-
-			err := r.Delete(d, client)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Batch Compute Environment (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Batch Compute Environment sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Batch Compute Environments for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Batch Compute Environments for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Batch Compute Environment sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// resourceComputeEnvironmentWithServiceRole is a Batch Compute Environment whose service
+// IAM Role is recreated before deletion if the Compute Environment is INVALID.
+//
+// Reference: https://aws.amazon.com/premiumsupport/knowledge-center/batch-invalid-compute-environment/
+//
+// When a Compute Environment becomes INVALID, it is typically because the associated
+// IAM Role has disappeared. There is no automatic resolution via the API, except to
+// associate a new IAM Role that is valid, then delete the Compute Environment.
+//
+// We avoid doing this in the resource because it would be very unexpected behavior
+// for the resource and this issue should be fixed in the API (e.g. Service Linked Role).
+//
+// To save writing much more logic around IAM Role deletion, we allow the
+// aws_iam_role sweeper to handle cleaning these up.
+func resourceComputeEnvironmentWithServiceRole() *schema.Resource {
+	r := ResourceComputeEnvironment()
+	r.Delete = resourceComputeEnvironmentWithServiceRoleDelete
+
+	return r
+}
+
+func resourceComputeEnvironmentWithServiceRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	if d.Get("status").(string) != batch.CEStatusInvalid {
+		return resourceComputeEnvironmentDelete(d, meta)
 	}
+
+	client := meta.(*conns.AWSClient)
+	iamconn := client.IAMConn
+	name := d.Id()
+
+	// Reusing the IAM Role name to prevent collisions and inventing a naming scheme
+	serviceRoleARN, err := arn.Parse(d.Get("service_role").(string))
+
+	if err != nil {
+		return fmt.Errorf("error parsing Batch Compute Environment (%s) Service Role ARN (%s): %w", name, d.Get("service_role").(string), err)
+	}
+
+	servicePrincipal := fmt.Sprintf("%s.%s", batch.EndpointsID, client.DNSSuffix)
+	serviceRoleName := strings.TrimPrefix(serviceRoleARN.Resource, "role/")
+	serviceRolePolicyARN := arn.ARN{
+		AccountID: "aws",
+		Partition: client.Partition,
+		Resource:  "policy/service-role/AWSBatchServiceRole",
+		Service:   iam.ServiceName,
+	}.String()
+
+	iamCreateRoleInput := &iam.CreateRoleInput{
+		AssumeRolePolicyDocument: aws.String(fmt.Sprintf("{\"Version\":\"2012-10-17\",\"Statement\":[{\"Effect\":\"Allow\",\"Principal\":{\"Service\": \"%s\"},\"Action\":\"sts:AssumeRole\"}]}", servicePrincipal)),
+		RoleName:                 aws.String(serviceRoleName),
+	}
+
+	_, err = iamconn.CreateRole(iamCreateRoleInput)
+
+	if err != nil {
+		return fmt.Errorf("error creating IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err)
+	}
+
+	iamGetRoleInput := &iam.GetRoleInput{
+		RoleName: aws.String(serviceRoleName),
+	}
+
+	err = iamconn.WaitUntilRoleExists(iamGetRoleInput)
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Batch Compute Environments: %w", err))
+		return fmt.Errorf("error waiting for IAM Role (%s) creation for INVALID Batch Compute Environment (%s): %w", serviceRoleName, name, err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	iamAttachRolePolicyInput := &iam.AttachRolePolicyInput{
+		PolicyArn: aws.String(serviceRolePolicyARN),
+		RoleName:  aws.String(serviceRoleName),
+	}
+
+	_, err = iamconn.AttachRolePolicy(iamAttachRolePolicyInput)
+
+	if err != nil {
+		return fmt.Errorf("error attaching Batch IAM Policy (%s) to IAM Role (%s) for INVALID Batch Compute Environment (%s): %w", serviceRolePolicyARN, serviceRoleName, name, err)
+	}
+
+	return resourceComputeEnvironmentDelete(d, meta)
 }
 
 func sweepJobDefinitions(region string) error {
@@ -174,7 +183,8 @@ func sweepJobDefinitions(region string) error {
 	input := &batch.DescribeJobDefinitionsInput{
 		Status: aws.String("ACTIVE"),
 	}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.DescribeJobDefinitionsPages(input, func(page *batch.DescribeJobDefinitionsOutput, lastPage bool) bool {
 		if page == nil {
@@ -182,31 +192,32 @@ func sweepJobDefinitions(region string) error {
 		}
 
 		for _, jobDefinition := range page.JobDefinitions {
-			arn := aws.StringValue(jobDefinition.JobDefinitionArn)
-
-			log.Printf("[INFO] Deleting Batch Job Definition: %s", arn)
-			_, err := conn.DeregisterJobDefinition(&batch.DeregisterJobDefinitionInput{
-				JobDefinition: aws.String(arn),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Batch Job Definition (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			r := ResourceJobDefinition()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(jobDefinition.JobDefinitionArn))
+			d.Set("name", jobDefinition.JobDefinitionName)
+			d.Set("tags_all", aws.StringValueMap(jobDefinition.Tags))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Batch Job Definitions sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Batch Job Definitions: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing Batch Job Definitions for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Batch Job Definitions for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Batch Job Definitions sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepJobQueues(region string) error {
@@ -215,31 +226,39 @@ func sweepJobQueues(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).BatchConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	out, err := conn.DescribeJobQueues(&batch.DescribeJobQueuesInput{})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Batch Job Queue sweep for %s: %s", region, err)
-			return nil
+	err = conn.DescribeJobQueuesPages(&batch.DescribeJobQueuesInput{}, func(page *batch.DescribeJobQueuesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
-		return fmt.Errorf("Error retrieving Batch Job Queues: %s", err)
-	}
-	for _, jobQueue := range out.JobQueues {
-		name := jobQueue.JobQueueName
 
-		log.Printf("[INFO] Disabling Batch Job Queue: %s", *name)
-		err := DisableJobQueue(*name, conn)
-		if err != nil {
-			log.Printf("[ERROR] Failed to disable Batch Job Queue %s: %s", *name, err)
-			continue
-		}
+		for _, jobQueue := range page.JobQueues {
+			r := ResourceJobQueue()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(jobQueue.JobQueueArn))
+			d.Set("name", jobQueue.JobQueueName)
+			d.Set("tags_all", aws.StringValueMap(jobQueue.Tags))
 
-		log.Printf("[INFO] Deleting Batch Job Queue: %s", *name)
-		err = DeleteJobQueue(*name, conn)
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete Batch Job Queue %s: %s", *name, err)
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Batch Job Queues for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Batch Job Queues for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Batch Job Queue sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
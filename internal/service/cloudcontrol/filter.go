@@ -0,0 +1,93 @@
+package cloudcontrol
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/jmespath/go-jmespath"
+)
+
+// resourcePropertyFilter matches resources whose property, selected by a JMESPath expression, has one of the specified values.
+type resourcePropertyFilter struct {
+	expression *jmespath.JMESPath
+	values     map[string]bool
+}
+
+// resourcePropertiesMatch returns whether the specified resource properties match the JMESPath query and all filters.
+func resourcePropertiesMatch(properties interface{}, query *jmespath.JMESPath, filters []*resourcePropertyFilter) (bool, error) {
+	if query != nil {
+		v, err := query.Search(properties)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !jmespathTruthy(v) {
+			return false, nil
+		}
+	}
+
+	for _, filter := range filters {
+		v, err := filter.expression.Search(properties)
+
+		if err != nil {
+			return false, err
+		}
+
+		if !filter.matches(v) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// matches returns whether the property value, or for arrays any of the element values, is one of the filter values.
+func (f *resourcePropertyFilter) matches(v interface{}) bool {
+	switch v := v.(type) {
+	case []interface{}:
+		for _, v := range v {
+			if f.matches(v) {
+				return true
+			}
+		}
+
+		return false
+	case string:
+		return f.values[v]
+	case float64:
+		return f.values[strconv.FormatFloat(v, 'f', -1, 64)]
+	case bool:
+		return f.values[strconv.FormatBool(v)]
+	default:
+		return false
+	}
+}
+
+// jmespathTruthy returns whether the value is true according to the JMESPath specification.
+func jmespathTruthy(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case []interface{}:
+		return len(v) > 0
+	case map[string]interface{}:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+func validJMESPathExpression(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if _, err := jmespath.Compile(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid JMESPath expression: %w", k, err))
+	}
+
+	return
+}
@@ -0,0 +1,158 @@
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jmespath/go-jmespath"
+)
+
+func TestResourcePropertiesMatch(t *testing.T) {
+	properties := `{"LogGroupName":"test","RetentionInDays":7,"Encrypted":true,"Tags":[{"Key":"Environment","Value":"production"}]}`
+
+	testCases := []struct {
+		TestName string
+		Query    string
+		Filters  map[string][]string
+		Expected bool
+	}{
+		{
+			TestName: "no query or filters",
+			Expected: true,
+		},
+		{
+			TestName: "query match",
+			Query:    "RetentionInDays > `1`",
+			Expected: true,
+		},
+		{
+			TestName: "query no match",
+			Query:    "RetentionInDays > `30`",
+			Expected: false,
+		},
+		{
+			TestName: "query empty result",
+			Query:    "Tags[?Key=='Owner']",
+			Expected: false,
+		},
+		{
+			TestName: "string filter match",
+			Filters:  map[string][]string{"LogGroupName": {"other", "test"}},
+			Expected: true,
+		},
+		{
+			TestName: "number filter match",
+			Filters:  map[string][]string{"RetentionInDays": {"7"}},
+			Expected: true,
+		},
+		{
+			TestName: "boolean filter match",
+			Filters:  map[string][]string{"Encrypted": {"true"}},
+			Expected: true,
+		},
+		{
+			TestName: "array filter match",
+			Filters:  map[string][]string{"Tags[?Key=='Environment'].Value": {"production"}},
+			Expected: true,
+		},
+		{
+			TestName: "missing property filter",
+			Filters:  map[string][]string{"KmsKeyId": {"test"}},
+			Expected: false,
+		},
+		{
+			TestName: "all filters must match",
+			Filters:  map[string][]string{"LogGroupName": {"test"}, "RetentionInDays": {"30"}},
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			var v interface{}
+
+			if err := json.Unmarshal([]byte(properties), &v); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var query *jmespath.JMESPath
+
+			if testCase.Query != "" {
+				query = jmespath.MustCompile(testCase.Query)
+			}
+
+			var filters []*resourcePropertyFilter
+
+			for name, values := range testCase.Filters {
+				filter := &resourcePropertyFilter{
+					expression: jmespath.MustCompile(name),
+					values:     make(map[string]bool),
+				}
+
+				for _, value := range values {
+					filter.values[value] = true
+				}
+
+				filters = append(filters, filter)
+			}
+
+			got, err := resourcePropertiesMatch(v, query, filters)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestExpandResourcePropertyFilters(t *testing.T) {
+	testCases := []struct {
+		TestName    string
+		Name        string
+		ExpectError bool
+	}{
+		{
+			TestName: "valid expression",
+			Name:     "Tags[?Key=='Environment'].Value | [0]",
+		},
+		{
+			TestName:    "invalid expression",
+			Name:        "Tags[?Key==",
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			tfList := []interface{}{
+				map[string]interface{}{
+					"name":   testCase.Name,
+					"values": schema.NewSet(schema.HashString, []interface{}{"production"}),
+				},
+			}
+
+			got, err := expandResourcePropertyFilters(tfList)
+
+			if testCase.ExpectError {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("got %d filters, expected 1", len(got))
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package cloudcontrol
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/mattbaird/jsonpatch"
+)
+
+const (
+	// propertyPathWildcard matches any array index in a CloudFormation resource schema property path.
+	propertyPathWildcard = "*"
+)
+
+// parseResourceSchema parses a CloudFormation resource schema document.
+func parseResourceSchema(s string) (*cfschema.Resource, error) {
+	cfResourceSchema, err := cfschema.NewResourceJsonSchemaDocument(cfschema.Sanitize(s))
+
+	if err != nil {
+		return nil, fmt.Errorf("error parsing CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	cfResource, err := cfResourceSchema.Resource()
+
+	if err != nil {
+		return nil, fmt.Errorf("error converting CloudFormation Resource Schema JSON: %w", err)
+	}
+
+	return cfResource, nil
+}
+
+// propertyPath splits a JSON Pointer (RFC 6901) into its unescaped reference tokens.
+func propertyPath(pointer string) []string {
+	if pointer == "" {
+		return nil
+	}
+
+	path := strings.Split(strings.TrimPrefix(pointer, cfschema.JsonPointerReferenceTokenSeparator), cfschema.JsonPointerReferenceTokenSeparator)
+	unescaper := strings.NewReplacer("~1", "/", "~0", "~")
+
+	for i, token := range path {
+		path[i] = unescaper.Replace(token)
+	}
+
+	return path
+}
+
+// propertyPathIn returns whether the specified path is one of, or is nested under one of, the specified properties.
+func propertyPathIn(ptrs cfschema.PropertyJsonPointers, path []string) bool {
+	for _, ptr := range ptrs {
+		ptrPath := ptr.Path()
+
+		if len(ptrPath) > len(path) {
+			continue
+		}
+
+		matches := true
+
+		for i, token := range ptrPath {
+			if token != path[i] && token != propertyPathWildcard {
+				matches = false
+
+				break
+			}
+		}
+
+		if matches {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isCreateOnlyPropertyPath returns whether changing the property at the specified path requires replacement.
+func isCreateOnlyPropertyPath(cfResource *cfschema.Resource, path []string) bool {
+	return propertyPathIn(cfResource.CreateOnlyProperties, path)
+}
+
+// isUnreadablePropertyPath returns whether the property at the specified path cannot be compared with
+// the resource's current properties, either because the property is never returned (write-only)
+// or because it is not configurable (read-only).
+func isUnreadablePropertyPath(cfResource *cfschema.Resource, path []string) bool {
+	return propertyPathIn(cfResource.WriteOnlyProperties, path) || propertyPathIn(cfResource.ReadOnlyProperties, path)
+}
+
+// desiredStateDrift returns the desired state with the value of each configured property replaced by its current value.
+// Only properties present in the desired state are compared, so values defaulted by the service are not reported as drift.
+// Write-only and read-only properties are never reported as drift.
+// The returned bool indicates whether any drift was detected.
+func desiredStateDrift(cfResource *cfschema.Resource, desiredState, properties string) (string, bool, error) {
+	var desired, actual interface{}
+
+	if err := json.Unmarshal([]byte(desiredState), &desired); err != nil {
+		return "", false, fmt.Errorf("error parsing desired state: %w", err)
+	}
+
+	if err := json.Unmarshal([]byte(properties), &actual); err != nil {
+		return "", false, fmt.Errorf("error parsing properties: %w", err)
+	}
+
+	v, drift := propertyDrift(cfResource, nil, desired, actual)
+
+	if !drift {
+		return desiredState, false, nil
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", false, err
+	}
+
+	return string(b), true, nil
+}
+
+// propertyDrift compares a desired property value with its current value.
+// It returns the desired value with any drifted values replaced by their current value
+// and whether any drift was detected.
+func propertyDrift(cfResource *cfschema.Resource, path []string, desired, actual interface{}) (interface{}, bool) {
+	if len(path) > 0 && isUnreadablePropertyPath(cfResource, path) {
+		return desired, false
+	}
+
+	switch desired := desired.(type) {
+	case map[string]interface{}:
+		actualMap, ok := actual.(map[string]interface{})
+
+		if !ok {
+			return actual, true
+		}
+
+		result := make(map[string]interface{}, len(desired))
+		drift := false
+
+		for k, v := range desired {
+			// Properties missing from the current properties are not reported as drift,
+			// as not all resource handlers return every configured property.
+			av, ok := actualMap[k]
+
+			if !ok {
+				result[k] = v
+
+				continue
+			}
+
+			v, d := propertyDrift(cfResource, append(path[:len(path):len(path)], k), v, av)
+			result[k] = v
+			drift = drift || d
+		}
+
+		return result, drift
+
+	case []interface{}:
+		actualSlice, ok := actual.([]interface{})
+
+		if !ok || len(actualSlice) != len(desired) {
+			return actual, true
+		}
+
+		result := make([]interface{}, len(desired))
+		drift := false
+
+		for i, v := range desired {
+			v, d := propertyDrift(cfResource, append(path[:len(path):len(path)], strconv.Itoa(i)), v, actualSlice[i])
+			result[i] = v
+			drift = drift || d
+		}
+
+		// Services may return array elements in a different order.
+		if drift && propertyArrayElementsMatch(cfResource, path, desired, actualSlice) {
+			return desired, false
+		}
+
+		return result, drift
+
+	default:
+		if reflect.DeepEqual(desired, actual) {
+			return desired, false
+		}
+
+		// Services may return scalar values with a different JSON type, e.g. "1" instead of 1.
+		if actual != nil && fmt.Sprint(desired) == fmt.Sprint(actual) {
+			return desired, false
+		}
+
+		return actual, true
+	}
+}
+
+// propertyArrayElementsMatch returns whether each desired array element matches a distinct current array element, ignoring order.
+func propertyArrayElementsMatch(cfResource *cfschema.Resource, path []string, desired, actual []interface{}) bool {
+	matched := make([]bool, len(actual))
+
+	for i, v := range desired {
+		found := false
+
+		for j, av := range actual {
+			if matched[j] {
+				continue
+			}
+
+			if _, d := propertyDrift(cfResource, append(path[:len(path):len(path)], strconv.Itoa(i)), v, av); !d {
+				matched[j] = true
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+// plannedProperties returns the properties expected after applying the specified desired state changes to the current properties.
+// The returned bool is false if the result cannot be determined, e.g. when a property is removed and the service may apply a default value.
+func plannedProperties(cfResource *cfschema.Resource, properties string, patches []jsonpatch.JsonPatchOperation) (string, bool, error) {
+	var v interface{}
+
+	if err := json.Unmarshal([]byte(properties), &v); err != nil {
+		return "", false, fmt.Errorf("error parsing properties: %w", err)
+	}
+
+	for _, patch := range patches {
+		path := propertyPath(patch.Path)
+
+		if isUnreadablePropertyPath(cfResource, path) {
+			continue
+		}
+
+		if patch.Operation != "add" && patch.Operation != "replace" {
+			return "", false, nil
+		}
+
+		var ok bool
+
+		v, ok = setPropertyValue(v, path, patch.Value, patch.Operation == "replace")
+
+		if !ok {
+			return "", false, nil
+		}
+	}
+
+	b, err := json.Marshal(v)
+
+	if err != nil {
+		return "", false, err
+	}
+
+	return string(b), true, nil
+}
+
+// setPropertyValue sets the value at the specified path.
+// Array elements can only be replaced, not added.
+func setPropertyValue(doc interface{}, path []string, value interface{}, replace bool) (interface{}, bool) {
+	if len(path) == 0 {
+		return value, true
+	}
+
+	switch doc := doc.(type) {
+	case map[string]interface{}:
+		if len(path) == 1 {
+			doc[path[0]] = value
+
+			return doc, true
+		}
+
+		v, ok := doc[path[0]]
+
+		if !ok {
+			return nil, false
+		}
+
+		v, ok = setPropertyValue(v, path[1:], value, replace)
+
+		if !ok {
+			return nil, false
+		}
+
+		doc[path[0]] = v
+
+		return doc, true
+
+	case []interface{}:
+		i, err := strconv.Atoi(path[0])
+
+		if err != nil || i < 0 || i >= len(doc) || (len(path) == 1 && !replace) {
+			return nil, false
+		}
+
+		v, ok := setPropertyValue(doc[i], path[1:], value, replace)
+
+		if !ok {
+			return nil, false
+		}
+
+		doc[i] = v
+
+		return doc, true
+
+	default:
+		return nil, false
+	}
+}
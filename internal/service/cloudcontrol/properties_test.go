@@ -0,0 +1,256 @@
+package cloudcontrol
+
+import (
+	"testing"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/mattbaird/jsonpatch"
+)
+
+func testResource() *cfschema.Resource {
+	return &cfschema.Resource{
+		CreateOnlyProperties: cfschema.PropertyJsonPointers{"/properties/Name", "/properties/Config/Engine"},
+		ReadOnlyProperties:   cfschema.PropertyJsonPointers{"/properties/Arn", "/properties/Endpoints/*/Address"},
+		WriteOnlyProperties:  cfschema.PropertyJsonPointers{"/properties/Password"},
+	}
+}
+
+func TestPropertyPath(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Pointer  string
+		Expected []string
+	}{
+		{
+			TestName: "empty",
+			Pointer:  "",
+			Expected: nil,
+		},
+		{
+			TestName: "top level",
+			Pointer:  "/Name",
+			Expected: []string{"Name"},
+		},
+		{
+			TestName: "nested",
+			Pointer:  "/Tags/0/Key",
+			Expected: []string{"Tags", "0", "Key"},
+		},
+		{
+			TestName: "escaped",
+			Pointer:  "/a~1b/c~0d",
+			Expected: []string{"a/b", "c~d"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := propertyPath(testCase.Pointer)
+
+			if len(got) != len(testCase.Expected) {
+				t.Fatalf("got %q, expected %q", got, testCase.Expected)
+			}
+
+			for i := range got {
+				if got[i] != testCase.Expected[i] {
+					t.Fatalf("got %q, expected %q", got, testCase.Expected)
+				}
+			}
+		})
+	}
+}
+
+func TestIsCreateOnlyPropertyPath(t *testing.T) {
+	cfResource := testResource()
+
+	testCases := []struct {
+		TestName string
+		Pointer  string
+		Expected bool
+	}{
+		{
+			TestName: "create-only",
+			Pointer:  "/Name",
+			Expected: true,
+		},
+		{
+			TestName: "nested under create-only",
+			Pointer:  "/Config/Engine/Version",
+			Expected: true,
+		},
+		{
+			TestName: "sibling of create-only",
+			Pointer:  "/Config/Size",
+			Expected: false,
+		},
+		{
+			TestName: "parent of create-only",
+			Pointer:  "/Config",
+			Expected: false,
+		},
+		{
+			TestName: "updatable",
+			Pointer:  "/Description",
+			Expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := isCreateOnlyPropertyPath(cfResource, propertyPath(testCase.Pointer))
+
+			if got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestDesiredStateDrift(t *testing.T) {
+	cfResource := testResource()
+
+	testCases := []struct {
+		TestName      string
+		DesiredState  string
+		Properties    string
+		ExpectedDrift bool
+		Expected      string
+	}{
+		{
+			TestName:      "no drift",
+			DesiredState:  `{"Name":"test", "Enabled": true}`,
+			Properties:    `{"Enabled":true,"Name":"test"}`,
+			ExpectedDrift: false,
+			Expected:      `{"Name":"test", "Enabled": true}`,
+		},
+		{
+			TestName:      "service defaults",
+			DesiredState:  `{"Name":"test","Config":{"Size":1}}`,
+			Properties:    `{"Name":"test","Config":{"Size":1,"Engine":"default"},"Retention":7}`,
+			ExpectedDrift: false,
+			Expected:      `{"Name":"test","Config":{"Size":1}}`,
+		},
+		{
+			TestName:      "read-only and write-only",
+			DesiredState:  `{"Name":"test","Password":"secret","Endpoints":[{"Address":"a","Port":80}]}`,
+			Properties:    `{"Name":"test","Arn":"arn","Endpoints":[{"Address":"b","Port":80}]}`,
+			ExpectedDrift: false,
+			Expected:      `{"Name":"test","Password":"secret","Endpoints":[{"Address":"a","Port":80}]}`,
+		},
+		{
+			TestName:      "scalar type",
+			DesiredState:  `{"Size":"1","Enabled":"true"}`,
+			Properties:    `{"Size":1,"Enabled":true}`,
+			ExpectedDrift: false,
+			Expected:      `{"Size":"1","Enabled":"true"}`,
+		},
+		{
+			TestName:      "array order",
+			DesiredState:  `{"Tags":[{"Key":"a","Value":"1"},{"Key":"b","Value":"2"}]}`,
+			Properties:    `{"Tags":[{"Key":"b","Value":"2"},{"Key":"a","Value":"1"}]}`,
+			ExpectedDrift: false,
+			Expected:      `{"Tags":[{"Key":"a","Value":"1"},{"Key":"b","Value":"2"}]}`,
+		},
+		{
+			TestName:      "nested drift",
+			DesiredState:  `{"Name":"test","Config":{"Size":1}}`,
+			Properties:    `{"Name":"test","Config":{"Size":2,"Engine":"default"}}`,
+			ExpectedDrift: true,
+			Expected:      `{"Config":{"Size":2},"Name":"test"}`,
+		},
+		{
+			TestName:      "array drift",
+			DesiredState:  `{"Tags":[{"Key":"a","Value":"1"}]}`,
+			Properties:    `{"Tags":[{"Key":"a","Value":"1"},{"Key":"b","Value":"2"}]}`,
+			ExpectedDrift: true,
+			Expected:      `{"Tags":[{"Key":"a","Value":"1"},{"Key":"b","Value":"2"}]}`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got, drift, err := desiredStateDrift(cfResource, testCase.DesiredState, testCase.Properties)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if drift != testCase.ExpectedDrift {
+				t.Errorf("got drift %t, expected %t", drift, testCase.ExpectedDrift)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPlannedProperties(t *testing.T) {
+	cfResource := testResource()
+
+	testCases := []struct {
+		TestName   string
+		Properties string
+		OldState   string
+		NewState   string
+		ExpectedOK bool
+		Expected   string
+	}{
+		{
+			TestName:   "replace",
+			Properties: `{"Arn":"arn","Config":{"Engine":"default","Size":1},"Name":"test"}`,
+			OldState:   `{"Name":"test","Config":{"Size":1}}`,
+			NewState:   `{"Name":"test","Config":{"Size":2}}`,
+			ExpectedOK: true,
+			Expected:   `{"Arn":"arn","Config":{"Engine":"default","Size":2},"Name":"test"}`,
+		},
+		{
+			TestName:   "add",
+			Properties: `{"Arn":"arn","Name":"test"}`,
+			OldState:   `{"Name":"test"}`,
+			NewState:   `{"Name":"test","Description":"desc"}`,
+			ExpectedOK: true,
+			Expected:   `{"Arn":"arn","Description":"desc","Name":"test"}`,
+		},
+		{
+			TestName:   "write-only",
+			Properties: `{"Arn":"arn","Name":"test"}`,
+			OldState:   `{"Name":"test","Password":"old"}`,
+			NewState:   `{"Name":"test","Password":"new"}`,
+			ExpectedOK: true,
+			Expected:   `{"Arn":"arn","Name":"test"}`,
+		},
+		{
+			TestName:   "remove",
+			Properties: `{"Arn":"arn","Description":"desc","Name":"test"}`,
+			OldState:   `{"Name":"test","Description":"desc"}`,
+			NewState:   `{"Name":"test"}`,
+			ExpectedOK: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			patches, err := jsonpatch.CreatePatch([]byte(testCase.OldState), []byte(testCase.NewState))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			got, ok, err := plannedProperties(cfResource, testCase.Properties, patches)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if ok != testCase.ExpectedOK {
+				t.Fatalf("got ok %t, expected %t", ok, testCase.ExpectedOK)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/mattbaird/jsonpatch"
 )
 
@@ -37,8 +39,9 @@ func ResourceResource() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"desired_state": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
 			},
 			"properties": {
 				Type:     schema.TypeString,
@@ -70,7 +73,8 @@ func ResourceResource() *schema.Resource {
 			resourceResourceCustomizeDiffGetSchema,
 			resourceResourceCustomizeDiffSchemaDiff,
 			customdiff.ComputedIf("properties", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
-				return diff.HasChange("desired_state")
+				// properties may already have been planned from the desired_state changes.
+				return diff.HasChange("desired_state") && !diff.HasChange("properties")
 			}),
 		),
 	}
@@ -141,7 +145,30 @@ func resourceResourceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return diag.FromErr(fmt.Errorf("error reading Cloud Control API Resource (%s): %w", d.Id(), err))
 	}
 
-	d.Set("properties", resourceDescription.Properties)
+	properties := aws.StringValue(resourceDescription.Properties)
+
+	d.Set("properties", properties)
+
+	// Report drift only for properties configured in desired_state, so that values
+	// defaulted by the service and read-only or write-only properties do not cause perpetual differences.
+	if desiredState, resourceSchema := d.Get("desired_state").(string), d.Get("schema").(string); desiredState != "" && resourceSchema != "" {
+		cfResource, err := parseResourceSchema(resourceSchema)
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		desiredState, drift, err := desiredStateDrift(cfResource, desiredState, properties)
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error comparing Cloud Control API Resource (%s) desired_state with properties: %w", d.Id(), err))
+		}
+
+		if drift {
+			log.Printf("[DEBUG] Cloud Control API Resource (%s) properties have drifted from desired_state", d.Id())
+			d.Set("desired_state", desiredState)
+		}
+	}
 
 	return nil
 }
@@ -297,19 +324,42 @@ func resourceResourceCustomizeDiffSchemaDiff(ctx context.Context, diff *schema.R
 		return fmt.Errorf("error converting CloudFormation Resource Schema JSON: %w", err)
 	}
 
-	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredStateRaw.(string)))
+	patches, err := jsonpatch.CreatePatch([]byte(oldDesiredStateRaw.(string)), []byte(newDesiredState))
 
 	if err != nil {
 		return fmt.Errorf("error creating desired_state JSON Patch: %w", err)
 	}
 
+	var createOnlyPaths []string
+
 	for _, patch := range patches {
-		if cfResource.IsCreateOnlyPropertyPath(patch.Path) {
-			if err := diff.ForceNew("desired_state"); err != nil {
-				return fmt.Errorf("error setting desired_state ForceNew: %w", err)
-			}
+		log.Printf("[DEBUG] Cloud Control API Resource (%s) property %s: %s", diff.Id(), patch.Path, patch.Operation)
+
+		if isCreateOnlyPropertyPath(cfResource, propertyPath(patch.Path)) {
+			createOnlyPaths = append(createOnlyPaths, patch.Path)
+		}
+	}
+
+	if len(createOnlyPaths) > 0 {
+		log.Printf("[INFO] Cloud Control API Resource (%s) create-only properties changed, requires replacement: %s", diff.Id(), strings.Join(createOnlyPaths, ", "))
+
+		if err := diff.ForceNew("desired_state"); err != nil {
+			return fmt.Errorf("error setting desired_state ForceNew: %w", err)
+		}
+
+		return nil
+	}
+
+	// Plan the resulting properties so that the difference shows each changed property.
+	properties, ok, err := plannedProperties(cfResource, diff.Get("properties").(string), patches)
+
+	if err != nil {
+		return fmt.Errorf("error planning properties: %w", err)
+	}
 
-			break
+	if ok {
+		if err := diff.SetNew("properties", properties); err != nil {
+			return fmt.Errorf("error setting properties diff: %w", err)
 		}
 	}
 
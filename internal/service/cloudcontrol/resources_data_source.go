@@ -0,0 +1,203 @@
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/jmespath/go-jmespath"
+)
+
+func DataSourceResources() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceResourcesRead,
+
+		Schema: map[string]*schema.Schema{
+			"filter": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validJMESPathExpression,
+						},
+						"values": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"identifiers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"query": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validJMESPathExpression,
+			},
+			"resource_model": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"identifier": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"properties": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}::[A-Za-z0-9]{2,64}`), "must be three alphanumeric sections separated by double colons (::)"),
+			},
+			"type_version_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).CloudControlConn
+
+	typeName := d.Get("type_name").(string)
+	input := &cloudcontrolapi.ListResourcesInput{
+		TypeName: aws.String(typeName),
+	}
+
+	if v, ok := d.GetOk("resource_model"); ok {
+		input.ResourceModel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("type_version_id"); ok {
+		input.TypeVersionId = aws.String(v.(string))
+	}
+
+	var query *jmespath.JMESPath
+
+	if v, ok := d.GetOk("query"); ok {
+		var err error
+		query, err = jmespath.Compile(v.(string))
+
+		if err != nil {
+			return diag.Errorf("error compiling query (%s): %s", v, err)
+		}
+	}
+
+	filters, err := expandResourcePropertyFilters(d.Get("filter").(*schema.Set).List())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resourceDescriptions, err := FindResources(ctx, conn, input)
+
+	if err != nil {
+		return diag.Errorf("error listing Cloud Control API Resources (%s): %s", typeName, err)
+	}
+
+	var identifiers []string
+	var resources []interface{}
+
+	for _, resourceDescription := range resourceDescriptions {
+		identifier := aws.StringValue(resourceDescription.Identifier)
+		properties := aws.StringValue(resourceDescription.Properties)
+
+		var v interface{}
+
+		if properties != "" {
+			if err := json.Unmarshal([]byte(properties), &v); err != nil {
+				return diag.Errorf("error parsing Cloud Control API Resource (%s) properties: %s", identifier, err)
+			}
+		}
+
+		match, err := resourcePropertiesMatch(v, query, filters)
+
+		if err != nil {
+			return diag.Errorf("error filtering Cloud Control API Resource (%s): %s", identifier, err)
+		}
+
+		if !match {
+			continue
+		}
+
+		identifiers = append(identifiers, identifier)
+		resources = append(resources, map[string]interface{}{
+			"identifier": identifier,
+			"properties": properties,
+		})
+	}
+
+	d.SetId(typeName)
+	d.Set("identifiers", identifiers)
+	d.Set("resources", resources)
+
+	return nil
+}
+
+func expandResourcePropertyFilters(tfList []interface{}) ([]*resourcePropertyFilter, error) {
+	var apiObjects []*resourcePropertyFilter
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		expression, err := jmespath.Compile(name)
+
+		if err != nil {
+			return nil, fmt.Errorf("error compiling filter name (%s): %w", name, err)
+		}
+
+		apiObject := &resourcePropertyFilter{
+			expression: expression,
+			values:     make(map[string]bool),
+		}
+
+		for _, v := range flex.ExpandStringValueSet(tfMap["values"].(*schema.Set)) {
+			apiObject.values[v] = true
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
@@ -0,0 +1,93 @@
+package cloudcontrol_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudcontrolapi"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccCloudControlResourcesDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "identifiers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "identifiers.0", resourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "resources.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resources.0.identifier", resourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resources.0.properties"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudControlResourcesDataSource_query(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_cloudcontrolapi_resources.test"
+	resourceName := "aws_cloudcontrolapi_resource.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { acctest.PreCheck(t) },
+		ErrorCheck:        acctest.ErrorCheck(t, cloudcontrolapi.EndpointsID),
+		ProviderFactories: acctest.ProviderFactories,
+		CheckDestroy:      testAccCheckResourceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceQueryConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "identifiers.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "identifiers.0", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourcesDataSourceBaseConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudcontrolapi_resource" "test" {
+  type_name = "AWS::Logs::LogGroup"
+
+  desired_state = jsonencode({
+    LogGroupName    = %[1]q
+    RetentionInDays = 7
+  })
+}
+`, rName)
+}
+
+func testAccResourcesDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccResourcesDataSourceBaseConfig(rName), `
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = aws_cloudcontrolapi_resource.test.type_name
+
+  filter {
+    name   = "LogGroupName"
+    values = [aws_cloudcontrolapi_resource.test.id]
+  }
+}
+`)
+}
+
+func testAccResourcesDataSourceQueryConfig(rName string) string {
+	return acctest.ConfigCompose(testAccResourcesDataSourceBaseConfig(rName), `
+data "aws_cloudcontrolapi_resources" "test" {
+  type_name = aws_cloudcontrolapi_resource.test.type_name
+  query     = "LogGroupName == '${aws_cloudcontrolapi_resource.test.id}' && RetentionInDays == `+"`7`"+`"
+}
+`)
+}
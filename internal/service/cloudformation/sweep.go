@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudformation_stack_set_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudformation_stack_set_instance", &resource.Sweeper{
 		Name: "aws_cloudformation_stack_set_instance",
 		F:    sweepStackSetInstances,
 	})
 
-	resource.AddTestSweepers("aws_cloudformation_stack_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudformation_stack_set", &resource.Sweeper{
 		Name: "aws_cloudformation_stack_set",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
@@ -29,7 +29,7 @@ func init() {
 		F: sweepStackSets,
 	})
 
-	resource.AddTestSweepers("aws_cloudformation_stack", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudformation_stack", &resource.Sweeper{
 		Name: "aws_cloudformation_stack",
 		Dependencies: []string{
 			"aws_cloudformation_stack_set_instance",
@@ -172,37 +172,38 @@ func sweepStacks(region string) error {
 			cloudformation.StackStatusUpdateComplete,
 		}),
 	}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListStacksPages(input, func(page *cloudformation.ListStacksOutput, lastPage bool) bool {
-		for _, stack := range page.StackSummaries {
-			input := &cloudformation.DeleteStackInput{
-				StackName: stack.StackName,
-			}
-			name := aws.StringValue(stack.StackName)
+		if page == nil {
+			return !lastPage
+		}
 
-			log.Printf("[INFO] Deleting CloudFormation Stack: %s", name)
-			_, err := conn.DeleteStack(input)
+		for _, stack := range page.StackSummaries {
+			r := ResourceStack()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(stack.StackId))
+			d.Set("name", stack.StackName)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CloudFormation Stack (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping CloudFormation Stack sweep for %s: %s", region, err)
-		return nil
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing CloudFormation Stacks for %s: %w", region, err))
 	}
 
-	if err != nil {
-		return fmt.Errorf("error listing CloudFormation Stacks: %s", err)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping CloudFormation Stacks for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping CloudFormation Stack sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudfront_cache_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_cache_policy", &resource.Sweeper{
 		Name: "aws_cloudfront_cache_policy",
 		F:    sweepCachePolicies,
 		Dependencies: []string{
@@ -25,17 +25,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_distribution", &resource.Sweeper{
 		Name: "aws_cloudfront_distribution",
 		F:    sweepDistributions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_field_level_encryption_config", &resource.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_config",
 		F:    sweepFieldLevelEncryptionConfigs,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_field_level_encryption_profile", &resource.Sweeper{
 		Name: "aws_cloudfront_field_level_encryption_profile",
 		F:    sweepFieldLevelEncryptionProfiles,
 		Dependencies: []string{
@@ -43,22 +43,22 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_function", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_function", &resource.Sweeper{
 		Name: "aws_cloudfront_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_key_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_key_group", &resource.Sweeper{
 		Name: "aws_cloudfront_key_group",
 		F:    sweepKeyGroup,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_monitoring_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_monitoring_subscription", &resource.Sweeper{
 		Name: "aws_cloudfront_monitoring_subscription",
 		F:    sweepMonitoringSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_origin_request_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_origin_request_policy", &resource.Sweeper{
 		Name: "aws_cloudfront_origin_request_policy",
 		F:    sweepOriginRequestPolicies,
 		Dependencies: []string{
@@ -66,12 +66,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_realtime_log_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_realtime_log_config", &resource.Sweeper{
 		Name: "aws_cloudfront_realtime_log_config",
 		F:    sweepRealtimeLogsConfig,
 	})
 
-	resource.AddTestSweepers("aws_cloudfront_response_headers_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudfront_response_headers_policy", &resource.Sweeper{
 		Name: "aws_cloudfront_response_headers_policy",
 		F:    sweepResponseHeadersPolicies,
 		Dependencies: []string{
@@ -145,52 +145,57 @@ func sweepDistributions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).CloudFrontConn
-
-	distributionSummaries := make([]*cloudfront.DistributionSummary, 0)
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &cloudfront.ListDistributionsInput{}
 	err = conn.ListDistributionsPages(input, func(page *cloudfront.ListDistributionsOutput, lastPage bool) bool {
-		distributionSummaries = append(distributionSummaries, page.DistributionList.Items...)
-		return !lastPage
-	})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping CloudFront Distribution sweep for %s: %s", region, err)
-			return nil
+		if page == nil || page.DistributionList == nil {
+			return !lastPage
 		}
-		return fmt.Errorf("Error listing CloudFront Distributions: %s", err)
-	}
 
-	if len(distributionSummaries) == 0 {
-		log.Print("[DEBUG] No CloudFront Distributions to sweep")
-		return nil
-	}
+		for _, distributionSummary := range page.DistributionList.Items {
+			id := aws.StringValue(distributionSummary.Id)
 
-	for _, distributionSummary := range distributionSummaries {
-		distributionID := aws.StringValue(distributionSummary.Id)
+			if aws.BoolValue(distributionSummary.Enabled) {
+				log.Printf("[WARN] Skipping deletion of enabled CloudFront Distribution: %s", id)
+				continue
+			}
 
-		if aws.BoolValue(distributionSummary.Enabled) {
-			log.Printf("[WARN] Skipping deletion of enabled CloudFront Distribution: %s", distributionID)
-			continue
-		}
+			output, err := conn.GetDistribution(&cloudfront.GetDistributionInput{
+				Id: aws.String(id),
+			})
 
-		output, err := conn.GetDistribution(&cloudfront.GetDistributionInput{
-			Id: aws.String(distributionID),
-		})
-		if err != nil {
-			return fmt.Errorf("Error reading CloudFront Distribution %s: %s", distributionID, err)
-		}
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error reading CloudFront Distribution (%s): %w", id, err))
+				continue
+			}
 
-		_, err = conn.DeleteDistribution(&cloudfront.DeleteDistributionInput{
-			Id:      aws.String(distributionID),
-			IfMatch: output.ETag,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting CloudFront Distribution %s: %s", distributionID, err)
+			r := ResourceDistribution()
+			d := r.Data(nil)
+			d.SetId(id)
+			d.Set("etag", output.ETag)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing CloudFront Distributions for %s: %w", region, err))
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping CloudFront Distributions for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping CloudFront Distribution sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepFunctions(region string) error {
@@ -200,7 +205,8 @@ func sweepFunctions(region string) error {
 	}
 	conn := client.(*conns.AWSClient).CloudFrontConn
 	input := &cloudfront.ListFunctionsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = ListFunctionsPages(conn, input, func(page *cloudfront.ListFunctionsOutput, lastPage bool) bool {
 		if page == nil {
@@ -217,9 +223,7 @@ func sweepFunctions(region string) error {
 			}
 
 			if err != nil {
-				sweeperErr := fmt.Errorf("error reading CloudFront Function (%s): %w", name, err)
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				errs = multierror.Append(errs, fmt.Errorf("error reading CloudFront Function (%s): %w", name, err))
 				continue
 			}
 
@@ -227,29 +231,28 @@ func sweepFunctions(region string) error {
 			d := r.Data(nil)
 			d.SetId(name)
 			d.Set("etag", output.ETag)
+			d.Set("name", name)
 
-			err = r.Delete(d, client)
-
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping CloudFront Function sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing CloudFront Functions for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing CloudFront Functions: %w", err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping CloudFront Functions for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping CloudFront Function sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepKeyGroup(region string) error {
@@ -258,38 +261,44 @@ func sweepKeyGroup(region string) error {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).CloudFrontConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &cloudfront.ListKeyGroupsInput{}
 
 	for {
 		output, err := conn.ListKeyGroups(input)
+
 		if err != nil {
-			if sweep.SkipSweepError(err) {
-				log.Printf("[WARN] Skipping CloudFront key group sweep for %s: %s", region, err)
-				return nil
-			}
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving CloudFront key group: %w", err))
-			return sweeperErrs.ErrorOrNil()
+			errs = multierror.Append(errs, fmt.Errorf("error listing CloudFront Key Groups for %s: %w", region, err))
+			break
 		}
 
-		if output == nil || output.KeyGroupList == nil || len(output.KeyGroupList.Items) == 0 {
-			log.Print("[DEBUG] No CloudFront key group to sweep")
-			return nil
+		if output == nil || output.KeyGroupList == nil {
+			break
 		}
 
 		for _, item := range output.KeyGroupList.Items {
-			strId := aws.StringValue(item.KeyGroup.Id)
-			log.Printf("[INFO] CloudFront key group %s", strId)
-			_, err := conn.DeleteKeyGroup(&cloudfront.DeleteKeyGroupInput{
-				Id: item.KeyGroup.Id,
+			id := aws.StringValue(item.KeyGroup.Id)
+
+			keyGroup, err := conn.GetKeyGroup(&cloudfront.GetKeyGroupInput{
+				Id: aws.String(id),
 			})
+
 			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CloudFront key group %s: %w", strId, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+				errs = multierror.Append(errs, fmt.Errorf("error reading CloudFront Key Group (%s): %w", id, err))
 				continue
 			}
+
+			r := ResourceKeyGroup()
+			d := r.Data(nil)
+			d.SetId(id)
+			d.Set("etag", keyGroup.ETag)
+			if item.KeyGroup.KeyGroupConfig != nil {
+				d.Set("name", item.KeyGroup.KeyGroupConfig.Name)
+			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if output.KeyGroupList.NextMarker == nil {
@@ -298,7 +307,16 @@ func sweepKeyGroup(region string) error {
 		input.Marker = output.KeyGroupList.NextMarker
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping CloudFront Key Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping CloudFront Key Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepMonitoringSubscriptions(region string) error {
@@ -307,46 +325,53 @@ func sweepMonitoringSubscriptions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).CloudFrontConn
-	var sweeperErrs *multierror.Error
-
-	distributionSummaries := make([]*cloudfront.DistributionSummary, 0)
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &cloudfront.ListDistributionsInput{}
 	err = conn.ListDistributionsPages(input, func(page *cloudfront.ListDistributionsOutput, lastPage bool) bool {
-		distributionSummaries = append(distributionSummaries, page.DistributionList.Items...)
+		if page == nil || page.DistributionList == nil {
+			return !lastPage
+		}
+
+		for _, distributionSummary := range page.DistributionList.Items {
+			id := aws.StringValue(distributionSummary.Id)
+
+			_, err := FindMonitoringSubscriptionByDistributionID(conn, id)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error reading CloudFront Monitoring Subscription (%s): %w", id, err))
+				continue
+			}
+
+			r := ResourceMonitoringSubscription()
+			d := r.Data(nil)
+			d.SetId(id)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping CloudFront Monitoring Subscriptions sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error listing CloudFront Distributions: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing CloudFront Distributions for %s: %w", region, err))
 	}
 
-	if len(distributionSummaries) == 0 {
-		log.Print("[DEBUG] No CloudFront Distributions found")
-		return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping CloudFront Monitoring Subscriptions for %s: %w", region, err))
 	}
 
-	for _, distributionSummary := range distributionSummaries {
-
-		_, err := conn.GetMonitoringSubscription(&cloudfront.GetMonitoringSubscriptionInput{
-			DistributionId: distributionSummary.Id,
-		})
-		if err != nil {
-			return fmt.Errorf("error reading CloudFront Monitoring Subscription %s: %s", aws.StringValue(distributionSummary.Id), err)
-		}
-
-		_, err = conn.DeleteMonitoringSubscription(&cloudfront.DeleteMonitoringSubscriptionInput{
-			DistributionId: distributionSummary.Id,
-		})
-		if err != nil {
-			return fmt.Errorf("error deleting CloudFront Monitoring Subscription %s: %s", aws.StringValue(distributionSummary.Id), err)
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping CloudFront Monitoring Subscriptions sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepRealtimeLogsConfig(region string) error {
@@ -356,35 +381,24 @@ func sweepRealtimeLogsConfig(region string) error {
 	}
 	conn := client.(*conns.AWSClient).CloudFrontConn
 	input := &cloudfront.ListRealtimeLogConfigsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.ListRealtimeLogConfigs(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping CloudFront Real-time Log Configs sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-		}
-
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving CloudFront Real-time Log Configs: %w", err))
-			return sweeperErrs
+			errs = multierror.Append(errs, fmt.Errorf("error listing CloudFront Real-time Log Configs for %s: %w", region, err))
+			break
 		}
 
 		for _, config := range output.RealtimeLogConfigs.Items {
-			id := aws.StringValue(config.ARN)
-
-			log.Printf("[INFO] Deleting CloudFront Real-time Log Config: %s", id)
 			r := ResourceRealtimeLogConfig()
 			d := r.Data(nil)
-			d.SetId(id)
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(config.ARN))
+			d.Set("name", config.Name)
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.RealtimeLogConfigs.NextMarker) == "" {
@@ -393,7 +407,16 @@ func sweepRealtimeLogsConfig(region string) error {
 		input.Marker = output.RealtimeLogConfigs.NextMarker
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping CloudFront Real-time Log Configs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping CloudFront Real-time Log Configs sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepFieldLevelEncryptionConfigs(region string) error {
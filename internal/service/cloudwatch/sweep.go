@@ -7,18 +7,18 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudwatch"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_composite_alarm", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_composite_alarm", &resource.Sweeper{
 		Name: "aws_cloudwatch_composite_alarm",
 		F:    sweepCompositeAlarms,
 	})
@@ -32,13 +32,13 @@ func sweepCompositeAlarms(region string) error {
 
 	conn := client.(*conns.AWSClient).CloudWatchConn
 	ctx := context.Background()
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &cloudwatch.DescribeAlarmsInput{
 		AlarmTypes: aws.StringSlice([]string{cloudwatch.AlarmTypeCompositeAlarm}),
 	}
 
-	var sweeperErrs *multierror.Error
-
 	err = conn.DescribeAlarmsPagesWithContext(ctx, input, func(page *cloudwatch.DescribeAlarmsOutput, isLast bool) bool {
 		if page == nil {
 			return !isLast
@@ -51,33 +51,29 @@ func sweepCompositeAlarms(region string) error {
 
 			name := aws.StringValue(compositeAlarm.AlarmName)
 
-			log.Printf("[INFO] Deleting CloudWatch Composite Alarm: %s", name)
-
 			r := ResourceCompositeAlarm()
 			d := r.Data(nil)
 			d.SetId(name)
+			d.Set("alarm_name", name)
 
-			diags := r.DeleteContext(ctx, d, client)
-
-			for i := range diags {
-				if diags[i].Severity == diag.Error {
-					log.Printf("[ERROR] %s", diags[i].Summary)
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf(diags[i].Summary))
-					continue
-				}
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !isLast
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping CloudWatch Composite Alarms sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving CloudWatch Composite Alarms: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing CloudWatch Composite Alarms for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestratorContext(ctx, sweepResources, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, 0*time.Millisecond, sweep.SweepThrottlingRetryTimeout); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping CloudWatch Composite Alarms for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping CloudWatch Composite Alarms sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -43,12 +43,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_query_definition", &resource.Sweeper{
 		Name: "aws_cloudwatch_query_definition",
 		F:    sweeplogQueryDefinitions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_log_resource_policy", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_resource_policy",
 		F:    sweepResourcePolicies,
 	})
@@ -60,7 +60,8 @@ func sweepGroups(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).CloudWatchLogsConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &cloudwatchlogs.DescribeLogGroupsInput{}
 
@@ -74,35 +75,31 @@ func sweepGroups(region string) error {
 				continue
 			}
 
-			input := &cloudwatchlogs.DeleteLogGroupInput{
-				LogGroupName: logGroup.LogGroupName,
-			}
-			name := aws.StringValue(logGroup.LogGroupName)
-
-			log.Printf("[INFO] Deleting CloudWatch Log Group: %s", name)
-			_, err := conn.DeleteLogGroup(input)
+			r := ResourceGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(logGroup.LogGroupName))
+			d.Set("name", logGroup.LogGroupName)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CloudWatch Log Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping CloudWatch Log Groups sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing CloudWatch Log Groups for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving CloudWatch Log Groups: %w", err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping CloudWatch Log Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping CloudWatch Log Groups sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweeplogQueryDefinitions(region string) error {
@@ -163,31 +160,25 @@ func sweepResourcePolicies(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).CloudWatchLogsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &cloudwatchlogs.DescribeResourcePoliciesInput{}
 
 	for {
 		output, err := conn.DescribeResourcePolicies(input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping CloudWatchLog Resource Policy sweep for %s: %s", region, err)
-			return nil
-		}
 
 		if err != nil {
-			return fmt.Errorf("error describing CloudWatchLog Resource Policy: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing CloudWatch Log Resource Policies for %s: %w", region, err))
+			break
 		}
 
 		for _, resourcePolicy := range output.ResourcePolicies {
-			policyName := aws.StringValue(resourcePolicy.PolicyName)
-			deleteInput := &cloudwatchlogs.DeleteResourcePolicyInput{
-				PolicyName: resourcePolicy.PolicyName,
-			}
-
-			log.Printf("[INFO] Deleting CloudWatch Log Resource Policy: %s", policyName)
+			r := ResourceResourcePolicy()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(resourcePolicy.PolicyName))
 
-			if _, err := conn.DeleteResourcePolicy(deleteInput); err != nil {
-				return fmt.Errorf("error deleting CloudWatch log resource policy (%s): %s", policyName, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -197,5 +188,14 @@ func sweepResourcePolicies(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping CloudWatch Log Resource Policies for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping CloudWatch Log Resource Policy sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
@@ -6,6 +6,7 @@ package codeartifact
 import (
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/codeartifact"
@@ -16,12 +17,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_codeartifact_domain", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codeartifact_domain", &resource.Sweeper{
 		Name: "aws_codeartifact_domain",
 		F:    sweepDomains,
 	})
 
-	resource.AddTestSweepers("aws_codeartifact_repository", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_codeartifact_repository", &resource.Sweeper{
 		Name: "aws_codeartifact_repository",
 		F:    sweepRepositories,
 	})
@@ -34,43 +35,47 @@ func sweepDomains(region string) error {
 	}
 	conn := client.(*conns.AWSClient).CodeArtifactConn
 	input := &codeartifact.ListDomainsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListDomainsPages(input, func(page *codeartifact.ListDomainsOutput, lastPage bool) bool {
-		for _, domainPtr := range page.Domains {
-			if domainPtr == nil {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, domain := range page.Domains {
+			if domain == nil {
 				continue
 			}
 
-			domain := aws.StringValue(domainPtr.Name)
-			input := &codeartifact.DeleteDomainInput{
-				Domain: domainPtr.Name,
+			r := ResourceDomain()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(domain.Arn))
+			d.Set("domain", domain.Name)
+			if domain.CreatedTime != nil {
+				d.Set("created_time", domain.CreatedTime.Format(time.RFC3339))
 			}
 
-			log.Printf("[INFO] Deleting CodeArtifact Domain: %s", domain)
-
-			_, err := conn.DeleteDomain(input)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CodeArtifact Domain (%s): %w", domain, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping CodeArtifact Domain sweep for %s: %s", region, err)
-		return nil
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing CodeArtifact Domains for %s: %w", region, err))
 	}
 
-	if err != nil {
-		return fmt.Errorf("error listing CodeArtifact Domains: %w", err)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping CodeArtifact Domains for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping CodeArtifact Domain sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepRepositories(region string) error {
@@ -80,43 +85,43 @@ func sweepRepositories(region string) error {
 	}
 	conn := client.(*conns.AWSClient).CodeArtifactConn
 	input := &codeartifact.ListRepositoriesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListRepositoriesPages(input, func(page *codeartifact.ListRepositoriesOutput, lastPage bool) bool {
-		for _, repositoryPtr := range page.Repositories {
-			if repositoryPtr == nil {
-				continue
-			}
+		if page == nil {
+			return !lastPage
+		}
 
-			repository := aws.StringValue(repositoryPtr.Name)
-			input := &codeartifact.DeleteRepositoryInput{
-				Repository:  repositoryPtr.Name,
-				Domain:      repositoryPtr.DomainName,
-				DomainOwner: repositoryPtr.DomainOwner,
+		for _, repository := range page.Repositories {
+			if repository == nil {
+				continue
 			}
 
-			log.Printf("[INFO] Deleting CodeArtifact Repository: %s", repository)
+			r := ResourceRepository()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(repository.Arn))
+			d.Set("domain", repository.DomainName)
+			d.Set("repository", repository.Name)
 
-			_, err := conn.DeleteRepository(input)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting CodeArtifact Repository (%s): %w", repository, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping CodeArtifact Repository sweep for %s: %s", region, err)
-		return nil
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing CodeArtifact Repositories for %s: %w", region, err))
 	}
 
-	if err != nil {
-		return fmt.Errorf("error listing CodeArtifact Repositories: %w", err)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping CodeArtifact Repositories for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping CodeArtifact Repository sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
@@ -11,28 +11,30 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/configservice"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_aggregate_authorization", &resource.Sweeper{
 		Name: "aws_config_aggregate_authorization",
 		F:    sweepAggregateAuthorizations,
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_configuration_aggregator", &resource.Sweeper{
 		Name: "aws_config_configuration_aggregator",
 		F:    sweepConfigurationAggregators,
 	})
 
-	resource.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_configuration_recorder", &resource.Sweeper{
 		Name: "aws_config_configuration_recorder",
 		F:    sweepConfigurationRecorder,
 	})
 
-	resource.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_config_delivery_channel", &resource.Sweeper{
 		Name: "aws_config_delivery_channel",
 		Dependencies: []string{
 			"aws_config_configuration_recorder",
@@ -47,35 +49,33 @@ func sweepAggregateAuthorizations(region string) error {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ConfigServiceConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	aggregateAuthorizations, err := DescribeAggregateAuthorizations(conn)
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Config Aggregate Authorizations sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving config aggregate authorizations: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing Config Aggregate Authorizations for %s: %w", region, err))
 	}
 
-	if len(aggregateAuthorizations) == 0 {
-		log.Print("[DEBUG] No config aggregate authorizations to sweep")
-		return nil
+	for _, auth := range aggregateAuthorizations {
+		r := ResourceAggregateAuthorization()
+		d := r.Data(nil)
+		d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(auth.AuthorizedAccountId), aws.StringValue(auth.AuthorizedAwsRegion)))
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
-	log.Printf("[INFO] Found %d config aggregate authorizations", len(aggregateAuthorizations))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Config Aggregate Authorizations for %s: %w", region, err))
+	}
 
-	for _, auth := range aggregateAuthorizations {
-		log.Printf("[INFO] Deleting config authorization %s", *auth.AggregationAuthorizationArn)
-		_, err := conn.DeleteAggregationAuthorization(&configservice.DeleteAggregationAuthorizationInput{
-			AuthorizedAccountId: auth.AuthorizedAccountId,
-			AuthorizedAwsRegion: auth.AuthorizedAwsRegion,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting config aggregate authorization %s: %s", *auth.AggregationAuthorizationArn, err)
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Config Aggregate Authorizations sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepConfigurationAggregators(region string) error {
@@ -84,36 +84,45 @@ func sweepConfigurationAggregators(region string) error {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ConfigServiceConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	resp, err := conn.DescribeConfigurationAggregators(&configservice.DescribeConfigurationAggregatorsInput{})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Config Configuration Aggregators sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving config configuration aggregators: %s", err)
-	}
+	input := &configservice.DescribeConfigurationAggregatorsInput{}
 
-	if len(resp.ConfigurationAggregators) == 0 {
-		log.Print("[DEBUG] No config configuration aggregators to sweep")
-		return nil
-	}
+	for {
+		output, err := conn.DescribeConfigurationAggregators(input)
 
-	log.Printf("[INFO] Found %d config configuration aggregators", len(resp.ConfigurationAggregators))
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Config Configuration Aggregators for %s: %w", region, err))
+			break
+		}
 
-	for _, agg := range resp.ConfigurationAggregators {
-		log.Printf("[INFO] Deleting config configuration aggregator %s", *agg.ConfigurationAggregatorName)
-		_, err := conn.DeleteConfigurationAggregator(&configservice.DeleteConfigurationAggregatorInput{
-			ConfigurationAggregatorName: agg.ConfigurationAggregatorName,
-		})
+		for _, agg := range output.ConfigurationAggregators {
+			r := ResourceConfigurationAggregator()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(agg.ConfigurationAggregatorName))
+			d.Set("name", agg.ConfigurationAggregatorName)
 
-		if err != nil {
-			return fmt.Errorf("error deleting config configuration aggregator %s: %w",
-				aws.StringValue(agg.ConfigurationAggregatorName), err)
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
 		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Config Configuration Aggregators for %s: %w", region, err))
 	}
 
-	return nil
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Config Configuration Aggregators sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepConfigurationRecorder(region string) error {
@@ -122,41 +131,61 @@ func sweepConfigurationRecorder(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ConfigServiceConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	output, err := conn.DescribeConfigurationRecorders(&configservice.DescribeConfigurationRecordersInput{})
 
-	req := &configservice.DescribeConfigurationRecordersInput{}
-	resp, err := conn.DescribeConfigurationRecorders(req)
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Config Configuration Recorders sweep for %s: %s", region, err)
-			return nil
+		errs = multierror.Append(errs, fmt.Errorf("error listing Config Configuration Recorders for %s: %w", region, err))
+	} else {
+		for _, cr := range output.ConfigurationRecorders {
+			r := resourceStoppedConfigurationRecorder()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(cr.Name))
+			d.Set("name", cr.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-		return fmt.Errorf("Error describing Configuration Recorders: %s", err)
 	}
 
-	if len(resp.ConfigurationRecorders) == 0 {
-		log.Print("[DEBUG] No AWS Config Configuration Recorder to sweep")
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Config Configuration Recorders for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Config Configuration Recorders sweep for %s: %s", region, errs)
 		return nil
 	}
 
-	for _, cr := range resp.ConfigurationRecorders {
-		_, err := conn.StopConfigurationRecorder(&configservice.StopConfigurationRecorderInput{
-			ConfigurationRecorderName: cr.Name,
-		})
-		if err != nil {
-			return err
-		}
+	return errs.ErrorOrNil()
+}
 
-		_, err = conn.DeleteConfigurationRecorder(&configservice.DeleteConfigurationRecorderInput{
-			ConfigurationRecorderName: cr.Name,
-		})
-		if err != nil {
-			return fmt.Errorf(
-				"Error deleting Configuration Recorder (%s): %s",
-				*cr.Name, err)
-		}
+// resourceStoppedConfigurationRecorder is a Config Configuration Recorder that
+// is stopped before it is deleted.
+func resourceStoppedConfigurationRecorder() *schema.Resource {
+	r := ResourceConfigurationRecorder()
+	r.Delete = resourceStoppedConfigurationRecorderDelete
+
+	return r
+}
+
+func resourceStoppedConfigurationRecorderDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ConfigServiceConn
+
+	_, err := conn.StopConfigurationRecorder(&configservice.StopConfigurationRecorderInput{
+		ConfigurationRecorderName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, configservice.ErrCodeNoSuchConfigurationRecorderException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error stopping Config Configuration Recorder (%s): %w", d.Id(), err)
 	}
 
-	return nil
+	return resourceConfigurationRecorderDelete(d, meta)
 }
 
 func sweepDeliveryChannels(region string) error {
@@ -165,6 +194,8 @@ func sweepDeliveryChannels(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ConfigServiceConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	req := &configservice.DescribeDeliveryChannelsInput{}
 	var resp *configservice.DescribeDeliveryChannelsOutput
@@ -180,29 +211,28 @@ func sweepDeliveryChannels(region string) error {
 		}
 		return nil
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Config Delivery Channels sweep for %s: %s", region, err)
-			return nil
+		errs = multierror.Append(errs, fmt.Errorf("error listing Config Delivery Channels for %s: %w", region, err))
+	} else {
+		for _, dc := range resp.DeliveryChannels {
+			r := ResourceDeliveryChannel()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(dc.Name))
+			d.Set("name", dc.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-		return fmt.Errorf("Error describing Delivery Channels: %s", err)
 	}
 
-	if len(resp.DeliveryChannels) == 0 {
-		log.Print("[DEBUG] No AWS Config Delivery Channel to sweep")
-		return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Config Delivery Channels for %s: %w", region, err))
 	}
 
-	for _, dc := range resp.DeliveryChannels {
-		_, err := conn.DeleteDeliveryChannel(&configservice.DeleteDeliveryChannelInput{
-			DeliveryChannelName: dc.Name,
-		})
-		if err != nil {
-			return fmt.Errorf(
-				"Error deleting Delivery Channel (%s): %s",
-				*dc.Name, err)
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Config Delivery Channels sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
@@ -10,44 +10,44 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/datasync"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_agent", &resource.Sweeper{
 		Name: "aws_datasync_agent",
 		F:    sweepAgents,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_efs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_efs", &resource.Sweeper{
 		Name: "aws_datasync_location_efs",
 		F:    sweepLocationEFSs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_fsx_windows_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_fsx_windows_file_system", &resource.Sweeper{
 		Name: "aws_datasync_location_fsx_windows_file_system",
 		F:    sweepLocationFSxWindows,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_nfs", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_nfs", &resource.Sweeper{
 		Name: "aws_datasync_location_nfs",
 		F:    sweepLocationNFSs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_s3", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_s3", &resource.Sweeper{
 		Name: "aws_datasync_location_s3",
 		F:    sweepLocationS3s,
 	})
 
-	resource.AddTestSweepers("aws_datasync_location_smb", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_location_smb", &resource.Sweeper{
 		Name: "aws_datasync_location_smb",
 		F:    sweepLocationSMBs,
 	})
 
-	resource.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_datasync_task", &resource.Sweeper{
 		Name: "aws_datasync_task",
 		F:    sweepTasks,
 	})
@@ -59,42 +59,25 @@ func sweepAgents(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &datasync.ListAgentsInput{}
 	for {
 		output, err := conn.ListAgents(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping DataSync Agent sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Agents: %s", err)
-		}
-
-		if len(output.Agents) == 0 {
-			log.Print("[DEBUG] No DataSync Agents to sweep")
-			return nil
+			errs = multierror.Append(errs, fmt.Errorf("error listing DataSync Agents for %s: %w", region, err))
+			break
 		}
 
 		for _, agent := range output.Agents {
-			name := aws.StringValue(agent.Name)
-
-			log.Printf("[INFO] Deleting DataSync Agent: %s", name)
-			input := &datasync.DeleteAgentInput{
-				AgentArn: agent.AgentArn,
-			}
-
-			_, err := conn.DeleteAgent(input)
-
-			if tfawserr.ErrMessageContains(err, "InvalidRequestException", "does not exist") {
-				continue
-			}
+			r := ResourceAgent()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(agent.AgentArn))
+			d.Set("name", agent.Name)
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Agent (%s): %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -104,7 +87,16 @@ func sweepAgents(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping DataSync Agents for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping DataSync Agent sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepLocationEFSs(region string) error {
@@ -113,23 +105,16 @@ func sweepLocationEFSs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &datasync.ListLocationsInput{}
 	for {
 		output, err := conn.ListLocations(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping DataSync Location EFS sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Location EFSs: %s", err)
-		}
-
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location EFSs to sweep")
-			return nil
+			errs = multierror.Append(errs, fmt.Errorf("error listing DataSync Locations for %s: %w", region, err))
+			break
 		}
 
 		for _, location := range output.Locations {
@@ -138,20 +123,12 @@ func sweepLocationEFSs(region string) error {
 				log.Printf("[INFO] Skipping DataSync Location EFS: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location EFS: %s", uri)
-			input := &datasync.DeleteLocationInput{
-				LocationArn: location.LocationArn,
-			}
-
-			_, err := conn.DeleteLocation(input)
 
-			if tfawserr.ErrMessageContains(err, "InvalidRequestException", "not found") {
-				continue
-			}
+			r := ResourceLocationEFS()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(location.LocationArn))
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location EFS (%s): %s", uri, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -161,7 +138,16 @@ func sweepLocationEFSs(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping DataSync Location EFSs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping DataSync Location EFS sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepLocationFSxWindows(region string) error {
@@ -170,45 +156,30 @@ func sweepLocationFSxWindows(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &datasync.ListLocationsInput{}
 	for {
 		output, err := conn.ListLocations(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping DataSync Location FSX Windows sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error retrieving DataSync Location FSX Windows: %w", err)
-		}
-
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location FSX Windows File System to sweep")
-			return nil
+			errs = multierror.Append(errs, fmt.Errorf("error listing DataSync Locations for %s: %w", region, err))
+			break
 		}
 
 		for _, location := range output.Locations {
 			uri := aws.StringValue(location.LocationUri)
 			if !strings.HasPrefix(uri, "fsxw://") {
-				log.Printf("[INFO] Skipping DataSync Location FSX Windows File System: %s", uri)
+				log.Printf("[INFO] Skipping DataSync Location FSx Windows File System: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location FSX Windows File System: %s", uri)
-			input := &datasync.DeleteLocationInput{
-				LocationArn: location.LocationArn,
-			}
-
-			_, err := conn.DeleteLocation(input)
 
-			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
-				continue
-			}
+			r := ResourceLocationFSxWindowsFileSystem()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(location.LocationArn))
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location FSX Windows (%s): %s", uri, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -218,7 +189,16 @@ func sweepLocationFSxWindows(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping DataSync Location FSx Windows File Systems for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping DataSync Location FSx Windows File System sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepLocationNFSs(region string) error {
@@ -227,44 +207,30 @@ func sweepLocationNFSs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &datasync.ListLocationsInput{}
 	for {
 		output, err := conn.ListLocations(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping DataSync Location Nfs sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Location Nfss: %s", err)
-		}
-
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location Nfss to sweep")
-			return nil
+			errs = multierror.Append(errs, fmt.Errorf("error listing DataSync Locations for %s: %w", region, err))
+			break
 		}
 
 		for _, location := range output.Locations {
 			uri := aws.StringValue(location.LocationUri)
 			if !strings.HasPrefix(uri, "nfs://") {
-				log.Printf("[INFO] Skipping DataSync Location Nfs: %s", uri)
+				log.Printf("[INFO] Skipping DataSync Location NFS: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location Nfs: %s", uri)
 
 			r := ResourceLocationNFS()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(location.LocationArn))
-			err = r.Delete(d, client)
-			if tfawserr.ErrMessageContains(err, "InvalidRequestException", "not found") {
-				continue
-			}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location Nfs (%s): %s", uri, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -274,7 +240,16 @@ func sweepLocationNFSs(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping DataSync Location NFSs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping DataSync Location NFS sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepLocationS3s(region string) error {
@@ -283,23 +258,16 @@ func sweepLocationS3s(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &datasync.ListLocationsInput{}
 	for {
 		output, err := conn.ListLocations(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping DataSync Location S3 sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Location S3s: %s", err)
-		}
-
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location S3s to sweep")
-			return nil
+			errs = multierror.Append(errs, fmt.Errorf("error listing DataSync Locations for %s: %w", region, err))
+			break
 		}
 
 		for _, location := range output.Locations {
@@ -308,20 +276,12 @@ func sweepLocationS3s(region string) error {
 				log.Printf("[INFO] Skipping DataSync Location S3: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location S3: %s", uri)
-			input := &datasync.DeleteLocationInput{
-				LocationArn: location.LocationArn,
-			}
 
-			_, err := conn.DeleteLocation(input)
-
-			if tfawserr.ErrMessageContains(err, "InvalidRequestException", "not found") {
-				continue
-			}
+			r := ResourceLocationS3()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(location.LocationArn))
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location S3 (%s): %s", uri, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -331,7 +291,16 @@ func sweepLocationS3s(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping DataSync Location S3s for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping DataSync Location S3 sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepLocationSMBs(region string) error {
@@ -340,23 +309,16 @@ func sweepLocationSMBs(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &datasync.ListLocationsInput{}
 	for {
 		output, err := conn.ListLocations(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping DataSync Location SMB sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Location SMBs: %w", err)
-		}
-
-		if len(output.Locations) == 0 {
-			log.Print("[DEBUG] No DataSync Location SMBs to sweep")
-			return nil
+			errs = multierror.Append(errs, fmt.Errorf("error listing DataSync Locations for %s: %w", region, err))
+			break
 		}
 
 		for _, location := range output.Locations {
@@ -365,19 +327,12 @@ func sweepLocationSMBs(region string) error {
 				log.Printf("[INFO] Skipping DataSync Location SMB: %s", uri)
 				continue
 			}
-			log.Printf("[INFO] Deleting DataSync Location SMB: %s", uri)
 
 			r := ResourceLocationSMB()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(location.LocationArn))
-			err = r.Delete(d, client)
-			if tfawserr.ErrMessageContains(err, "InvalidRequestException", "not found") {
-				continue
-			}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Location SMB (%s): %s", uri, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -387,7 +342,16 @@ func sweepLocationSMBs(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping DataSync Location SMBs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping DataSync Location SMB sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepTasks(region string) error {
@@ -396,42 +360,25 @@ func sweepTasks(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).DataSyncConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &datasync.ListTasksInput{}
 	for {
 		output, err := conn.ListTasks(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping DataSync Task sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving DataSync Tasks: %w", err)
-		}
-
-		if len(output.Tasks) == 0 {
-			log.Print("[DEBUG] No DataSync Tasks to sweep")
-			return nil
+			errs = multierror.Append(errs, fmt.Errorf("error listing DataSync Tasks for %s: %w", region, err))
+			break
 		}
 
 		for _, task := range output.Tasks {
-			name := aws.StringValue(task.Name)
-
-			log.Printf("[INFO] Deleting DataSync Task: %s", name)
-			input := &datasync.DeleteTaskInput{
-				TaskArn: task.TaskArn,
-			}
-
-			_, err := conn.DeleteTask(input)
-
-			if tfawserr.ErrMessageContains(err, datasync.ErrCodeInvalidRequestException, "not found") {
-				continue
-			}
+			r := ResourceTask()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(task.TaskArn))
+			d.Set("name", task.Name)
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DataSync Task (%s): %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -441,5 +388,14 @@ func sweepTasks(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping DataSync Tasks for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping DataSync Task sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
@@ -7,15 +7,17 @@ import (
 	"fmt"
 	"log"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/dax"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dax_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dax_cluster", &resource.Sweeper{
 		Name: "aws_dax_cluster",
 		F:    sweepClusters,
 	})
@@ -27,6 +29,8 @@ func sweepClusters(region string) error {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).DAXConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	resp, err := conn.DescribeClusters(&dax.DescribeClustersInput{})
 	if err != nil {
@@ -39,22 +43,23 @@ func sweepClusters(region string) error {
 		return fmt.Errorf("Error retrieving DAX clusters: %s", err)
 	}
 
-	if len(resp.Clusters) == 0 {
-		log.Print("[DEBUG] No DAX clusters to sweep")
-		return nil
+	for _, cluster := range resp.Clusters {
+		r := ResourceCluster()
+		d := r.Data(nil)
+		d.SetId(aws.StringValue(cluster.ClusterName))
+		d.Set("cluster_name", cluster.ClusterName)
+
+		sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 	}
 
-	log.Printf("[INFO] Found %d DAX clusters", len(resp.Clusters))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping DAX Clusters for %s: %w", region, err))
+	}
 
-	for _, cluster := range resp.Clusters {
-		log.Printf("[INFO] Deleting DAX cluster %s", *cluster.ClusterName)
-		_, err := conn.DeleteCluster(&dax.DeleteClusterInput{
-			ClusterName: cluster.ClusterName,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting DAX cluster %s: %s", *cluster.ClusterName, err)
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping DAX Cluster sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
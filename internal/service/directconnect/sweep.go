@@ -17,17 +17,17 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_connection", &resource.Sweeper{
 		Name: "aws_dx_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association_proposal", &resource.Sweeper{
 		Name: "aws_dx_gateway_association_proposal",
 		F:    sweepGatewayAssociationProposals,
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway_association", &resource.Sweeper{
 		Name: "aws_dx_gateway_association",
 		F:    sweepGatewayAssociations,
 		Dependencies: []string{
@@ -35,7 +35,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_gateway", &resource.Sweeper{
 		Name: "aws_dx_gateway",
 		F:    sweepGateways,
 		Dependencies: []string{
@@ -43,7 +43,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_dx_lag", &resource.Sweeper{
 		Name:         "aws_dx_lag",
 		F:            sweepLags,
 		Dependencies: []string{"aws_dx_connection"},
@@ -58,53 +58,43 @@ func sweepConnections(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).DirectConnectConn
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &directconnect.DescribeConnectionsInput{}
 
 	// DescribeConnections has no pagination support
 	output, err := conn.DescribeConnections(input)
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Direct Connect Connection sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
-	}
-
 	if err != nil {
-		sweeperErr := fmt.Errorf("error listing Direct Connect Connections for %s: %w", region, err)
-		log.Printf("[ERROR] %s", sweeperErr)
-		sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-		return sweeperErrs.ErrorOrNil()
-	}
-
-	if output == nil {
-		log.Printf("[WARN] Skipping Direct Connect Connection sweep for %s: empty response", region)
-		return sweeperErrs.ErrorOrNil()
+		errs = multierror.Append(errs, fmt.Errorf("error listing Direct Connect Connections for %s: %w", region, err))
 	}
 
-	for _, connection := range output.Connections {
-		if connection == nil {
-			continue
-		}
+	if output != nil {
+		for _, connection := range output.Connections {
+			if connection == nil {
+				continue
+			}
 
-		id := aws.StringValue(connection.ConnectionId)
+			r := ResourceConnection()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(connection.ConnectionId))
+			d.Set("name", connection.ConnectionName)
 
-		r := ResourceConnection()
-		d := r.Data(nil)
-		d.SetId(id)
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
 
-		err = r.Delete(d, client)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Direct Connect Connections for %s: %w", region, err))
+	}
 
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting Direct Connect Connection (%s): %w", id, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Direct Connect Connection sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepGatewayAssociationProposals(region string) error {
@@ -391,51 +381,41 @@ func sweepLags(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).DirectConnectConn
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &directconnect.DescribeLagsInput{}
 
 	// DescribeLags has no pagination support
 	output, err := conn.DescribeLags(input)
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Direct Connect LAG sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
-	}
-
 	if err != nil {
-		sweeperErr := fmt.Errorf("error listing Direct Connect LAGs for %s: %w", region, err)
-		log.Printf("[ERROR] %s", sweeperErr)
-		sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-		return sweeperErrs.ErrorOrNil()
-	}
-
-	if output == nil {
-		log.Printf("[WARN] Skipping Direct Connect LAG sweep for %s: empty response", region)
-		return sweeperErrs.ErrorOrNil()
+		errs = multierror.Append(errs, fmt.Errorf("error listing Direct Connect LAGs for %s: %w", region, err))
 	}
 
-	for _, lag := range output.Lags {
-		if lag == nil {
-			continue
-		}
+	if output != nil {
+		for _, lag := range output.Lags {
+			if lag == nil {
+				continue
+			}
 
-		id := aws.StringValue(lag.LagId)
+			r := ResourceLag()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(lag.LagId))
+			d.Set("name", lag.LagName)
 
-		r := ResourceLag()
-		d := r.Data(nil)
-		d.SetId(id)
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+	}
 
-		err = r.Delete(d, client)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Direct Connect LAGs for %s: %w", region, err))
+	}
 
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting Direct Connect LAG (%s): %w", id, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Direct Connect LAG sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
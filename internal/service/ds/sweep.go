@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_directory_service_directory", &resource.Sweeper{
 		Name: "aws_directory_service_directory",
 		F:    sweepDirectories,
 		Dependencies: []string{
@@ -38,8 +38,8 @@ func sweepDirectories(region string) error {
 	}
 
 	conn := client.(*conns.AWSClient).DSConn
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &directoryservice.DescribeDirectoriesInput{}
 
@@ -49,35 +49,29 @@ func sweepDirectories(region string) error {
 		}
 
 		for _, directory := range page.DirectoryDescriptions {
-			id := aws.StringValue(directory.DirectoryId)
-
 			r := ResourceDirectory()
 			d := r.Data(nil)
-			d.SetId(id)
+			d.SetId(aws.StringValue(directory.DirectoryId))
+			d.Set("name", directory.Name)
 
-			err := r.Delete(d, client)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Directory Service Directory (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Directory Service Directory sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Directory Service Directories for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErr := fmt.Errorf("error listing Directory Service Directories: %w", err)
-		log.Printf("[ERROR] %s", sweeperErr)
-		sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Directory Service Directories for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Directory Service Directory sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
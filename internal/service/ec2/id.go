@@ -113,6 +113,82 @@ func TransitGatewayPrefixListReferenceParseID(id string) (string, string, error)
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected transit-gateway-route-table-id%[2]sprefix-list-id", id, transitGatewayPrefixListReferenceSeparator)
 }
 
+const transitGatewayMulticastDomainAssociationIDSeparator = "/"
+
+func TransitGatewayMulticastDomainAssociationCreateID(multicastDomainID, attachmentID, subnetID string) string {
+	parts := []string{multicastDomainID, attachmentID, subnetID}
+	id := strings.Join(parts, transitGatewayMulticastDomainAssociationIDSeparator)
+
+	return id
+}
+
+func TransitGatewayMulticastDomainAssociationParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, transitGatewayMulticastDomainAssociationIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected multicast-domain-id%[2]sattachment-id%[2]ssubnet-id", id, transitGatewayMulticastDomainAssociationIDSeparator)
+}
+
+const transitGatewayMulticastGroupMemberIDSeparator = "/"
+
+func TransitGatewayMulticastGroupMemberCreateID(multicastDomainID, groupIPAddress, eniID string) string {
+	parts := []string{multicastDomainID, groupIPAddress, eniID}
+	id := strings.Join(parts, transitGatewayMulticastGroupMemberIDSeparator)
+
+	return id
+}
+
+func TransitGatewayMulticastGroupMemberParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, transitGatewayMulticastGroupMemberIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected multicast-domain-id%[2]sgroup-ip-address%[2]seni-id", id, transitGatewayMulticastGroupMemberIDSeparator)
+}
+
+const transitGatewayMulticastGroupSourceIDSeparator = "/"
+
+func TransitGatewayMulticastGroupSourceCreateID(multicastDomainID, groupIPAddress, eniID string) string {
+	parts := []string{multicastDomainID, groupIPAddress, eniID}
+	id := strings.Join(parts, transitGatewayMulticastGroupSourceIDSeparator)
+
+	return id
+}
+
+func TransitGatewayMulticastGroupSourceParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, transitGatewayMulticastGroupSourceIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected multicast-domain-id%[2]sgroup-ip-address%[2]seni-id", id, transitGatewayMulticastGroupSourceIDSeparator)
+}
+
+const transitGatewayPolicyTableAssociationIDSeparator = "_"
+
+func TransitGatewayPolicyTableAssociationCreateID(policyTableID, attachmentID string) string {
+	parts := []string{policyTableID, attachmentID}
+	id := strings.Join(parts, transitGatewayPolicyTableAssociationIDSeparator)
+
+	return id
+}
+
+func TransitGatewayPolicyTableAssociationParseID(id string) (string, string, error) {
+	parts := strings.Split(id, transitGatewayPolicyTableAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected transit-gateway-policy-table-id%[2]stransit-gateway-attachment-id", id, transitGatewayPolicyTableAssociationIDSeparator)
+}
+
 func VPCEndpointRouteTableAssociationCreateID(vpcEndpointID, routeTableID string) string {
 	return fmt.Sprintf("a-%s%d", vpcEndpointID, create.StringHashcode(routeTableID))
 }
@@ -38,7 +38,11 @@ func ResourceInstance() *schema.Resource {
 		Update: resourceInstanceUpdate,
 		Delete: resourceInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("user_data_replace_on_change", false)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		SchemaVersion: 1,
@@ -563,7 +567,6 @@ func ResourceInstance() *schema.Resource {
 			"user_data": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				Computed:      true,
 				ConflictsWith: []string{"user_data_base64"},
 				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
@@ -588,7 +591,6 @@ func ResourceInstance() *schema.Resource {
 			"user_data_base64": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ForceNew:      true,
 				Computed:      true,
 				ConflictsWith: []string{"user_data"},
 				ValidateFunc: func(v interface{}, name string) (warns []string, errs []error) {
@@ -601,6 +603,11 @@ func ResourceInstance() *schema.Resource {
 					return
 				},
 			},
+			"user_data_replace_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 			"volume_tags": tftags.TagsSchema(),
 			"vpc_security_group_ids": {
 				Type:     schema.TypeSet,
@@ -690,6 +697,12 @@ func ResourceInstance() *schema.Resource {
 
 				return nil
 			},
+			customdiff.ForceNewIf("user_data", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("user_data") && diff.Get("user_data_replace_on_change").(bool)
+			}),
+			customdiff.ForceNewIf("user_data_base64", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("user_data_base64") && diff.Get("user_data_replace_on_change").(bool)
+			}),
 			customdiff.ComputedIf("launch_template.0.id", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.name")
 			}),
@@ -1422,73 +1435,38 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	if d.HasChange("instance_type") && !d.IsNewResource() {
-		log.Printf("[INFO] Stopping Instance %q for instance_type change", d.Id())
-		_, err := conn.StopInstances(&ec2.StopInstancesInput{
-			InstanceIds: []*string{aws.String(d.Id())},
-		})
-		if err != nil {
-			return fmt.Errorf("error stopping instance (%s): %s", d.Id(), err)
-		}
+	// Changing the instance type or user data requires the instance to be stopped.
+	// EBS-backed instances are stopped, modified and then started again (if they were running);
+	// user data changes force replacement instead when user_data_replace_on_change is set.
+	if d.HasChanges("instance_type", "user_data", "user_data_base64") && !d.IsNewResource() {
+		var inputs []*ec2.ModifyInstanceAttributeInput
 
-		if err := WaitForInstanceStopping(conn, d.Id(), InstanceStopTimeout); err != nil {
-			return err
-		}
-
-		log.Printf("[INFO] Modifying instance type %s", d.Id())
-		_, err = conn.ModifyInstanceAttribute(&ec2.ModifyInstanceAttributeInput{
-			InstanceId: aws.String(d.Id()),
-			InstanceType: &ec2.AttributeValue{
-				Value: aws.String(d.Get("instance_type").(string)),
-			},
-		})
-		if err != nil {
-			return err
-		}
-
-		log.Printf("[INFO] Starting Instance %q after instance_type change", d.Id())
-
-		input := &ec2.StartInstancesInput{
-			InstanceIds: []*string{aws.String(d.Id())},
+		if d.HasChange("instance_type") {
+			inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				InstanceType: &ec2.AttributeValue{
+					Value: aws.String(d.Get("instance_type").(string)),
+				},
+			})
 		}
 
-		// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/16433
-		err = resource.Retry(InstanceAttributePropagationTimeout, func() *resource.RetryError {
-			_, err := conn.StartInstances(input)
-
-			if tfawserr.ErrMessageContains(err, ErrCodeInvalidParameterValue, "LaunchPlan instance type does not match attribute value") {
-				return resource.RetryableError(err)
-			}
+		if d.HasChanges("user_data", "user_data_base64") {
+			userData, err := instanceUserDataFromConfig(d)
 
 			if err != nil {
-				return resource.NonRetryableError(err)
+				return err
 			}
 
-			return nil
-		})
-
-		if tfresource.TimedOut(err) {
-			_, err = conn.StartInstances(input)
-		}
-
-		if err != nil {
-			return fmt.Errorf("error starting EC2 Instance (%s): %w", d.Id(), err)
-		}
-
-		stateConf := &resource.StateChangeConf{
-			Pending:    []string{ec2.InstanceStateNamePending, ec2.InstanceStateNameStopped},
-			Target:     []string{ec2.InstanceStateNameRunning},
-			Refresh:    InstanceStateRefreshFunc(conn, d.Id(), []string{ec2.InstanceStateNameTerminated}),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      10 * time.Second,
-			MinTimeout: 3 * time.Second,
+			inputs = append(inputs, &ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				UserData: &ec2.BlobAttributeValue{
+					Value: userData,
+				},
+			})
 		}
 
-		_, err = stateConf.WaitForState()
-		if err != nil {
-			return fmt.Errorf(
-				"Error waiting for instance (%s) to become ready: %s",
-				d.Id(), err)
+		if err := modifyInstanceAttributesWithStopStart(conn, d.Id(), inputs, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
 		}
 	}
 
@@ -2773,6 +2751,125 @@ func terminateInstance(conn *ec2.EC2, id string, timeout time.Duration) error {
 	return waitForInstanceDeletion(conn, id, timeout)
 }
 
+// modifyInstanceAttributesWithStopStart applies instance attribute modifications that are only
+// permitted on stopped instances. A running instance is stopped first and started again afterwards.
+func modifyInstanceAttributesWithStopStart(conn *ec2.EC2, id string, inputs []*ec2.ModifyInstanceAttributeInput, startTimeout time.Duration) error {
+	instance, err := FindInstanceByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance (%s): %w", id, err)
+	}
+
+	if instance == nil {
+		return fmt.Errorf("error reading EC2 Instance (%s): not found", id)
+	}
+
+	if aws.StringValue(instance.RootDeviceType) == ec2.DeviceTypeInstanceStore {
+		return fmt.Errorf("EC2 Instance (%s) has an instance store root device and cannot be stopped to modify its attributes", id)
+	}
+
+	running := instance.State == nil || aws.StringValue(instance.State.Name) != ec2.InstanceStateNameStopped
+
+	if running {
+		if err := stopInstance(conn, id, InstanceStopTimeout); err != nil {
+			return err
+		}
+	}
+
+	for _, input := range inputs {
+		log.Printf("[INFO] Modifying EC2 Instance attribute: %s", input)
+		if _, err := conn.ModifyInstanceAttribute(input); err != nil {
+			return fmt.Errorf("error modifying EC2 Instance (%s) attribute: %w", id, err)
+		}
+	}
+
+	if running {
+		if err := startInstance(conn, id, startTimeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func stopInstance(conn *ec2.EC2, id string, timeout time.Duration) error {
+	log.Printf("[INFO] Stopping EC2 Instance: %s", id)
+	_, err := conn.StopInstances(&ec2.StopInstancesInput{
+		InstanceIds: aws.StringSlice([]string{id}),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error stopping EC2 Instance (%s): %w", id, err)
+	}
+
+	return WaitForInstanceStopping(conn, id, timeout)
+}
+
+func startInstance(conn *ec2.EC2, id string, timeout time.Duration) error {
+	log.Printf("[INFO] Starting EC2 Instance: %s", id)
+	input := &ec2.StartInstancesInput{
+		InstanceIds: aws.StringSlice([]string{id}),
+	}
+
+	// Reference: https://github.com/hashicorp/terraform-provider-aws/issues/16433
+	err := resource.Retry(InstanceAttributePropagationTimeout, func() *resource.RetryError {
+		_, err := conn.StartInstances(input)
+
+		if tfawserr.ErrMessageContains(err, ErrCodeInvalidParameterValue, "LaunchPlan instance type does not match attribute value") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.StartInstances(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error starting EC2 Instance (%s): %w", id, err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{ec2.InstanceStateNamePending, ec2.InstanceStateNameStopped},
+		Target:     []string{ec2.InstanceStateNameRunning},
+		Refresh:    InstanceStateRefreshFunc(conn, id, []string{ec2.InstanceStateNameTerminated}),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("error waiting for EC2 Instance (%s) to start: %w", id, err)
+	}
+
+	return nil
+}
+
+// instanceUserDataFromConfig returns the raw (unencoded) user data from configuration.
+// user_data is stored in state as a hash so the configured value is read from the raw configuration.
+func instanceUserDataFromConfig(d *schema.ResourceData) ([]byte, error) {
+	if v := d.GetRawConfig().GetAttr("user_data"); v.IsKnown() && !v.IsNull() {
+		return []byte(v.AsString()), nil
+	}
+
+	if v, ok := d.GetOk("user_data_base64"); ok {
+		userData, err := base64.StdEncoding.DecodeString(v.(string))
+
+		if err != nil {
+			return nil, fmt.Errorf("error decoding user_data_base64: %w", err)
+		}
+
+		return userData, nil
+	}
+
+	return []byte{}, nil
+}
+
 func WaitForInstanceStopping(conn *ec2.EC2, id string, timeout time.Duration) error {
 	log.Printf("[DEBUG] Waiting for instance (%s) to become stopped", id)
 
@@ -0,0 +1,140 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+const (
+	// instanceMetadataDefaultsHopLimitNoPreference removes the account-level default
+	// for the HTTP PUT response hop limit.
+	instanceMetadataDefaultsHopLimitNoPreference = -1
+)
+
+func ResourceInstanceMetadataDefaults() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInstanceMetadataDefaultsPut,
+		Read:   resourceInstanceMetadataDefaultsRead,
+		Update: resourceInstanceMetadataDefaultsPut,
+		Delete: resourceInstanceMetadataDefaultsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"http_endpoint": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.DefaultInstanceMetadataEndpointStateNoPreference,
+				ValidateFunc: validation.StringInSlice(ec2.DefaultInstanceMetadataEndpointState_Values(), false),
+			},
+			"http_put_response_hop_limit": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  instanceMetadataDefaultsHopLimitNoPreference,
+				ValidateFunc: validation.Any(
+					validation.IntInSlice([]int{instanceMetadataDefaultsHopLimitNoPreference}),
+					validation.IntBetween(1, 64),
+				),
+			},
+			"http_tokens": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.MetadataDefaultHttpTokensStateNoPreference,
+				ValidateFunc: validation.StringInSlice(ec2.MetadataDefaultHttpTokensState_Values(), false),
+			},
+			"instance_metadata_tags": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ec2.DefaultInstanceMetadataTagsStateNoPreference,
+				ValidateFunc: validation.StringInSlice(ec2.DefaultInstanceMetadataTagsState_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceInstanceMetadataDefaultsPut(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	input := &ec2.ModifyInstanceMetadataDefaultsInput{
+		HttpEndpoint:            aws.String(d.Get("http_endpoint").(string)),
+		HttpPutResponseHopLimit: aws.Int64(int64(d.Get("http_put_response_hop_limit").(int))),
+		HttpTokens:              aws.String(d.Get("http_tokens").(string)),
+		InstanceMetadataTags:    aws.String(d.Get("instance_metadata_tags").(string)),
+	}
+
+	log.Printf("[DEBUG] Modifying EC2 Instance Metadata Defaults: %s", input)
+	_, err := conn.ModifyInstanceMetadataDefaults(input)
+
+	if err != nil {
+		return fmt.Errorf("error modifying EC2 Instance Metadata Defaults: %w", err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(meta.(*conns.AWSClient).Region)
+	}
+
+	return resourceInstanceMetadataDefaultsRead(d, meta)
+}
+
+func resourceInstanceMetadataDefaultsRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	output, err := FindInstanceMetadataDefaults(conn)
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Instance Metadata Defaults (%s): %w", d.Id(), err)
+	}
+
+	// Settings without an account-level default are not returned.
+	httpEndpoint := ec2.DefaultInstanceMetadataEndpointStateNoPreference
+	if v := aws.StringValue(output.HttpEndpoint); v != "" {
+		httpEndpoint = v
+	}
+	d.Set("http_endpoint", httpEndpoint)
+
+	httpPutResponseHopLimit := int64(instanceMetadataDefaultsHopLimitNoPreference)
+	if v := aws.Int64Value(output.HttpPutResponseHopLimit); v != 0 {
+		httpPutResponseHopLimit = v
+	}
+	d.Set("http_put_response_hop_limit", httpPutResponseHopLimit)
+
+	httpTokens := ec2.MetadataDefaultHttpTokensStateNoPreference
+	if v := aws.StringValue(output.HttpTokens); v != "" {
+		httpTokens = v
+	}
+	d.Set("http_tokens", httpTokens)
+
+	instanceMetadataTags := ec2.DefaultInstanceMetadataTagsStateNoPreference
+	if v := aws.StringValue(output.InstanceMetadataTags); v != "" {
+		instanceMetadataTags = v
+	}
+	d.Set("instance_metadata_tags", instanceMetadataTags)
+
+	return nil
+}
+
+func resourceInstanceMetadataDefaultsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	// Removing the resource removes all account-level defaults.
+	log.Printf("[DEBUG] Resetting EC2 Instance Metadata Defaults: %s", d.Id())
+	_, err := conn.ModifyInstanceMetadataDefaults(&ec2.ModifyInstanceMetadataDefaultsInput{
+		HttpEndpoint:            aws.String(ec2.DefaultInstanceMetadataEndpointStateNoPreference),
+		HttpPutResponseHopLimit: aws.Int64(instanceMetadataDefaultsHopLimitNoPreference),
+		HttpTokens:              aws.String(ec2.MetadataDefaultHttpTokensStateNoPreference),
+		InstanceMetadataTags:    aws.String(ec2.DefaultInstanceMetadataTagsStateNoPreference),
+	})
+
+	if err != nil {
+		return fmt.Errorf("error resetting EC2 Instance Metadata Defaults (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+)
+
+// Instance metadata defaults are account-wide, so the tests must not run in parallel.
+func TestAccEC2InstanceMetadataDefaults_basic(t *testing.T) {
+	resourceName := "aws_ec2_instance_metadata_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceMetadataDefaultsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_full(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceMetadataDefaults(resourceName, ec2.MetadataDefaultHttpTokensStateRequired),
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", ec2.DefaultInstanceMetadataEndpointStateEnabled),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "2"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", ec2.MetadataDefaultHttpTokensStateRequired),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", ec2.DefaultInstanceMetadataTagsStateDisabled),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceMetadataDefaults(resourceName, ec2.MetadataDefaultHttpTokensStateNoPreference),
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", ec2.DefaultInstanceMetadataEndpointStateNoPreference),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", ec2.MetadataDefaultHttpTokensStateNoPreference),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", ec2.DefaultInstanceMetadataTagsStateNoPreference),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInstanceMetadataDefaultsDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+	output, err := tfec2.FindInstanceMetadataDefaults(conn)
+
+	if err != nil {
+		return err
+	}
+
+	if output.HttpEndpoint != nil || output.HttpPutResponseHopLimit != nil || output.HttpTokens != nil || output.InstanceMetadataTags != nil {
+		return fmt.Errorf("EC2 Instance Metadata Defaults not reset on resource removal: %s", output)
+	}
+
+	return nil
+}
+
+func testAccCheckInstanceMetadataDefaults(n, httpTokens string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
+
+		output, err := tfec2.FindInstanceMetadataDefaults(conn)
+
+		if err != nil {
+			return err
+		}
+
+		got := aws.StringValue(output.HttpTokens)
+		if got == "" {
+			got = ec2.MetadataDefaultHttpTokensStateNoPreference
+		}
+
+		if got != httpTokens {
+			return fmt.Errorf("EC2 Instance Metadata Defaults http_tokens is %q, expected %q", got, httpTokens)
+		}
+
+		return nil
+	}
+}
+
+func testAccInstanceMetadataDefaultsConfig_basic() string {
+	return `
+resource "aws_ec2_instance_metadata_defaults" "test" {}
+`
+}
+
+func testAccInstanceMetadataDefaultsConfig_full() string {
+	return `
+resource "aws_ec2_instance_metadata_defaults" "test" {
+  http_endpoint               = "enabled"
+  http_put_response_hop_limit = 2
+  http_tokens                 = "required"
+  instance_metadata_tags      = "disabled"
+}
+`
+}
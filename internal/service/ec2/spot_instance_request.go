@@ -49,6 +49,9 @@ func ResourceSpotInstanceRequest() *schema.Resource {
 				v.ForceNew = true
 			}
 
+			// user_data changes always replace the Spot Instance Request.
+			delete(s, "user_data_replace_on_change")
+
 			s["volume_tags"] = &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -3,6 +3,7 @@ package ecs
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -59,3 +60,81 @@ func FindClusterByNameOrARN(conn *ecs.ECS, nameOrARN string) (*ecs.DescribeClust
 
 	return output, nil
 }
+
+func FindServiceByTwoPartKey(conn *ecs.ECS, id, cluster string) (*ecs.Service, error) {
+	input := &ecs.DescribeServicesInput{
+		Services: aws.StringSlice([]string{id}),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.DescribeServices(input)
+
+	if tfawserr.ErrCodeEquals(err, ecs.ErrCodeClusterNotFoundException, ecs.ErrCodeServiceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.Services) == 0 || output.Services[0] == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	service := output.Services[0]
+
+	if status := aws.StringValue(service.Status); status == serviceStatusInactive {
+		return nil, &resource.NotFoundError{
+			Message:     status,
+			LastRequest: input,
+		}
+	}
+
+	return service, nil
+}
+
+// FindStoppedTasksByServiceName returns up to maxResults of the most recently stopped tasks started by the specified service.
+func FindStoppedTasksByServiceName(conn *ecs.ECS, serviceName, cluster string, maxResults int) ([]*ecs.Task, error) {
+	input := &ecs.ListTasksInput{
+		DesiredStatus: aws.String(ecs.DesiredStatusStopped),
+		MaxResults:    aws.Int64(int64(maxResults)),
+		ServiceName:   aws.String(serviceName),
+	}
+
+	if cluster != "" {
+		input.Cluster = aws.String(cluster)
+	}
+
+	output, err := conn.ListTasks(input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.TaskArns) == 0 {
+		return nil, nil
+	}
+
+	describeInput := &ecs.DescribeTasksInput{
+		Cluster: input.Cluster,
+		Tasks:   output.TaskArns,
+	}
+
+	describeOutput, err := conn.DescribeTasks(describeInput)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if describeOutput == nil {
+		return nil, tfresource.NewEmptyResultError(describeInput)
+	}
+
+	return describeOutput.Tasks, nil
+}
@@ -37,10 +37,34 @@ func ResourceService() *schema.Resource {
 		},
 
 		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
 		Schema: map[string]*schema.Schema{
+			"alarms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_names": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"enable": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"rollback": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
 			"capacity_provider_strategy": {
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -332,6 +356,98 @@ func ResourceService() *schema.Resource {
 					ecs.SchedulingStrategyReplica,
 				}, false),
 			},
+			"service_connect_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"log_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_driver": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(ecs.LogDriver_Values(), false),
+									},
+									"options": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"secret_option": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"name": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+												"value_from": {
+													Type:     schema.TypeString,
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"namespace": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"service": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"client_alias": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"dns_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+												"port": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ValidateFunc: validation.IsPortNumber,
+												},
+											},
+										},
+									},
+									"discovery_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"ingress_port_override": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IsPortNumber,
+									},
+									"port_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"service_registries": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -470,10 +586,21 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if v, ok := d.GetOk("deployment_circuit_breaker"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-		input.DeploymentConfiguration = &ecs.DeploymentConfiguration{}
+		if input.DeploymentConfiguration == nil {
+			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{}
+		}
+
 		input.DeploymentConfiguration.DeploymentCircuitBreaker = expandECSDeploymentCircuitBreaker(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("alarms"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		if input.DeploymentConfiguration == nil {
+			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{}
+		}
+
+		input.DeploymentConfiguration.Alarms = expandECSDeploymentAlarms(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("cluster"); ok {
 		input.Cluster = aws.String(v.(string))
 	}
@@ -514,6 +641,10 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	input.NetworkConfiguration = expandEcsNetworkConfiguration(d.Get("network_configuration").([]interface{}))
 
+	if v, ok := d.GetOk("service_connect_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ServiceConnectConfiguration = expandECSServiceConnectConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	if v, ok := d.GetOk("ordered_placement_strategy"); ok {
 		ps, err := expandPlacementStrategy(v.([]interface{}))
 
@@ -559,6 +690,8 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Creating ECS service: %s", input)
 
+	var deploymentID string
+
 	// Retry due to AWS IAM & ECS eventual consistency
 	err := resource.Retry(tfiam.PropagationTimeout+serviceCreateTimeout, func() *resource.RetryError {
 		output, err := conn.CreateService(&input)
@@ -585,6 +718,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 
 		log.Printf("[DEBUG] ECS service created: %s", aws.StringValue(output.Service.ServiceArn))
 		d.SetId(aws.StringValue(output.Service.ServiceArn))
+		deploymentID = servicePrimaryDeploymentID(output.Service)
 
 		return nil
 	})
@@ -602,6 +736,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 
 		log.Printf("[DEBUG] ECS service created: %s", aws.StringValue(output.Service.ServiceArn))
 		d.SetId(aws.StringValue(output.Service.ServiceArn))
+		deploymentID = servicePrimaryDeploymentID(output.Service)
 	}
 
 	if err != nil {
@@ -614,7 +749,7 @@ func resourceServiceCreate(d *schema.ResourceData, meta interface{}) error {
 			cluster = v.(string)
 		}
 
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+		if err := waitServiceStable(conn, d.Id(), cluster, deploymentID, d.Timeout(schema.TimeoutCreate)); err != nil {
 			return fmt.Errorf("error waiting for ECS service (%s) to become ready: %w", d.Id(), err)
 		}
 	}
@@ -730,6 +865,15 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 		} else {
 			d.Set("deployment_circuit_breaker", nil)
 		}
+
+		// An empty, disabled alarms configuration is returned once alarms have been removed.
+		if v := service.DeploymentConfiguration.Alarms; v != nil && (aws.BoolValue(v.Enable) || len(v.AlarmNames) > 0) {
+			if err := d.Set("alarms", []interface{}{flattenECSDeploymentAlarms(v)}); err != nil {
+				return fmt.Errorf("error setting alarms: %w", err)
+			}
+		} else {
+			d.Set("alarms", nil)
+		}
 	}
 
 	if err := d.Set("deployment_controller", flattenEcsDeploymentController(service.DeploymentController)); err != nil {
@@ -760,6 +904,22 @@ func resourceServiceRead(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("error setting service_registries for (%s): %w", d.Id(), err)
 	}
 
+	// The service connect configuration is only reported on the service's deployments.
+	if v := servicePrimaryDeployment(service); v != nil && v.ServiceConnectConfiguration != nil && (aws.BoolValue(v.ServiceConnectConfiguration.Enabled) || len(d.Get("service_connect_configuration").([]interface{})) > 0) {
+		tfMap := flattenECSServiceConnectConfiguration(v.ServiceConnectConfiguration)
+
+		// The namespace may be configured by name but is returned as an ARN.
+		if namespace := d.Get("service_connect_configuration.0.namespace").(string); namespace != "" && !strings.HasPrefix(namespace, "arn:") {
+			tfMap["namespace"] = namespace
+		}
+
+		if err := d.Set("service_connect_configuration", []interface{}{tfMap}); err != nil {
+			return fmt.Errorf("error setting service_connect_configuration: %w", err)
+		}
+	} else {
+		d.Set("service_connect_configuration", nil)
+	}
+
 	tags := KeyValueTags(service.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 	//lintignore:AWSR002
@@ -828,6 +988,227 @@ func flattenECSDeploymentCircuitBreaker(apiObject *ecs.DeploymentCircuitBreaker)
 	return tfMap
 }
 
+func expandECSDeploymentAlarms(tfMap map[string]interface{}) *ecs.DeploymentAlarms {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.DeploymentAlarms{}
+
+	apiObject.AlarmNames = flex.ExpandStringSet(tfMap["alarm_names"].(*schema.Set))
+	apiObject.Enable = aws.Bool(tfMap["enable"].(bool))
+	apiObject.Rollback = aws.Bool(tfMap["rollback"].(bool))
+
+	return apiObject
+}
+
+func flattenECSDeploymentAlarms(apiObject *ecs.DeploymentAlarms) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	tfMap["alarm_names"] = flex.FlattenStringSet(apiObject.AlarmNames)
+	tfMap["enable"] = aws.BoolValue(apiObject.Enable)
+	tfMap["rollback"] = aws.BoolValue(apiObject.Rollback)
+
+	return tfMap
+}
+
+func expandECSServiceConnectConfiguration(tfMap map[string]interface{}) *ecs.ServiceConnectConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.ServiceConnectConfiguration{
+		Enabled: aws.Bool(tfMap["enabled"].(bool)),
+	}
+
+	if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogConfiguration = expandECSLogConfiguration(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["namespace"].(string); ok && v != "" {
+		apiObject.Namespace = aws.String(v)
+	}
+
+	if v, ok := tfMap["service"].([]interface{}); ok && len(v) > 0 {
+		apiObject.Services = expandECSServiceConnectServices(v)
+	}
+
+	return apiObject
+}
+
+func expandECSLogConfiguration(tfMap map[string]interface{}) *ecs.LogConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &ecs.LogConfiguration{
+		LogDriver: aws.String(tfMap["log_driver"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringMap(v)
+	}
+
+	if v, ok := tfMap["secret_option"].([]interface{}); ok && len(v) > 0 {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiObject.SecretOptions = append(apiObject.SecretOptions, &ecs.Secret{
+				Name:      aws.String(tfMap["name"].(string)),
+				ValueFrom: aws.String(tfMap["value_from"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandECSServiceConnectServices(tfList []interface{}) []*ecs.ServiceConnectService {
+	var apiObjects []*ecs.ServiceConnectService
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &ecs.ServiceConnectService{
+			PortName: aws.String(tfMap["port_name"].(string)),
+		}
+
+		if v, ok := tfMap["client_alias"].([]interface{}); ok && len(v) > 0 {
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				clientAlias := &ecs.ServiceConnectClientAlias{
+					Port: aws.Int64(int64(tfMap["port"].(int))),
+				}
+
+				if v, ok := tfMap["dns_name"].(string); ok && v != "" {
+					clientAlias.DnsName = aws.String(v)
+				}
+
+				apiObject.ClientAliases = append(apiObject.ClientAliases, clientAlias)
+			}
+		}
+
+		if v, ok := tfMap["discovery_name"].(string); ok && v != "" {
+			apiObject.DiscoveryName = aws.String(v)
+		}
+
+		if v, ok := tfMap["ingress_port_override"].(int); ok && v != 0 {
+			apiObject.IngressPortOverride = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenECSServiceConnectConfiguration(apiObject *ecs.ServiceConnectConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"enabled":   aws.BoolValue(apiObject.Enabled),
+		"namespace": aws.StringValue(apiObject.Namespace),
+	}
+
+	if v := apiObject.LogConfiguration; v != nil {
+		logConfiguration := map[string]interface{}{
+			"log_driver": aws.StringValue(v.LogDriver),
+			"options":    flex.PointersMapToStringList(v.Options),
+		}
+
+		var secretOptions []interface{}
+
+		for _, v := range v.SecretOptions {
+			if v == nil {
+				continue
+			}
+
+			secretOptions = append(secretOptions, map[string]interface{}{
+				"name":       aws.StringValue(v.Name),
+				"value_from": aws.StringValue(v.ValueFrom),
+			})
+		}
+
+		logConfiguration["secret_option"] = secretOptions
+		tfMap["log_configuration"] = []interface{}{logConfiguration}
+	}
+
+	var services []interface{}
+
+	for _, v := range apiObject.Services {
+		if v == nil {
+			continue
+		}
+
+		service := map[string]interface{}{
+			"discovery_name":        aws.StringValue(v.DiscoveryName),
+			"ingress_port_override": aws.Int64Value(v.IngressPortOverride),
+			"port_name":             aws.StringValue(v.PortName),
+		}
+
+		var clientAliases []interface{}
+
+		for _, v := range v.ClientAliases {
+			if v == nil {
+				continue
+			}
+
+			clientAliases = append(clientAliases, map[string]interface{}{
+				"dns_name": aws.StringValue(v.DnsName),
+				"port":     aws.Int64Value(v.Port),
+			})
+		}
+
+		service["client_alias"] = clientAliases
+		services = append(services, service)
+	}
+
+	tfMap["service"] = services
+
+	return tfMap
+}
+
+func servicePrimaryDeployment(service *ecs.Service) *ecs.Deployment {
+	if service == nil {
+		return nil
+	}
+
+	for _, v := range service.Deployments {
+		if v != nil && aws.StringValue(v.Status) == serviceDeploymentStatusPrimary {
+			return v
+		}
+	}
+
+	return nil
+}
+
+func servicePrimaryDeploymentID(service *ecs.Service) string {
+	if v := servicePrimaryDeployment(service); v != nil {
+		return aws.StringValue(v.Id)
+	}
+
+	return ""
+}
+
 func flattenEcsNetworkConfiguration(nc *ecs.NetworkConfiguration) []interface{} {
 	if nc == nil {
 		return nil
@@ -1089,6 +1470,37 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("alarms") {
+		updateService = true
+
+		if input.DeploymentConfiguration == nil {
+			input.DeploymentConfiguration = &ecs.DeploymentConfiguration{}
+		}
+
+		// To remove existing alarms, specify a disabled configuration without alarm names.
+		input.DeploymentConfiguration.Alarms = &ecs.DeploymentAlarms{
+			AlarmNames: []*string{},
+			Enable:     aws.Bool(false),
+			Rollback:   aws.Bool(false),
+		}
+
+		if v, ok := d.GetOk("alarms"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.DeploymentConfiguration.Alarms = expandECSDeploymentAlarms(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("service_connect_configuration") {
+		updateService = true
+		// To disable service connect, specify a disabled configuration.
+		input.ServiceConnectConfiguration = &ecs.ServiceConnectConfiguration{
+			Enabled: aws.Bool(false),
+		}
+
+		if v, ok := d.GetOk("service_connect_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ServiceConnectConfiguration = expandECSServiceConnectConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
 	if d.HasChange("ordered_placement_strategy") {
 		updateService = true
 		// Reference: https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_UpdateService.html#ECS-UpdateService-request-placementStrategy
@@ -1153,11 +1565,13 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 		input.EnableExecuteCommand = aws.Bool(d.Get("enable_execute_command").(bool))
 	}
 
+	var deploymentID string
+
 	if updateService {
 		log.Printf("[DEBUG] Updating ECS Service (%s): %s", d.Id(), input)
 		// Retry due to IAM eventual consistency
 		err := resource.Retry(tfiam.PropagationTimeout+serviceUpdateTimeout, func() *resource.RetryError {
-			_, err := conn.UpdateService(&input)
+			output, err := conn.UpdateService(&input)
 
 			if err != nil {
 				if tfawserr.ErrMessageContains(err, ecs.ErrCodeInvalidParameterException, "verify that the ECS service role being passed has the proper permissions") {
@@ -1170,11 +1584,20 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 
 				return resource.NonRetryableError(err)
 			}
+
+			deploymentID = servicePrimaryDeploymentID(output.Service)
+
 			return nil
 		})
 
 		if tfresource.TimedOut(err) {
-			_, err = conn.UpdateService(&input)
+			var output *ecs.UpdateServiceOutput
+
+			output, err = conn.UpdateService(&input)
+
+			if err == nil {
+				deploymentID = servicePrimaryDeploymentID(output.Service)
+			}
 		}
 
 		if err != nil {
@@ -1188,7 +1611,7 @@ func resourceServiceUpdate(d *schema.ResourceData, meta interface{}) error {
 			cluster = v.(string)
 		}
 
-		if err := waitServiceStable(conn, d.Id(), cluster); err != nil {
+		if err := waitServiceStable(conn, d.Id(), cluster, deploymentID, d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return fmt.Errorf("error waiting for ECS service (%s) to become ready: %w", d.Id(), err)
 		}
 	}
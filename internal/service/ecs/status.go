@@ -1,6 +1,7 @@
 package ecs
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -25,6 +26,11 @@ const (
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
+
+	serviceStabilityStatusStabilizing = "STABILIZING"
+	serviceStabilityStatusSteadyState = "STEADY_STATE"
+
+	serviceDeploymentStatusPrimary = "PRIMARY"
 )
 
 func statusCapacityProvider(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
@@ -85,6 +91,71 @@ func statusService(conn *ecs.ECS, id, cluster string) resource.StateRefreshFunc
 	}
 }
 
+func statusServiceStability(conn *ecs.ECS, id, cluster, deploymentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindServiceByTwoPartKey(conn, id, cluster)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		status, err := serviceStabilityState(output, deploymentID)
+
+		return output, status, err
+	}
+}
+
+// serviceStabilityState returns whether the service has reached a steady state, using the same
+// conditions as the AWS SDK's ServicesStable waiter: a single deployment and all desired tasks running.
+// If deploymentID is empty the service's primary deployment is tracked.
+// An error is returned if the tracked deployment has failed or has been superseded by another deployment,
+// e.g. when a deployment circuit breaker rolls the service back.
+func serviceStabilityState(service *ecs.Service, deploymentID string) (string, error) {
+	var deployment, primary *ecs.Deployment
+
+	for _, v := range service.Deployments {
+		if v == nil {
+			continue
+		}
+
+		if aws.StringValue(v.Status) == serviceDeploymentStatusPrimary {
+			primary = v
+		}
+
+		if deploymentID != "" && aws.StringValue(v.Id) == deploymentID {
+			deployment = v
+		}
+	}
+
+	if deploymentID == "" {
+		deployment = primary
+	}
+
+	if deployment != nil && aws.StringValue(deployment.RolloutState) == ecs.DeploymentRolloutStateFailed {
+		err := fmt.Errorf("deployment (%s) failed: %s", aws.StringValue(deployment.Id), aws.StringValue(deployment.RolloutStateReason))
+
+		if primary != nil && primary != deployment {
+			err = fmt.Errorf("%w; rolled back to deployment (%s) with task definition (%s)", err, aws.StringValue(primary.Id), aws.StringValue(primary.TaskDefinition))
+		}
+
+		return "", err
+	}
+
+	if deploymentID != "" && deployment != primary {
+		if primary == nil {
+			return "", fmt.Errorf("deployment (%s) is no longer active", deploymentID)
+		}
+
+		return "", fmt.Errorf("deployment (%s) was superseded by deployment (%s) with task definition (%s)", deploymentID, aws.StringValue(primary.Id), aws.StringValue(primary.TaskDefinition))
+	}
+
+	if len(service.Deployments) == 1 && aws.Int64Value(service.RunningCount) == aws.Int64Value(service.DesiredCount) {
+		return serviceStabilityStatusSteadyState, nil
+	}
+
+	return serviceStabilityStatusStabilizing, nil
+}
+
 func statusCluster(conn *ecs.ECS, arn string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := FindClusterByNameOrARN(conn, arn)
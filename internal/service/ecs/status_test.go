@@ -0,0 +1,118 @@
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestServiceStabilityState(t *testing.T) {
+	testCases := []struct {
+		TestName       string
+		Service        *ecs.Service
+		DeploymentID   string
+		ExpectedStatus string
+		ExpectedError  string
+	}{
+		{
+			TestName: "steady state",
+			Service: &ecs.Service{
+				DesiredCount: aws.Int64(2),
+				RunningCount: aws.Int64(2),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+				},
+			},
+			DeploymentID:   "ecs-svc/1",
+			ExpectedStatus: serviceStabilityStatusSteadyState,
+		},
+		{
+			TestName: "tasks starting",
+			Service: &ecs.Service{
+				DesiredCount: aws.Int64(2),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+				},
+			},
+			DeploymentID:   "ecs-svc/1",
+			ExpectedStatus: serviceStabilityStatusStabilizing,
+		},
+		{
+			TestName: "previous deployment draining",
+			Service: &ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("ACTIVE"), RolloutState: aws.String(ecs.DeploymentRolloutStateCompleted)},
+				},
+			},
+			DeploymentID:   "ecs-svc/2",
+			ExpectedStatus: serviceStabilityStatusStabilizing,
+		},
+		{
+			TestName: "primary deployment failed",
+			Service: &ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(0),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("PRIMARY"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start.")},
+				},
+			},
+			ExpectedError: "deployment (ecs-svc/1) failed: ECS deployment circuit breaker: tasks failed to start.",
+		},
+		{
+			TestName: "circuit breaker rollback",
+			Service: &ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/3"), Status: aws.String("PRIMARY"), TaskDefinition: aws.String("web:1"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("ACTIVE"), TaskDefinition: aws.String("web:2"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start.")},
+				},
+			},
+			DeploymentID:  "ecs-svc/2",
+			ExpectedError: "deployment (ecs-svc/2) failed: ECS deployment circuit breaker: tasks failed to start.; rolled back to deployment (ecs-svc/3) with task definition (web:1)",
+		},
+		{
+			TestName: "superseded",
+			Service: &ecs.Service{
+				DesiredCount: aws.Int64(1),
+				RunningCount: aws.Int64(1),
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/3"), Status: aws.String("PRIMARY"), TaskDefinition: aws.String("web:3")},
+				},
+			},
+			DeploymentID:  "ecs-svc/2",
+			ExpectedError: "deployment (ecs-svc/2) was superseded by deployment (ecs-svc/3) with task definition (web:3)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			status, err := serviceStabilityState(testCase.Service, testCase.DeploymentID)
+
+			if testCase.ExpectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error %q, got none", testCase.ExpectedError)
+				}
+
+				if got := err.Error(); got != testCase.ExpectedError {
+					t.Errorf("got error %q, expected %q", got, testCase.ExpectedError)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if status != testCase.ExpectedStatus {
+				t.Errorf("got status %q, expected %q", status, testCase.ExpectedStatus)
+			}
+		})
+	}
+}
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ecs"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -17,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_capacity_provider", &resource.Sweeper{
 		Name: "aws_ecs_capacity_provider",
 		F:    sweepCapacityProviders,
 		Dependencies: []string{
@@ -26,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_cluster", &resource.Sweeper{
 		Name: "aws_ecs_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -34,12 +33,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_service", &resource.Sweeper{
 		Name: "aws_ecs_service",
 		F:    sweepServices,
 	})
 
-	resource.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ecs_task_definition", &resource.Sweeper{
 		Name: "aws_ecs_task_definition",
 		F:    sweepTaskDefinitions,
 		Dependencies: []string{
@@ -103,36 +102,39 @@ func sweepClusters(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ECSConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListClustersPages(&ecs.ListClustersInput{}, func(page *ecs.ListClustersOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
-		for _, clusterARNPtr := range page.ClusterArns {
-			clusterARN := aws.StringValue(clusterARNPtr)
-
-			log.Printf("[INFO] Deleting ECS Cluster: %s", clusterARN)
+		for _, clusterARN := range page.ClusterArns {
 			r := ResourceCluster()
 			d := r.Data(nil)
-			d.SetId(clusterARN)
-			err = r.Delete(d, client)
-			if err != nil {
-				log.Printf("[ERROR] Error deleting ECS Cluster (%s): %s", clusterARN, err)
-			}
+			d.SetId(aws.StringValue(clusterARN))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping ECS Cluster sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving ECS Clusters: %w", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing ECS Clusters for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping ECS Clusters for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping ECS Cluster sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepServices(region string) error {
@@ -141,80 +143,58 @@ func sweepServices(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ECSConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListClustersPages(&ecs.ListClustersInput{}, func(page *ecs.ListClustersOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
-		for _, clusterARNPtr := range page.ClusterArns {
+		for _, clusterARN := range page.ClusterArns {
 			input := &ecs.ListServicesInput{
-				Cluster: clusterARNPtr,
+				Cluster: clusterARN,
 			}
 
-			err = conn.ListServicesPages(input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
+			err := conn.ListServicesPages(input, func(page *ecs.ListServicesOutput, lastPage bool) bool {
 				if page == nil {
 					return !lastPage
 				}
 
-				for _, serviceARNPtr := range page.ServiceArns {
-					describeServicesInput := &ecs.DescribeServicesInput{
-						Cluster:  clusterARNPtr,
-						Services: []*string{serviceARNPtr},
-					}
-					serviceARN := aws.StringValue(serviceARNPtr)
-
-					log.Printf("[DEBUG] Describing ECS Service: %s", serviceARN)
-					describeServicesOutput, err := conn.DescribeServices(describeServicesInput)
-
-					if tfawserr.ErrMessageContains(err, ecs.ErrCodeServiceNotFoundException, "") {
-						continue
-					}
-
-					if err != nil {
-						log.Printf("[ERROR] Error describing ECS Service (%s): %s", serviceARN, err)
-						continue
-					}
-
-					if describeServicesOutput == nil || len(describeServicesOutput.Services) == 0 {
-						continue
-					}
-
-					service := describeServicesOutput.Services[0]
+				for _, serviceARN := range page.ServiceArns {
+					r := ResourceService()
+					d := r.Data(nil)
+					d.SetId(aws.StringValue(serviceARN))
+					d.Set("cluster", clusterARN)
 
-					if aws.StringValue(service.Status) == "INACTIVE" {
-						continue
-					}
-
-					deleteServiceInput := &ecs.DeleteServiceInput{
-						Cluster: service.ClusterArn,
-						Force:   aws.Bool(true),
-						Service: service.ServiceArn,
-					}
-
-					log.Printf("[INFO] Deleting ECS Service: %s", serviceARN)
-					_, err = conn.DeleteService(deleteServiceInput)
-
-					if err != nil {
-						log.Printf("[ERROR] Error deleting ECS Service (%s): %s", serviceARN, err)
-					}
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 
 				return !lastPage
 			})
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing ECS Services for %s: %w", region, err))
+			}
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping ECS Service sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving ECS Services: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing ECS Clusters for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping ECS Services for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping ECS Service sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepTaskDefinitions(region string) error {
@@ -223,37 +203,38 @@ func sweepTaskDefinitions(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ECSConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListTaskDefinitionsPages(&ecs.ListTaskDefinitionsInput{}, func(page *ecs.ListTaskDefinitionsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
-		for _, taskDefinitionArn := range page.TaskDefinitionArns {
-			arn := aws.StringValue(taskDefinitionArn)
+		for _, taskDefinitionARN := range page.TaskDefinitionArns {
+			r := ResourceTaskDefinition()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(taskDefinitionARN))
+			d.Set("arn", taskDefinitionARN)
 
-			log.Printf("[INFO] Deleting ECS Task Definition: %s", arn)
-			_, err := conn.DeregisterTaskDefinition(&ecs.DeregisterTaskDefinitionInput{
-				TaskDefinition: aws.String(arn),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting ECS Task Definition (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping ECS Task Definitions sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving ECS Task Definitions: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing ECS Task Definitions for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping ECS Task Definitions for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping ECS Task Definition sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
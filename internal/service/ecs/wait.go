@@ -1,6 +1,9 @@
 package ecs
 
 import (
+	"fmt"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +21,9 @@ const (
 	serviceDescribeTimeout    = 2 * time.Minute
 	serviceUpdateTimeout      = 2 * time.Minute
 
+	serviceStablePollInterval     = 15 * time.Second
+	serviceStableDiagnosticsCount = 5
+
 	clusterAvailableTimeout = 10 * time.Minute
 	clusterDeleteTimeout    = 10 * time.Minute
 	clusterAvailableDelay   = 10 * time.Second
@@ -60,21 +66,101 @@ func waitCapacityProviderUpdated(conn *ecs.ECS, arn string) (*ecs.CapacityProvid
 	return nil, err
 }
 
-func waitServiceStable(conn *ecs.ECS, id, cluster string) error {
-	input := &ecs.DescribeServicesInput{
-		Services: aws.StringSlice([]string{id}),
+// waitServiceStable waits for an ECS service to reach a steady state.
+// On failure the returned error describes the failed deployment, recent service events and recently stopped tasks.
+func waitServiceStable(conn *ecs.ECS, id, cluster, deploymentID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{serviceStabilityStatusStabilizing},
+		Target:       []string{serviceStabilityStatusSteadyState},
+		Refresh:      statusServiceStability(conn, id, cluster, deploymentID),
+		Timeout:      timeout,
+		PollInterval: serviceStablePollInterval,
 	}
 
-	if cluster != "" {
-		input.Cluster = aws.String(cluster)
-	}
+	_, err := stateConf.WaitForState()
 
-	if err := conn.WaitUntilServicesStable(input); err != nil {
-		return err
+	if err != nil {
+		service, findErr := FindServiceByTwoPartKey(conn, id, cluster)
+
+		if findErr != nil {
+			log.Printf("[WARN] Unable to describe ECS service (%s) for diagnostics: %s", id, findErr)
+
+			return err
+		}
+
+		tasks, findErr := FindStoppedTasksByServiceName(conn, aws.StringValue(service.ServiceName), cluster, serviceStableDiagnosticsCount)
+
+		if findErr != nil {
+			log.Printf("[WARN] Unable to list stopped tasks of ECS service (%s) for diagnostics: %s", id, findErr)
+		}
+
+		return fmt.Errorf("%w%s", err, serviceStableDiagnostics(service, tasks))
 	}
+
 	return nil
 }
 
+// serviceStableDiagnostics summarizes why an ECS service may not have reached a steady state:
+// any failed deployments, the most recent service events and the reasons recently stopped tasks stopped.
+func serviceStableDiagnostics(service *ecs.Service, tasks []*ecs.Task) string {
+	var b strings.Builder
+
+	for _, v := range service.Deployments {
+		if v == nil || aws.StringValue(v.RolloutState) != ecs.DeploymentRolloutStateFailed {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n\nDeployment %s (task definition %s) failed: %s", aws.StringValue(v.Id), aws.StringValue(v.TaskDefinition), aws.StringValue(v.RolloutStateReason))
+	}
+
+	// Service events are returned most recent first.
+	if n := len(service.Events); n > 0 {
+		if n > serviceStableDiagnosticsCount {
+			n = serviceStableDiagnosticsCount
+		}
+
+		b.WriteString("\n\nMost recent service events:")
+
+		for _, v := range service.Events[:n] {
+			if v == nil {
+				continue
+			}
+
+			fmt.Fprintf(&b, "\n  %s %s", aws.TimeValue(v.CreatedAt).UTC().Format(time.RFC3339), aws.StringValue(v.Message))
+		}
+	}
+
+	if len(tasks) > 0 {
+		b.WriteString("\n\nRecently stopped tasks:")
+
+		for _, v := range tasks {
+			if v == nil {
+				continue
+			}
+
+			fmt.Fprintf(&b, "\n  %s: %s", aws.StringValue(v.TaskArn), aws.StringValue(v.StoppedReason))
+
+			for _, c := range v.Containers {
+				if c == nil || (c.Reason == nil && aws.Int64Value(c.ExitCode) == 0) {
+					continue
+				}
+
+				fmt.Fprintf(&b, "\n    container %s", aws.StringValue(c.Name))
+
+				if c.ExitCode != nil {
+					fmt.Fprintf(&b, " exited with code %d", aws.Int64Value(c.ExitCode))
+				}
+
+				if c.Reason != nil {
+					fmt.Fprintf(&b, ": %s", aws.StringValue(c.Reason))
+				}
+			}
+		}
+	}
+
+	return b.String()
+}
+
 func waitServiceInactive(conn *ecs.ECS, id, cluster string) error {
 	input := &ecs.DescribeServicesInput{
 		Services: aws.StringSlice([]string{id}),
@@ -0,0 +1,89 @@
+package ecs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+func TestServiceStableDiagnostics(t *testing.T) {
+	createdAt := time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	testCases := []struct {
+		TestName string
+		Service  *ecs.Service
+		Tasks    []*ecs.Task
+		Expected string
+	}{
+		{
+			TestName: "empty",
+			Service:  &ecs.Service{},
+			Expected: "",
+		},
+		{
+			TestName: "failed deployment",
+			Service: &ecs.Service{
+				Deployments: []*ecs.Deployment{
+					{Id: aws.String("ecs-svc/2"), Status: aws.String("PRIMARY"), TaskDefinition: aws.String("web:1"), RolloutState: aws.String(ecs.DeploymentRolloutStateInProgress)},
+					{Id: aws.String("ecs-svc/1"), Status: aws.String("ACTIVE"), TaskDefinition: aws.String("web:2"), RolloutState: aws.String(ecs.DeploymentRolloutStateFailed), RolloutStateReason: aws.String("ECS deployment circuit breaker: tasks failed to start.")},
+				},
+			},
+			Expected: "\n\nDeployment ecs-svc/1 (task definition web:2) failed: ECS deployment circuit breaker: tasks failed to start.",
+		},
+		{
+			TestName: "events are truncated",
+			Service: &ecs.Service{
+				Events: []*ecs.ServiceEvent{
+					{CreatedAt: aws.Time(createdAt), Message: aws.String("event 1")},
+					{CreatedAt: aws.Time(createdAt), Message: aws.String("event 2")},
+					{CreatedAt: aws.Time(createdAt), Message: aws.String("event 3")},
+					{CreatedAt: aws.Time(createdAt), Message: aws.String("event 4")},
+					{CreatedAt: aws.Time(createdAt), Message: aws.String("event 5")},
+					{CreatedAt: aws.Time(createdAt), Message: aws.String("event 6")},
+				},
+			},
+			Expected: "\n\nMost recent service events:" +
+				"\n  2022-01-02T03:04:05Z event 1" +
+				"\n  2022-01-02T03:04:05Z event 2" +
+				"\n  2022-01-02T03:04:05Z event 3" +
+				"\n  2022-01-02T03:04:05Z event 4" +
+				"\n  2022-01-02T03:04:05Z event 5",
+		},
+		{
+			TestName: "stopped tasks",
+			Service:  &ecs.Service{},
+			Tasks: []*ecs.Task{
+				{
+					TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/test/1"),
+					StoppedReason: aws.String("Essential container in task exited"),
+					Containers: []*ecs.Container{
+						{Name: aws.String("web"), ExitCode: aws.Int64(1)},
+						{Name: aws.String("sidecar"), ExitCode: aws.Int64(0)},
+					},
+				},
+				{
+					TaskArn:       aws.String("arn:aws:ecs:us-west-2:123456789012:task/test/2"),
+					StoppedReason: aws.String("CannotPullContainerError"),
+					Containers: []*ecs.Container{
+						{Name: aws.String("web"), Reason: aws.String("CannotPullContainerError: image not found")},
+					},
+				},
+			},
+			Expected: "\n\nRecently stopped tasks:" +
+				"\n  arn:aws:ecs:us-west-2:123456789012:task/test/1: Essential container in task exited" +
+				"\n    container web exited with code 1" +
+				"\n  arn:aws:ecs:us-west-2:123456789012:task/test/2: CannotPullContainerError" +
+				"\n    container web: CannotPullContainerError: image not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			if got := serviceStableDiagnostics(testCase.Service, testCase.Tasks); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_efs_access_point", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_access_point", &resource.Sweeper{
 		Name: "aws_efs_access_point",
 		F:    sweepAccessPoints,
 	})
 
-	resource.AddTestSweepers("aws_efs_file_system", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_file_system", &resource.Sweeper{
 		Name: "aws_efs_file_system",
 		F:    sweepFileSystems,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_efs_mount_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_efs_mount_target", &resource.Sweeper{
 		Name: "aws_efs_mount_target",
 		F:    sweepMountTargets,
 	})
@@ -42,59 +42,61 @@ func sweepAccessPoints(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EFSConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.DescribeFileSystemsPages(&efs.DescribeFileSystemsInput{}, func(page *efs.DescribeFileSystemsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-	var errors error
-	input := &efs.DescribeFileSystemsInput{}
-	err = conn.DescribeFileSystemsPages(input, func(page *efs.DescribeFileSystemsOutput, lastPage bool) bool {
 		for _, filesystem := range page.FileSystems {
 			id := aws.StringValue(filesystem.FileSystemId)
-			log.Printf("[INFO] Deleting access points for EFS File System: %s", id)
-
 			input := &efs.DescribeAccessPointsInput{
 				FileSystemId: filesystem.FileSystemId,
 			}
+
 			for {
 				out, err := conn.DescribeAccessPoints(input)
-				if err != nil {
-					errors = multierror.Append(errors, fmt.Errorf("error retrieving EFS access points on File System %q: %w", id, err))
-					break
-				}
 
-				if out == nil || len(out.AccessPoints) == 0 {
-					log.Printf("[INFO] No EFS access points to sweep on File System %q", id)
+				if err != nil {
+					errs = multierror.Append(errs, fmt.Errorf("error listing EFS Access Points on File System (%s): %w", id, err))
 					break
 				}
 
-				for _, AccessPoint := range out.AccessPoints {
-					id := aws.StringValue(AccessPoint.AccessPointId)
-
-					log.Printf("[INFO] Deleting EFS access point: %s", id)
+				for _, v := range out.AccessPoints {
 					r := ResourceAccessPoint()
 					d := r.Data(nil)
-					d.SetId(id)
-					err := r.Delete(d, client)
-
-					if err != nil {
-						log.Printf("[ERROR] %s", err)
-						sweeperErrs = multierror.Append(sweeperErrs, err)
-						continue
-					}
+					d.SetId(aws.StringValue(v.AccessPointId))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 
 				if out.NextToken == nil {
 					break
 				}
+
 				input.NextToken = out.NextToken
 			}
 		}
-		return true
+
+		return !lastPage
 	})
+
 	if err != nil {
-		errors = multierror.Append(errors, fmt.Errorf("error retrieving EFS File Systems: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing EFS File Systems for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EFS Access Points for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping EFS Access Point sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepFileSystems(region string) error {
@@ -103,95 +105,101 @@ func sweepFileSystems(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).EFSConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	input := &efs.DescribeFileSystemsInput{}
-	err = conn.DescribeFileSystemsPages(input, func(page *efs.DescribeFileSystemsOutput, lastPage bool) bool {
-		for _, filesystem := range page.FileSystems {
-			id := aws.StringValue(filesystem.FileSystemId)
-
-			log.Printf("[INFO] Deleting EFS File System: %s", id)
+	err = conn.DescribeFileSystemsPages(&efs.DescribeFileSystemsInput{}, func(page *efs.DescribeFileSystemsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
+		for _, filesystem := range page.FileSystems {
 			r := ResourceFileSystem()
 			d := r.Data(nil)
-			d.SetId(id)
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(filesystem.FileSystemId))
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-		return true
+
+		return !lastPage
 	})
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving EFS File Systems: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing EFS File Systems for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EFS File Systems for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping EFS File System sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepMountTargets(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EFSConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.DescribeFileSystemsPages(&efs.DescribeFileSystemsInput{}, func(page *efs.DescribeFileSystemsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-	var errors error
-	input := &efs.DescribeFileSystemsInput{}
-	err = conn.DescribeFileSystemsPages(input, func(page *efs.DescribeFileSystemsOutput, lastPage bool) bool {
 		for _, filesystem := range page.FileSystems {
 			id := aws.StringValue(filesystem.FileSystemId)
-			log.Printf("[INFO] Deleting Mount Targets for EFS File System: %s", id)
-
-			var errors error
 			input := &efs.DescribeMountTargetsInput{
 				FileSystemId: filesystem.FileSystemId,
 			}
+
 			for {
 				out, err := conn.DescribeMountTargets(input)
+
 				if err != nil {
-					errors = multierror.Append(errors, fmt.Errorf("error retrieving EFS Mount Targets on File System %q: %w", id, err))
+					errs = multierror.Append(errs, fmt.Errorf("error listing EFS Mount Targets on File System (%s): %w", id, err))
 					break
 				}
 
-				if out == nil || len(out.MountTargets) == 0 {
-					log.Printf("[INFO] No EFS Mount Targets to sweep on File System %q", id)
-					break
-				}
+				for _, v := range out.MountTargets {
+					r := ResourceMountTarget()
+					d := r.Data(nil)
+					d.SetId(aws.StringValue(v.MountTargetId))
+					d.Set("file_system_id", v.FileSystemId)
 
-				for _, mounttarget := range out.MountTargets {
-					id := aws.StringValue(mounttarget.MountTargetId)
-
-					log.Printf("[INFO] Deleting EFS Mount Target: %s", id)
-					_, err := conn.DeleteMountTarget(&efs.DeleteMountTargetInput{
-						MountTargetId: mounttarget.MountTargetId,
-					})
-					if err != nil {
-						errors = multierror.Append(errors, fmt.Errorf("error deleting EFS Mount Target %q: %w", id, err))
-						continue
-					}
-
-					err = WaitForDeleteMountTarget(conn, id, mountTargetDeleteTimeout)
-					if err != nil {
-						errors = multierror.Append(errors, fmt.Errorf("error waiting for EFS Mount Target %q to delete: %w", id, err))
-						continue
-					}
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 
 				if out.NextMarker == nil {
 					break
 				}
+
 				input.Marker = out.NextMarker
 			}
 		}
-		return true
+
+		return !lastPage
 	})
+
 	if err != nil {
-		errors = multierror.Append(errors, fmt.Errorf("error retrieving EFS File Systems: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing EFS File Systems for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EFS Mount Targets for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping EFS Mount Target sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return errors
+	return errs.ErrorOrNil()
 }
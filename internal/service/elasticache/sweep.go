@@ -13,8 +13,10 @@ import (
 	"github.com/aws/aws-sdk-go/service/elasticache"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 // These timeouts are lower to fail faster during sweepers
@@ -24,7 +26,7 @@ const (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_cluster", &resource.Sweeper{
 		Name: "aws_elasticache_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -32,12 +34,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_global_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_global_replication_group",
 		F:    sweepGlobalReplicationGroups,
 	})
 
-	resource.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_parameter_group", &resource.Sweeper{
 		Name: "aws_elasticache_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -46,7 +48,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_replication_group", &resource.Sweeper{
 		Name: "aws_elasticache_replication_group",
 		F:    sweepReplicationGroups,
 		Dependencies: []string{
@@ -54,7 +56,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_security_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_security_group", &resource.Sweeper{
 		Name: "aws_elasticache_security_group",
 		F:    sweepCacheSecurityGroups,
 		Dependencies: []string{
@@ -63,7 +65,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elasticache_subnet_group", &resource.Sweeper{
 		Name: "aws_elasticache_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -79,44 +81,41 @@ func sweepClusters(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ElastiCacheConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	var sweeperErrs *multierror.Error
-
-	input := &elasticache.DescribeCacheClustersInput{
+	err = conn.DescribeCacheClustersPages(&elasticache.DescribeCacheClustersInput{
 		ShowCacheClustersNotInReplicationGroups: aws.Bool(true),
-	}
-	err = conn.DescribeCacheClustersPages(input, func(page *elasticache.DescribeCacheClustersOutput, lastPage bool) bool {
-		if len(page.CacheClusters) == 0 {
-			log.Print("[DEBUG] No ElastiCache Replicaton Groups to sweep")
-			return false
+	}, func(page *elasticache.DescribeCacheClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, cluster := range page.CacheClusters {
-			id := aws.StringValue(cluster.CacheClusterId)
+			r := ResourceCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(cluster.CacheClusterId))
 
-			log.Printf("[INFO] Deleting ElastiCache Cluster: %s", id)
-			err := DeleteCacheCluster(conn, id, "")
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete ElastiCache Cache Cluster (%s): %s", id, err)
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): %w", id, err))
-			}
-			_, err = WaitCacheClusterDeleted(conn, id, CacheClusterDeletedTimeout)
-			if err != nil {
-				log.Printf("[ERROR] Failed waiting for ElastiCache Cache Cluster (%s) to be deleted: %s", id, err)
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting ElastiCache Cache Cluster (%s): waiting for completion: %w", id, err))
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping ElastiCache Cluster sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error retrieving ElastiCache Clusters: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error describing ElastiCache Clusters for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping ElastiCache Clusters for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping ElastiCache Cluster sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepGlobalReplicationGroups(region string) error {
@@ -125,56 +124,95 @@ func sweepGlobalReplicationGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ElastiCacheConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	var grgGroup multierror.Group
-
-	input := &elasticache.DescribeGlobalReplicationGroupsInput{
+	err = conn.DescribeGlobalReplicationGroupsPages(&elasticache.DescribeGlobalReplicationGroupsInput{
 		ShowMemberInfo: aws.Bool(true),
-	}
-	err = conn.DescribeGlobalReplicationGroupsPages(input, func(page *elasticache.DescribeGlobalReplicationGroupsOutput, lastPage bool) bool {
+	}, func(page *elasticache.DescribeGlobalReplicationGroupsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
 		for _, globalReplicationGroup := range page.GlobalReplicationGroups {
-			globalReplicationGroup := globalReplicationGroup
-
-			grgGroup.Go(func() error {
-				id := aws.StringValue(globalReplicationGroup.GlobalReplicationGroupId)
-
-				disassociationErrors := DisassociateMembers(conn, globalReplicationGroup)
-				if disassociationErrors != nil {
-					sweeperErr := fmt.Errorf("failed to disassociate ElastiCache Global Replication Group (%s) members: %w", id, disassociationErrors)
-					log.Printf("[ERROR] %s", sweeperErr)
-					return sweeperErr
-				}
-
-				log.Printf("[INFO] Deleting ElastiCache Global Replication Group: %s", id)
-				err := DeleteGlobalReplicationGroup(conn, id, sweeperGlobalReplicationGroupDefaultUpdatedTimeout)
-				if err != nil {
-					sweeperErr := fmt.Errorf("error deleting ElastiCache Global Replication Group (%s): %w", id, err)
-					log.Printf("[ERROR] %s", sweeperErr)
-					return sweeperErr
-				}
-				return nil
-			})
+			r := resourceGlobalReplicationGroupWithMembers()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(globalReplicationGroup.GlobalReplicationGroupId))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	grgErrs := grgGroup.Wait()
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing ElastiCache Global Replication Groups for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping ElastiCache Global Replication Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping ElastiCache Global Replication Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping ElastiCache Global Replication Group sweep for %q: %s", region, err)
-		return grgErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+// resourceGlobalReplicationGroupWithMembers is an ElastiCache Global Replication Group
+// resource which disassociates its secondary members before deletion.
+func resourceGlobalReplicationGroupWithMembers() *schema.Resource {
+	r := ResourceGlobalReplicationGroup()
+	r.Delete = resourceGlobalReplicationGroupWithMembersDelete
+
+	return r
+}
+
+func resourceGlobalReplicationGroupWithMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).ElastiCacheConn
+
+	globalReplicationGroup, err := FindGlobalReplicationGroupByID(conn, d.Id())
+
+	if tfresource.NotFound(err) {
+		return nil
 	}
 
 	if err != nil {
-		grgErrs = multierror.Append(grgErrs, fmt.Errorf("error listing ElastiCache Global Replication Groups: %w", err))
+		return fmt.Errorf("error reading ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+	}
+
+	var membersGroup multierror.Group
+
+	for _, member := range globalReplicationGroup.Members {
+		member := member
+
+		if aws.StringValue(member.Role) == GlobalReplicationGroupMemberRolePrimary {
+			continue
+		}
+
+		membersGroup.Go(func() error {
+			if err := DisassociateReplicationGroup(conn, d.Id(), aws.StringValue(member.ReplicationGroupId), aws.StringValue(member.ReplicationGroupRegion), sweeperGlobalReplicationGroupDisassociationReadyTimeout); err != nil {
+				return fmt.Errorf(
+					"error disassociating ElastiCache Replication Group (%s) in %s from Global Group (%s): %w",
+					aws.StringValue(member.ReplicationGroupId), aws.StringValue(member.ReplicationGroupRegion), d.Id(), err,
+				)
+			}
+
+			return nil
+		})
 	}
 
-	return grgErrs.ErrorOrNil()
+	if err := membersGroup.Wait().ErrorOrNil(); err != nil {
+		return err
+	}
+
+	if err := DeleteGlobalReplicationGroup(conn, d.Id(), sweeperGlobalReplicationGroupDefaultUpdatedTimeout); err != nil {
+		return fmt.Errorf("error deleting ElastiCache Global Replication Group (%s): %w", d.Id(), err)
+	}
+
+	return nil
 }
 
 func sweepParameterGroups(region string) error {
@@ -183,39 +221,47 @@ func sweepParameterGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ElastiCacheConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.DescribeCacheParameterGroupsPages(&elasticache.DescribeCacheParameterGroupsInput{}, func(page *elasticache.DescribeCacheParameterGroupsOutput, lastPage bool) bool {
-		if len(page.CacheParameterGroups) == 0 {
-			log.Print("[DEBUG] No Elasticache Parameter Groups to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, parameterGroup := range page.CacheParameterGroups {
 			name := aws.StringValue(parameterGroup.CacheParameterGroupName)
 
 			if strings.HasPrefix(name, "default.") {
-				log.Printf("[INFO] Skipping Elasticache Cache Parameter Group: %s", name)
+				log.Printf("[INFO] Skipping ElastiCache Parameter Group: %s", name)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Elasticache Parameter Group: %s", name)
-			_, err := conn.DeleteCacheParameterGroup(&elasticache.DeleteCacheParameterGroupInput{
-				CacheParameterGroupName: aws.String(name),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Elasticache Parameter Group (%s): %s", name, err)
-			}
+			r := ResourceParameterGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Elasticache Parameter Group sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Elasticache Parameter Group: %w", err)
+		errs = multierror.Append(errs, fmt.Errorf("error describing ElastiCache Parameter Groups for %s: %w", region, err))
 	}
-	return nil
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping ElastiCache Parameter Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping ElastiCache Parameter Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepReplicationGroups(region string) error {
@@ -275,39 +321,47 @@ func sweepCacheSecurityGroups(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ElastiCacheConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.DescribeCacheSecurityGroupsPages(&elasticache.DescribeCacheSecurityGroupsInput{}, func(page *elasticache.DescribeCacheSecurityGroupsOutput, lastPage bool) bool {
-		if len(page.CacheSecurityGroups) == 0 {
-			log.Print("[DEBUG] No Elasticache Cache Security Groups to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, securityGroup := range page.CacheSecurityGroups {
 			name := aws.StringValue(securityGroup.CacheSecurityGroupName)
 
 			if name == "default" {
-				log.Printf("[INFO] Skipping Elasticache Cache Security Group: %s", name)
+				log.Printf("[INFO] Skipping ElastiCache Cache Security Group: %s", name)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Elasticache Cache Security Group: %s", name)
-			_, err := conn.DeleteCacheSecurityGroup(&elasticache.DeleteCacheSecurityGroupInput{
-				CacheSecurityGroupName: aws.String(name),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Elasticache Cache Security Group (%s): %s", name, err)
-			}
+			r := ResourceSecurityGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Elasticache Cache Security Group sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Elasticache Cache Security Groups: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error describing ElastiCache Cache Security Groups for %s: %w", region, err))
 	}
-	return nil
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping ElastiCache Cache Security Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping ElastiCache Cache Security Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepSubnetGroups(region string) error {
@@ -316,60 +370,38 @@ func sweepSubnetGroups(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ElastiCacheConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.DescribeCacheSubnetGroupsPages(&elasticache.DescribeCacheSubnetGroupsInput{}, func(page *elasticache.DescribeCacheSubnetGroupsOutput, lastPage bool) bool {
-		if len(page.CacheSubnetGroups) == 0 {
-			log.Print("[DEBUG] No Elasticache Subnet Groups to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, subnetGroup := range page.CacheSubnetGroups {
-			name := aws.StringValue(subnetGroup.CacheSubnetGroupName)
-
-			log.Printf("[INFO] Deleting Elasticache Subnet Group: %s", name)
-			_, err := conn.DeleteCacheSubnetGroup(&elasticache.DeleteCacheSubnetGroupInput{
-				CacheSubnetGroupName: aws.String(name),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Elasticache Subnet Group (%s): %s", name, err)
-			}
+			r := ResourceSubnetGroup()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(subnetGroup.CacheSubnetGroupName))
+			d.Set("name", subnetGroup.CacheSubnetGroupName)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Elasticache Subnet Group sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Elasticache Subnet Groups: %w", err)
+		errs = multierror.Append(errs, fmt.Errorf("error describing ElastiCache Subnet Groups for %s: %w", region, err))
 	}
-	return nil
-}
-
-func DisassociateMembers(conn *elasticache.ElastiCache, globalReplicationGroup *elasticache.GlobalReplicationGroup) error {
-	var membersGroup multierror.Group
 
-	for _, member := range globalReplicationGroup.Members {
-		member := member
-
-		if aws.StringValue(member.Role) == GlobalReplicationGroupMemberRolePrimary {
-			continue
-		}
-
-		id := aws.StringValue(globalReplicationGroup.GlobalReplicationGroupId)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping ElastiCache Subnet Groups for %s: %w", region, err))
+	}
 
-		membersGroup.Go(func() error {
-			if err := DisassociateReplicationGroup(conn, id, aws.StringValue(member.ReplicationGroupId), aws.StringValue(member.ReplicationGroupRegion), sweeperGlobalReplicationGroupDisassociationReadyTimeout); err != nil {
-				sweeperErr := fmt.Errorf(
-					"error disassociating ElastiCache Replication Group (%s) in %s from Global Group (%s): %w",
-					aws.StringValue(member.ReplicationGroupId), aws.StringValue(member.ReplicationGroupRegion), id, err,
-				)
-				log.Printf("[ERROR] %s", sweeperErr)
-				return sweeperErr
-			}
-			return nil
-		})
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping ElastiCache Subnet Group sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return membersGroup.Wait().ErrorOrNil()
+	return errs.ErrorOrNil()
 }
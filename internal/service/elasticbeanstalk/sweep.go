@@ -6,11 +6,9 @@ package elasticbeanstalk
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -18,13 +16,13 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_elastic_beanstalk_application", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elastic_beanstalk_application", &resource.Sweeper{
 		Name:         "aws_elastic_beanstalk_application",
 		Dependencies: []string{"aws_elastic_beanstalk_environment"},
 		F:            sweepApplications,
 	})
 
-	resource.AddTestSweepers("aws_elastic_beanstalk_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_elastic_beanstalk_environment", &resource.Sweeper{
 		Name: "aws_elastic_beanstalk_environment",
 		F:    sweepEnvironments,
 	})
@@ -36,39 +34,36 @@ func sweepApplications(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).ElasticBeanstalkConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	resp, err := conn.DescribeApplications(&elasticbeanstalk.DescribeApplicationsInput{})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Elastic Beanstalk Application sweep for %s: %s", region, err)
-			return nil
+		errs = multierror.Append(errs, fmt.Errorf("error describing Elastic Beanstalk Applications for %s: %w", region, err))
+	}
+
+	if resp != nil {
+		for _, bsa := range resp.Applications {
+			r := ResourceApplication()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(bsa.ApplicationName))
+			d.Set("name", bsa.ApplicationName)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-		return fmt.Errorf("error retrieving beanstalk application: %w", err)
 	}
 
-	if len(resp.Applications) == 0 {
-		log.Print("[DEBUG] No aws beanstalk applications to sweep")
-		return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Elastic Beanstalk Applications for %s: %w", region, err))
 	}
 
-	var errors error
-	for _, bsa := range resp.Applications {
-		applicationName := aws.StringValue(bsa.ApplicationName)
-		_, err := conn.DeleteApplication(
-			&elasticbeanstalk.DeleteApplicationInput{
-				ApplicationName: bsa.ApplicationName,
-			})
-		if err != nil {
-			if tfawserr.ErrMessageContains(err, "InvalidConfiguration.NotFound", "") || tfawserr.ErrMessageContains(err, "ValidationError", "") {
-				log.Printf("[DEBUG] beanstalk application %q not found", applicationName)
-				continue
-			}
-
-			errors = multierror.Append(fmt.Errorf("error deleting Elastic Beanstalk Application %q: %w", applicationName, err))
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Elastic Beanstalk Application sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return errors
+	return errs.ErrorOrNil()
 }
 
 func sweepEnvironments(region string) error {
@@ -77,37 +72,38 @@ func sweepEnvironments(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).ElasticBeanstalkConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	resp, err := conn.DescribeEnvironments(&elasticbeanstalk.DescribeEnvironmentsInput{
 		IncludeDeleted: aws.Bool(false),
 	})
 
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Elastic Beanstalk Environment sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving beanstalk environment: %w", err)
+		errs = multierror.Append(errs, fmt.Errorf("error describing Elastic Beanstalk Environments for %s: %w", region, err))
 	}
 
-	if len(resp.Environments) == 0 {
-		log.Print("[DEBUG] No aws beanstalk environments to sweep")
-		return nil
-	}
+	if resp != nil {
+		for _, bse := range resp.Environments {
+			r := ResourceEnvironment()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(bse.EnvironmentId))
+			d.Set("name", bse.EnvironmentName)
+			d.Set("poll_interval", "10s")
+			d.Set("wait_for_ready_timeout", "5m")
 
-	var errors error
-	for _, bse := range resp.Environments {
-		environmentName := aws.StringValue(bse.EnvironmentName)
-		environmentID := aws.StringValue(bse.EnvironmentId)
-		log.Printf("Trying to terminate (%s) (%s)", environmentName, environmentID)
-
-		err := DeleteEnvironment(conn, environmentID, 5*time.Minute, 10*time.Second) //nolint:gomnd
-		if err != nil {
-			errors = multierror.Append(fmt.Errorf("error deleting Elastic Beanstalk Environment %q: %w", environmentID, err))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+	}
 
-		log.Printf("> Terminated (%s) (%s)", environmentName, environmentID)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Elastic Beanstalk Environments for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Elastic Beanstalk Environment sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return errors
+	return errs.ErrorOrNil()
 }
@@ -229,13 +229,13 @@ func ResourceDomain() *schema.Resource {
 						"custom_endpoint": {
 							Type:             schema.TypeString,
 							Optional:         true,
-							DiffSuppressFunc: isCustomEndpointDisabled,
+							DiffSuppressFunc: IsCustomEndpointDisabled,
 						},
 						"custom_endpoint_certificate_arn": {
 							Type:             schema.TypeString,
 							Optional:         true,
 							ValidateFunc:     verify.ValidARN,
-							DiffSuppressFunc: isCustomEndpointDisabled,
+							DiffSuppressFunc: IsCustomEndpointDisabled,
 						},
 					},
 				},
@@ -300,7 +300,7 @@ func ResourceDomain() *schema.Resource {
 							Optional:         true,
 							Computed:         true,
 							ForceNew:         true,
-							DiffSuppressFunc: suppressEquivalentKmsKeyIds,
+							DiffSuppressFunc: SuppressEquivalentKmsKeyIds,
 						},
 					},
 				},
@@ -330,7 +330,7 @@ func ResourceDomain() *schema.Resource {
 						"dedicated_master_count": {
 							Type:             schema.TypeInt,
 							Optional:         true,
-							DiffSuppressFunc: isDedicatedMasterDisabled,
+							DiffSuppressFunc: IsDedicatedMasterDisabled,
 						},
 						"dedicated_master_enabled": {
 							Type:     schema.TypeBool,
@@ -340,7 +340,7 @@ func ResourceDomain() *schema.Resource {
 						"dedicated_master_type": {
 							Type:             schema.TypeString,
 							Optional:         true,
-							DiffSuppressFunc: isDedicatedMasterDisabled,
+							DiffSuppressFunc: IsDedicatedMasterDisabled,
 						},
 						"instance_count": {
 							Type:     schema.TypeInt,
@@ -477,7 +477,7 @@ func ResourceDomain() *schema.Resource {
 				Optional:         true,
 				ForceNew:         false,
 				MaxItems:         1,
-				DiffSuppressFunc: esCognitoOptionsDiffSuppress,
+				DiffSuppressFunc: CognitoOptionsDiffSuppress,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"enabled": {
@@ -810,7 +810,7 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("access_policies", policies)
 	}
 
-	options := advancedOptionsIgnoreDefault(d.Get("advanced_options").(map[string]interface{}), flex.PointersMapToStringList(ds.AdvancedOptions))
+	options := AdvancedOptionsIgnoreDefault(d.Get("advanced_options").(map[string]interface{}), flex.PointersMapToStringList(ds.AdvancedOptions))
 	if err = d.Set("advanced_options", options); err != nil {
 		return fmt.Errorf("setting advanced_options %v: %w", options, err)
 	}
@@ -848,9 +848,9 @@ func resourceDomainRead(d *schema.ResourceData, meta interface{}) error {
 	if ds.AdvancedSecurityOptions != nil {
 		advSecOpts := flattenAdvancedSecurityOptions(ds.AdvancedSecurityOptions)
 		if !aws.BoolValue(ds.AdvancedSecurityOptions.Enabled) {
-			advSecOpts[0]["internal_user_database_enabled"] = getUserDBEnabled(d)
+			advSecOpts[0]["internal_user_database_enabled"] = GetUserDBEnabled(d)
 		}
-		advSecOpts[0]["master_user_options"] = getMasterUserOptions(d)
+		advSecOpts[0]["master_user_options"] = GetMasterUserOptions(d)
 
 		if err := d.Set("advanced_security_options", advSecOpts); err != nil {
 			return fmt.Errorf("error setting advanced_security_options: %w", err)
@@ -1167,7 +1167,7 @@ func resourceDomainDeleteWaiter(domainName string, conn *elasticsearch.Elasticse
 	return nil
 }
 
-func suppressEquivalentKmsKeyIds(k, old, new string, d *schema.ResourceData) bool {
+func SuppressEquivalentKmsKeyIds(k, old, new string, d *schema.ResourceData) bool {
 	// The Elasticsearch API accepts a short KMS key id but always returns the ARN of the key.
 	// The ARN is of the format 'arn:aws:kms:REGION:ACCOUNT_ID:key/KMS_KEY_ID'.
 	// These should be treated as equivalent.
@@ -1178,14 +1178,14 @@ func getKibanaEndpoint(d *schema.ResourceData) string {
 	return d.Get("endpoint").(string) + "/_plugin/kibana/"
 }
 
-func esCognitoOptionsDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+func CognitoOptionsDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	if old == "1" && new == "0" {
 		return true
 	}
 	return false
 }
 
-func isDedicatedMasterDisabled(k, old, new string, d *schema.ResourceData) bool {
+func IsDedicatedMasterDisabled(k, old, new string, d *schema.ResourceData) bool {
 	v, ok := d.GetOk("cluster_config")
 	if ok {
 		clusterConfig := v.([]interface{})[0].(map[string]interface{})
@@ -1194,7 +1194,7 @@ func isDedicatedMasterDisabled(k, old, new string, d *schema.ResourceData) bool
 	return false
 }
 
-func isCustomEndpointDisabled(k, old, new string, d *schema.ResourceData) bool {
+func IsCustomEndpointDisabled(k, old, new string, d *schema.ResourceData) bool {
 	v, ok := d.GetOk("domain_endpoint_options")
 	if ok {
 		domainEndpointOptions := v.([]interface{})[0].(map[string]interface{})
@@ -1340,10 +1340,10 @@ func flattenZoneAwarenessConfig(zoneAwarenessConfig *elasticsearch.ZoneAwareness
 	return []interface{}{m}
 }
 
-// advancedOptionsIgnoreDefault checks for defaults in the n map and, if
+// AdvancedOptionsIgnoreDefault checks for defaults in the n map and, if
 // they don't exist in the o map, it deletes them. AWS returns default advanced
 // options that cause perpetual diffs.
-func advancedOptionsIgnoreDefault(o map[string]interface{}, n map[string]interface{}) map[string]interface{} {
+func AdvancedOptionsIgnoreDefault(o map[string]interface{}, n map[string]interface{}) map[string]interface{} {
 	for k, v := range n {
 		switch fmt.Sprintf("%s=%s", k, v) {
 		case "override_main_response_version=false":
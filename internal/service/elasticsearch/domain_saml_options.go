@@ -83,13 +83,13 @@ func ResourceDomainSAMLOptions() *schema.Resource {
 							Optional:         true,
 							Default:          60,
 							ValidateFunc:     validation.IntBetween(1, 1440),
-							DiffSuppressFunc: elasticsearchDomainSamlOptionsDiffSupress,
+							DiffSuppressFunc: DomainSAMLOptionsDiffSuppress,
 						},
 						"subject_key": {
 							Type:             schema.TypeString,
 							Optional:         true,
 							Default:          "NameID",
-							DiffSuppressFunc: elasticsearchDomainSamlOptionsDiffSupress,
+							DiffSuppressFunc: DomainSAMLOptionsDiffSuppress,
 						},
 					},
 				},
@@ -97,7 +97,7 @@ func ResourceDomainSAMLOptions() *schema.Resource {
 		},
 	}
 }
-func elasticsearchDomainSamlOptionsDiffSupress(k, old, new string, d *schema.ResourceData) bool {
+func DomainSAMLOptionsDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	if v, ok := d.Get("saml_options").([]interface{}); ok && len(v) > 0 {
 		if enabled, ok := v[0].(map[string]interface{})["enabled"].(bool); ok && !enabled {
 			return true
@@ -266,7 +266,7 @@ func flattenESSAMLIdpOptions(SAMLIdp *elasticsearch.SAMLIdp) []interface{} {
 	return []interface{}{m}
 }
 
-func getMasterUserOptions(d *schema.ResourceData) []interface{} {
+func GetMasterUserOptions(d *schema.ResourceData) []interface{} {
 	if v, ok := d.GetOk("advanced_security_options"); ok {
 		options := v.([]interface{})
 		if len(options) > 0 && options[0] != nil {
@@ -279,7 +279,7 @@ func getMasterUserOptions(d *schema.ResourceData) []interface{} {
 	return []interface{}{}
 }
 
-func getUserDBEnabled(d *schema.ResourceData) bool {
+func GetUserDBEnabled(d *schema.ResourceData) bool {
 	if v, ok := d.GetOk("advanced_security_options"); ok {
 		options := v.([]interface{})
 		if len(options) > 0 && options[0] != nil {
@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_cluster", &resource.Sweeper{
 		Name: "aws_emr_cluster",
 		F:    sweepClusters,
 	})
 
-	resource.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_emr_studio", &resource.Sweeper{
 		Name: "aws_emr_studio",
 		F:    sweepStudios,
 	})
@@ -33,6 +33,8 @@ func sweepClusters(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EMRConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &emr.ListClustersInput{
 		ClusterStates: []*string{
@@ -48,37 +50,31 @@ func sweepClusters(region string) error {
 		}
 
 		for _, cluster := range page.Clusters {
-			describeClusterInput := &emr.DescribeClusterInput{
-				ClusterId: cluster.Id,
-			}
-			terminateJobFlowsInput := &emr.TerminateJobFlowsInput{
-				JobFlowIds: []*string{cluster.Id},
-			}
-			id := aws.StringValue(cluster.Id)
-
-			log.Printf("[INFO] Deleting EMR Cluster: %s", id)
-			_, err = conn.TerminateJobFlows(terminateJobFlowsInput)
-
-			if err != nil {
-				log.Printf("[ERROR] Error terminating EMR Cluster (%s): %s", id, err)
-			}
-
-			if err := conn.WaitUntilClusterTerminated(describeClusterInput); err != nil {
-				log.Printf("[ERROR] Error waiting for EMR Cluster (%s) termination: %s", id, err)
-			}
+			r := ResourceCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(cluster.Id))
+			d.Set("name", cluster.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EMR Cluster sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving EMR Clusters: %w", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing EMR Clusters for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EMR Clusters for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping EMR Cluster sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepStudios(region string) error {
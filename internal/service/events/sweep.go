@@ -17,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_cloudwatch_event_api_destination", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_api_destination", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_api_destination",
 		F:    sweepAPIDestination,
 		Dependencies: []string{
@@ -25,7 +25,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_archive", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_archive",
 		F:    sweepArchives,
 		Dependencies: []string{
@@ -33,7 +33,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_bus", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_bus",
 		F:    sweepBuses,
 		Dependencies: []string{
@@ -43,17 +43,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_connection", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_connection",
 		F:    sweepConnection,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_permission", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_permission",
 		F:    sweepPermissions,
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_rule", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -61,7 +61,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_cloudwatch_event_target", &resource.Sweeper{
 		Name: "aws_cloudwatch_event_target",
 		F:    sweepTargets,
 	})
@@ -73,48 +73,48 @@ func sweepAPIDestination(region string) error {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EventsConn
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &eventbridge.ListApiDestinationsInput{
 		Limit: aws.Int64(100),
 	}
-	var apiDestinations []*eventbridge.ApiDestination
 	for {
 		output, err := conn.ListApiDestinations(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EventBridge API Destination sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving EventBridge API Destinations: %w", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing EventBridge API Destinations for %s: %w", region, err))
+			break
 		}
 
-		apiDestinations = append(apiDestinations, output.ApiDestinations...)
+		for _, apiDestination := range output.ApiDestinations {
+			name := aws.StringValue(apiDestination.Name)
+
+			r := ResourceAPIDestination()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
 
 		if aws.StringValue(output.NextToken) == "" {
 			break
 		}
+
 		input.NextToken = output.NextToken
 	}
 
-	for _, apiDestination := range apiDestinations {
-
-		input := &eventbridge.DeleteApiDestinationInput{
-			Name: apiDestination.Name,
-		}
-		_, err := conn.DeleteApiDestination(input)
-		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error deleting EventBridge Api Destination (%s): %w", *apiDestination.Name, err))
-			continue
-		}
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EventBridge API Destinations for %s: %w", region, err))
 	}
 
-	log.Printf("[INFO] Deleted %d EventBridge Api Destinations", len(apiDestinations))
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping EventBridge API Destination sweep for %s: %s", region, errs)
+		return nil
+	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepArchives(region string) error {
@@ -123,48 +123,50 @@ func sweepArchives(region string) error {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EventsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &eventbridge.ListArchivesInput{}
-
 	for {
 		output, err := conn.ListArchives(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EventBridge archive sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving EventBridge archive: %w", err)
-		}
-
-		if len(output.Archives) == 0 {
-			log.Print("[DEBUG] No EventBridge archives to sweep")
-			return nil
+			errs = multierror.Append(errs, fmt.Errorf("error listing EventBridge Archives for %s: %w", region, err))
+			break
 		}
 
 		for _, archive := range output.Archives {
 			name := aws.StringValue(archive.ArchiveName)
+
 			if name == "default" {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting EventBridge archive (%s)", name)
-			_, err := conn.DeleteArchive(&eventbridge.DeleteArchiveInput{
-				ArchiveName: aws.String(name),
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting EventBridge archive (%s): %w", name, err)
-			}
+			r := ResourceArchive()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
-		if output.NextToken == nil {
+		if aws.StringValue(output.NextToken) == "" {
 			break
 		}
+
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EventBridge Archives for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping EventBridge Archive sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepBuses(region string) error {
@@ -174,7 +176,8 @@ func sweepBuses(region string) error {
 	}
 	conn := client.(*conns.AWSClient).EventsConn
 	input := &eventbridge.ListEventBusesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = listEventBusesPages(conn, input, func(page *eventbridge.ListEventBusesOutput, lastPage bool) bool {
 		if page == nil {
@@ -190,28 +193,28 @@ func sweepBuses(region string) error {
 			r := ResourceBus()
 			d := r.Data(nil)
 			d.SetId(name)
-			err = r.Delete(d, client)
+			d.Set("name", name)
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EventBridge event bus sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing EventBridge event buses for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EventBridge event buses: %w", err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EventBridge event buses for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping EventBridge event bus sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepConnection(region string) error {
@@ -220,45 +223,48 @@ func sweepConnection(region string) error {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EventsConn
-
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &eventbridge.ListConnectionsInput{
 		Limit: aws.Int64(100),
 	}
-	var connections []*eventbridge.Connection
 	for {
 		output, err := conn.ListConnections(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EventBridge Connection sweep for %s: %s", region, err)
-			return nil
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing EventBridge Connections for %s: %w", region, err))
+			break
 		}
 
-		if err != nil {
-			return fmt.Errorf("Error retrieving EventBridge Connections: %w", err)
+		for _, connection := range output.Connections {
+			name := aws.StringValue(connection.Name)
+
+			r := ResourceConnection()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
 			break
 		}
+
 		input.NextToken = output.NextToken
 	}
 
-	for _, connection := range connections {
-		input := &eventbridge.DeleteConnectionInput{
-			Name: connection.Name,
-		}
-		_, err := conn.DeleteConnection(input)
-		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("Error deleting EventBridge Connection (%s): %w", *connection.Name, err))
-			continue
-		}
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EventBridge Connections for %s: %w", region, err))
 	}
 
-	log.Printf("[INFO] Deleted %d EventBridge Connections", len(connections))
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping EventBridge Connection sweep for %s: %s", region, errs)
+		return nil
+	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepPermissions(region string) error {
@@ -267,42 +273,42 @@ func sweepPermissions(region string) error {
 		return fmt.Errorf("Error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).EventsConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	output, err := conn.DescribeEventBus(&eventbridge.DescribeEventBusInput{})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping EventBridge Permission sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving EventBridge Permissions: %w", err)
+		errs = multierror.Append(errs, fmt.Errorf("error describing EventBridge event bus for %s: %w", region, err))
 	}
 
-	policy := aws.StringValue(output.Policy)
+	if output != nil && aws.StringValue(output.Policy) != "" {
+		policy := aws.StringValue(output.Policy)
+		var policyDoc PermissionPolicyDoc
 
-	if policy == "" {
-		log.Print("[DEBUG] No EventBridge Permissions to sweep")
-		return nil
-	}
+		if err := json.Unmarshal([]byte(policy), &policyDoc); err != nil {
+			return fmt.Errorf("Parsing EventBridge Permissions policy %q failed: %w", policy, err)
+		}
 
-	var policyDoc PermissionPolicyDoc
-	err = json.Unmarshal([]byte(policy), &policyDoc)
-	if err != nil {
-		return fmt.Errorf("Parsing EventBridge Permissions policy %q failed: %w", policy, err)
+		for _, statement := range policyDoc.Statements {
+			r := ResourcePermission()
+			d := r.Data(nil)
+			d.SetId(PermissionCreateResourceID(DefaultEventBusName, statement.Sid))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
 	}
 
-	for _, statement := range policyDoc.Statements {
-		sid := statement.Sid
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EventBridge Permissions for %s: %w", region, err))
+	}
 
-		log.Printf("[INFO] Deleting EventBridge Permission %s", sid)
-		_, err := conn.RemovePermission(&eventbridge.RemovePermissionInput{
-			StatementId: aws.String(sid),
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting EventBridge Permission %s: %w", sid, err)
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping EventBridge Permission sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepRules(region string) error {
@@ -312,7 +318,8 @@ func sweepRules(region string) error {
 	}
 	conn := client.(*conns.AWSClient).EventsConn
 	input := &eventbridge.ListEventBusesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = listEventBusesPages(conn, input, func(page *eventbridge.ListEventBusesOutput, lastPage bool) bool {
 		if page == nil {
@@ -334,44 +341,45 @@ func sweepRules(region string) error {
 				for _, rule := range page.Rules {
 					ruleName := aws.StringValue(rule.Name)
 
-					log.Printf("[DEBUG] Deleting EventBridge Rule: %s/%s", eventBusName, ruleName)
-					_, err := conn.DeleteRule(&eventbridge.DeleteRuleInput{
-						EventBusName: aws.String(eventBusName),
-						Force:        aws.Bool(true),
-						Name:         aws.String(ruleName),
-					})
-
-					if err != nil {
-						sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting EventBridge Rule (%s/%s): %w", eventBusName, ruleName, err))
+					// Rules managed by other AWS services are removed by those services.
+					if managedBy := aws.StringValue(rule.ManagedBy); managedBy != "" {
+						log.Printf("[INFO] Skipping EventBridge Rule (%s/%s) managed by %s", eventBusName, ruleName, managedBy)
 						continue
 					}
+
+					r := ResourceRule()
+					d := r.Data(nil)
+					d.SetId(RuleCreateResourceID(eventBusName, ruleName))
+					d.Set("name", ruleName)
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 
 				return !lastPage
 			})
 
-			if sweep.SkipSweepError(err) {
-				continue
-			}
-
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EventBridge Rules (%s): %w", region, err))
+				errs = multierror.Append(errs, fmt.Errorf("error listing EventBridge Rules for %s: %w", region, err))
 			}
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EventBridge Rule sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing EventBridge event buses for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EventBridge event buses (%s): %w", region, err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EventBridge Rules for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping EventBridge Rule sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepTargets(region string) error {
@@ -381,7 +389,8 @@ func sweepTargets(region string) error {
 	}
 	conn := client.(*conns.AWSClient).EventsConn
 	input := &eventbridge.ListEventBusesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = listEventBusesPages(conn, input, func(page *eventbridge.ListEventBusesOutput, lastPage bool) bool {
 		if page == nil {
@@ -403,6 +412,12 @@ func sweepTargets(region string) error {
 				for _, rule := range page.Rules {
 					ruleName := aws.StringValue(rule.Name)
 
+					// Rules managed by other AWS services are removed by those services.
+					if managedBy := aws.StringValue(rule.ManagedBy); managedBy != "" {
+						log.Printf("[INFO] Skipping EventBridge Rule (%s/%s) managed by %s", eventBusName, ruleName, managedBy)
+						continue
+					}
+
 					input := &eventbridge.ListTargetsByRuleInput{
 						EventBusName: aws.String(eventBusName),
 						Rule:         aws.String(ruleName),
@@ -416,55 +431,47 @@ func sweepTargets(region string) error {
 						for _, target := range page.Targets {
 							targetID := aws.StringValue(target.Id)
 
-							log.Printf("[DEBUG] Deleting EventBridge Target: %s/%s/%s", eventBusName, ruleName, targetID)
-							_, err := conn.RemoveTargets(&eventbridge.RemoveTargetsInput{
-								EventBusName: aws.String(eventBusName),
-								Force:        aws.Bool(true),
-								Ids:          aws.StringSlice([]string{targetID}),
-								Rule:         aws.String(ruleName),
-							})
-
-							if err != nil {
-								sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting EventBridge Target (%s/%s/%s): %w", eventBusName, ruleName, targetID, err))
-								continue
-							}
+							r := ResourceTarget()
+							d := r.Data(nil)
+							d.SetId(TargetCreateResourceID(eventBusName, ruleName, targetID))
+							d.Set("event_bus_name", eventBusName)
+							d.Set("rule", ruleName)
+							d.Set("target_id", targetID)
+
+							sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 						}
 
 						return !lastPage
 					})
 
-					if sweep.SkipSweepError(err) {
-						continue
-					}
-
 					if err != nil {
-						sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EventBridge Targets (%s): %w", region, err))
+						errs = multierror.Append(errs, fmt.Errorf("error listing EventBridge Targets for %s: %w", region, err))
 					}
 				}
 
 				return !lastPage
 			})
 
-			if sweep.SkipSweepError(err) {
-				continue
-			}
-
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EventBridge Rules (%s): %w", region, err))
+				errs = multierror.Append(errs, fmt.Errorf("error listing EventBridge Rules for %s: %w", region, err))
 			}
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping EventBridge Rule sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing EventBridge event buses for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing EventBridge event buses (%s): %w", region, err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping EventBridge Targets for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping EventBridge Target sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
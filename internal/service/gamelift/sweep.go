@@ -6,18 +6,17 @@ package gamelift
 import (
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/gamelift"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_alias", &resource.Sweeper{
 		Name: "aws_gamelift_alias",
 		Dependencies: []string{
 			"aws_gamelift_fleet",
@@ -25,12 +24,12 @@ func init() {
 		F: sweepAliases,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_build", &resource.Sweeper{
 		Name: "aws_gamelift_build",
 		F:    sweepBuilds,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_fleet", &resource.Sweeper{
 		Name: "aws_gamelift_fleet",
 		Dependencies: []string{
 			"aws_gamelift_build",
@@ -38,7 +37,7 @@ func init() {
 		F: sweepFleets,
 	})
 
-	resource.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_gamelift_game_session_queue", &resource.Sweeper{
 		Name: "aws_gamelift_game_session_queue",
 		F:    sweepGameSessionQueue,
 	})
@@ -50,36 +49,44 @@ func sweepAliases(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GameLiftConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	err = listAliases(&gamelift.ListAliasesInput{}, conn, func(resp *gamelift.ListAliasesOutput) error {
-		if len(resp.Aliases) == 0 {
-			log.Print("[DEBUG] No Gamelift Aliases to sweep")
-			return nil
+	input := &gamelift.ListAliasesInput{}
+	for {
+		output, err := conn.ListAliases(input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Gamelift Aliases for %s: %w", region, err))
+			break
 		}
 
-		log.Printf("[INFO] Found %d Gamelift Aliases", len(resp.Aliases))
-
-		for _, alias := range resp.Aliases {
-			log.Printf("[INFO] Deleting Gamelift Alias %q", *alias.AliasId)
-			_, err := conn.DeleteAlias(&gamelift.DeleteAliasInput{
-				AliasId: alias.AliasId,
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Gamelift Alias (%s): %s",
-					*alias.AliasId, err)
-			}
+		for _, alias := range output.Aliases {
+			r := ResourceAlias()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(alias.AliasId))
+			d.Set("name", alias.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-		return nil
-	})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Gamelift Alias sweep for %s: %s", region, err)
-			return nil
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
 		}
-		return fmt.Errorf("Error listing Gamelift Aliases: %s", err)
+
+		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Gamelift Aliases for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Gamelift Alias sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepBuilds(region string) error {
@@ -88,35 +95,44 @@ func sweepBuilds(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GameLiftConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	resp, err := conn.ListBuilds(&gamelift.ListBuildsInput{})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Gamelife Build sweep for %s: %s", region, err)
-			return nil
+	input := &gamelift.ListBuildsInput{}
+	for {
+		output, err := conn.ListBuilds(input)
+
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Gamelift Builds for %s: %w", region, err))
+			break
 		}
-		return fmt.Errorf("Error listing Gamelift Builds: %s", err)
-	}
 
-	if len(resp.Builds) == 0 {
-		log.Print("[DEBUG] No Gamelift Builds to sweep")
-		return nil
-	}
+		for _, build := range output.Builds {
+			r := ResourceBuild()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(build.BuildId))
+			d.Set("name", build.Name)
 
-	log.Printf("[INFO] Found %d Gamelift Builds", len(resp.Builds))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
 
-	for _, build := range resp.Builds {
-		log.Printf("[INFO] Deleting Gamelift Build %q", *build.BuildId)
-		_, err := conn.DeleteBuild(&gamelift.DeleteBuildInput{
-			BuildId: build.BuildId,
-		})
-		if err != nil {
-			return fmt.Errorf("Error deleting Gamelift Build (%s): %s",
-				*build.BuildId, err)
+		if aws.StringValue(output.NextToken) == "" {
+			break
 		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Gamelift Builds for %s: %w", region, err))
 	}
 
-	return nil
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Gamelift Build sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepFleets(region string) error {
@@ -125,50 +141,43 @@ func sweepFleets(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GameLiftConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	return listFleets(conn, nil, region, func(fleetIds []*string) error {
-		if len(fleetIds) == 0 {
-			log.Print("[DEBUG] No Gamelift Fleets to sweep")
-			return nil
-		}
+	input := &gamelift.ListFleetsInput{}
+	for {
+		output, err := conn.ListFleets(input)
 
-		out, err := conn.DescribeFleetAttributes(&gamelift.DescribeFleetAttributesInput{
-			FleetIds: fleetIds,
-		})
 		if err != nil {
-			return fmt.Errorf("Error describing Gamelift Fleet attributes: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing Gamelift Fleets for %s: %w", region, err))
+			break
 		}
 
-		log.Printf("[INFO] Found %d Gamelift Fleets", len(out.FleetAttributes))
-
-		for _, attr := range out.FleetAttributes {
-			log.Printf("[INFO] Deleting Gamelift Fleet %q", *attr.FleetId)
-			err := resource.Retry(60*time.Minute, func() *resource.RetryError {
-				_, err := conn.DeleteFleet(&gamelift.DeleteFleetInput{
-					FleetId: attr.FleetId,
-				})
-				if err != nil {
-					msg := fmt.Sprintf("Cannot delete fleet %s that is in status of ", *attr.FleetId)
-					if tfawserr.ErrMessageContains(err, gamelift.ErrCodeInvalidRequestException, msg) {
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Gamelift Fleet (%s): %s",
-					*attr.FleetId, err)
-			}
-
-			err = WaitForFleetToBeDeleted(conn, *attr.FleetId, FleetDeletedDefaultTimeout)
-			if err != nil {
-				return fmt.Errorf("Error waiting for Gamelift Fleet (%s) to be deleted: %s",
-					*attr.FleetId, err)
-			}
+		for _, fleetID := range output.FleetIds {
+			r := ResourceFleet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(fleetID))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
 		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Gamelift Fleets for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Gamelift Fleet sweep for %s: %s", region, errs)
 		return nil
-	})
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepGameSessionQueue(region string) error {
@@ -177,73 +186,42 @@ func sweepGameSessionQueue(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GameLiftConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	out, err := conn.DescribeGameSessionQueues(&gamelift.DescribeGameSessionQueuesInput{})
-
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Gamelife Queue sweep for %s: %s", region, err)
-		return nil
-	}
-
-	if err != nil {
-		return fmt.Errorf("error listing Gamelift Session Queue: %s", err)
-	}
+	input := &gamelift.DescribeGameSessionQueuesInput{}
+	for {
+		output, err := conn.DescribeGameSessionQueues(input)
 
-	if len(out.GameSessionQueues) == 0 {
-		log.Print("[DEBUG] No Gamelift Session Queue to sweep")
-		return nil
-	}
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Gamelift Session Queues for %s: %w", region, err))
+			break
+		}
 
-	log.Printf("[INFO] Found %d Gamelift Session Queue", len(out.GameSessionQueues))
+		for _, queue := range output.GameSessionQueues {
+			r := ResourceGameSessionQueue()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(queue.Name))
+			d.Set("name", queue.Name)
 
-	for _, queue := range out.GameSessionQueues {
-		log.Printf("[INFO] Deleting Gamelift Session Queue %q", *queue.Name)
-		_, err := conn.DeleteGameSessionQueue(&gamelift.DeleteGameSessionQueueInput{
-			Name: aws.String(*queue.Name),
-		})
-		if err != nil {
-			return fmt.Errorf("error deleting Gamelift Session Queue (%s): %s",
-				*queue.Name, err)
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-	}
 
-	return nil
-}
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
 
-func listAliases(input *gamelift.ListAliasesInput, conn *gamelift.GameLift, f func(*gamelift.ListAliasesOutput) error) error {
-	resp, err := conn.ListAliases(input)
-	if err != nil {
-		return err
-	}
-	err = f(resp)
-	if err != nil {
-		return err
+		input.NextToken = output.NextToken
 	}
 
-	if resp.NextToken != nil {
-		return listAliases(input, conn, f)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Gamelift Session Queues for %s: %w", region, err))
 	}
-	return nil
-}
 
-func listFleets(conn *gamelift.GameLift, nextToken *string, region string, f func([]*string) error) error {
-	resp, err := conn.ListFleets(&gamelift.ListFleetsInput{
-		NextToken: nextToken,
-	})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Gamelift Fleet sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error listing Gamelift Fleets: %s", err)
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Gamelift Session Queue sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	err = f(resp.FleetIds)
-	if err != nil {
-		return err
-	}
-	if nextToken != nil {
-		return listFleets(conn, nextToken, region, f)
-	}
-	return nil
+	return errs.ErrorOrNil()
 }
@@ -9,14 +9,16 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/glacier"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glacier_vault", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glacier_vault", &resource.Sweeper{
 		Name: "aws_glacier_vault",
 		F:    sweepVaults,
 	})
@@ -28,7 +30,8 @@ func sweepVaults(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).GlacierConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListVaultsPages(&glacier.ListVaultsInput{}, func(page *glacier.ListVaultsOutput, lastPage bool) bool {
 		if page == nil {
@@ -36,41 +39,57 @@ func sweepVaults(region string) error {
 		}
 
 		for _, vault := range page.VaultList {
-			name := aws.StringValue(vault.VaultName)
-
-			// First attempt to delete the vault's notification configuration in case the vault deletion fails.
-			log.Printf("[INFO] Deleting Glacier Vault (%s) Notifications", name)
-			_, err := conn.DeleteVaultNotifications(&glacier.DeleteVaultNotificationsInput{
-				VaultName: aws.String(name),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Glacier Vault (%s) Notifications: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-
-			log.Printf("[INFO] Deleting Glacier Vault: %s", name)
-			_, err = conn.DeleteVault(&glacier.DeleteVaultInput{
-				VaultName: aws.String(name),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Glacier Vault (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			r := resourceVaultWithNotifications()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(vault.VaultName))
+			d.Set("name", vault.VaultName)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Glacier Vaults sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Glacier Vaults for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glacier Vaults for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glacier Vault sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// resourceVaultWithNotifications is a Glacier Vault resource which deletes
+// the vault's notification configuration first in case the vault deletion fails.
+func resourceVaultWithNotifications() *schema.Resource {
+	r := ResourceVault()
+	r.Delete = resourceVaultWithNotificationsDelete
+
+	return r
+}
+
+func resourceVaultWithNotificationsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlacierConn
+
+	log.Printf("[INFO] Deleting Glacier Vault (%s) Notifications", d.Id())
+	_, err := conn.DeleteVaultNotifications(&glacier.DeleteVaultNotificationsInput{
+		VaultName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, glacier.ErrCodeResourceNotFoundException) {
+		return nil
 	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Glacier Vaults: %w", err))
+		return fmt.Errorf("error deleting Glacier Vault (%s) Notifications: %w", d.Id(), err)
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return resourceVaultDelete(d, meta)
 }
@@ -9,14 +9,16 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_globalaccelerator_accelerator", &resource.Sweeper{
 		Name: "aws_globalaccelerator_accelerator",
 		F:    sweepAccelerators,
 	})
@@ -28,41 +30,25 @@ func sweepAccelerators(region string) error {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlobalAcceleratorConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &globalaccelerator.ListAcceleratorsInput{}
-	var sweeperErrs *multierror.Error
-
 	for {
 		output, err := conn.ListAccelerators(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Global Accelerator Accelerator sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving Global Accelerator Accelerators: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing Global Accelerator Accelerators for %s: %w", region, err))
+			break
 		}
 
 		for _, accelerator := range output.Accelerators {
-			arn := aws.StringValue(accelerator.AcceleratorArn)
-
-			errs := sweepGlobalAcceleratorListeners(client, accelerator.AcceleratorArn)
-			if errs != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, errs)
-			}
-
-			r := ResourceAccelerator()
+			r := resourceAcceleratorWithListeners()
 			d := r.Data(nil)
-			d.SetId(arn)
-			err = r.Delete(d, client)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Global Accelerator Accelerator (%s): %s", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			d.SetId(aws.StringValue(accelerator.AcceleratorArn))
+			d.Set("name", accelerator.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -72,78 +58,72 @@ func sweepAccelerators(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
-}
-
-func sweepGlobalAcceleratorEndpointGroups(client interface{}, listenerArn *string) *multierror.Error {
-	conn := client.(*conns.AWSClient).GlobalAcceleratorConn
-	var sweeperErrs *multierror.Error
-
-	log.Printf("[INFO] deleting Endpoint Groups for Listener %s", *listenerArn)
-	input := &globalaccelerator.ListEndpointGroupsInput{
-		ListenerArn: listenerArn,
-	}
-	output, err := conn.ListEndpointGroups(input)
-	if err != nil {
-		sweeperErr := fmt.Errorf("error listing Global Accelerator Endpoint Groups for Listener (%s): %s", *listenerArn, err)
-		log.Printf("[ERROR] %s", sweeperErr)
-		sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Global Accelerator Accelerators for %s: %w", region, err))
 	}
 
-	for _, endpoint := range output.EndpointGroups {
-		arn := aws.StringValue(endpoint.EndpointGroupArn)
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Global Accelerator Accelerator sweep for %s: %s", region, errs)
+		return nil
+	}
 
-		r := ResourceEndpointGroup()
-		d := r.Data(nil)
-		d.SetId(arn)
-		err = r.Delete(d, client)
+	return errs.ErrorOrNil()
+}
 
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting Global Accelerator endpoint group (%s): %s", arn, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
-	}
+// resourceAcceleratorWithListeners is a Global Accelerator Accelerator resource
+// which deletes its listeners and their endpoint groups before deletion.
+func resourceAcceleratorWithListeners() *schema.Resource {
+	r := ResourceAccelerator()
+	r.Delete = resourceAcceleratorWithListenersDelete
 
-	return sweeperErrs
+	return r
 }
 
-func sweepGlobalAcceleratorListeners(client interface{}, acceleratorArn *string) *multierror.Error {
-	conn := client.(*conns.AWSClient).GlobalAcceleratorConn
-	var sweeperErrs *multierror.Error
+func resourceAcceleratorWithListenersDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GlobalAcceleratorConn
+
+	listenersOutput, err := conn.ListListeners(&globalaccelerator.ListListenersInput{
+		AcceleratorArn: aws.String(d.Id()),
+	})
 
-	log.Printf("[INFO] deleting Listeners for Accelerator %s", *acceleratorArn)
-	listenersInput := &globalaccelerator.ListListenersInput{
-		AcceleratorArn: acceleratorArn,
+	if tfawserr.ErrCodeEquals(err, globalaccelerator.ErrCodeAcceleratorNotFoundException) {
+		return nil
 	}
-	listenersOutput, err := conn.ListListeners(listenersInput)
+
 	if err != nil {
-		sweeperErr := fmt.Errorf("error listing Global Accelerator Listeners for Accelerator (%s): %s", *acceleratorArn, err)
-		log.Printf("[ERROR] %s", sweeperErr)
-		sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+		return fmt.Errorf("error listing Global Accelerator Listeners for Accelerator (%s): %w", d.Id(), err)
 	}
 
 	for _, listener := range listenersOutput.Listeners {
-		errs := sweepGlobalAcceleratorEndpointGroups(client, listener.ListenerArn)
-		if errs != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, errs)
+		listenerARN := aws.StringValue(listener.ListenerArn)
+
+		endpointGroupsOutput, err := conn.ListEndpointGroups(&globalaccelerator.ListEndpointGroupsInput{
+			ListenerArn: listener.ListenerArn,
+		})
+
+		if err != nil {
+			return fmt.Errorf("error listing Global Accelerator Endpoint Groups for Listener (%s): %w", listenerARN, err)
 		}
 
-		arn := aws.StringValue(listener.ListenerArn)
+		for _, endpointGroup := range endpointGroupsOutput.EndpointGroups {
+			r := ResourceEndpointGroup()
+			egd := r.Data(nil)
+			egd.SetId(aws.StringValue(endpointGroup.EndpointGroupArn))
+
+			if err := resourceEndpointGroupDelete(egd, meta); err != nil {
+				return err
+			}
+		}
 
 		r := ResourceListener()
-		d := r.Data(nil)
-		d.SetId(arn)
-		err = r.Delete(d, client)
+		ld := r.Data(nil)
+		ld.SetId(listenerARN)
+		ld.Set("accelerator_arn", d.Id())
 
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting Global Accelerator listener (%s): %s", arn, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
+		if err := resourceListenerDelete(ld, meta); err != nil {
+			return err
 		}
 	}
 
-	return sweeperErrs
+	return resourceAcceleratorDelete(d, meta)
 }
@@ -18,62 +18,62 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_glue_catalog_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_catalog_database", &resource.Sweeper{
 		Name: "aws_glue_catalog_database",
 		F:    sweepCatalogDatabases,
 	})
 
-	resource.AddTestSweepers("aws_glue_classifier", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_classifier", &resource.Sweeper{
 		Name: "aws_glue_classifier",
 		F:    sweepClassifiers,
 	})
 
-	resource.AddTestSweepers("aws_glue_connection", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_connection", &resource.Sweeper{
 		Name: "aws_glue_connection",
 		F:    sweepConnections,
 	})
 
-	resource.AddTestSweepers("aws_glue_crawler", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_crawler", &resource.Sweeper{
 		Name: "aws_glue_crawler",
 		F:    sweepCrawlers,
 	})
 
-	resource.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_dev_endpoint", &resource.Sweeper{
 		Name: "aws_glue_dev_endpoint",
 		F:    sweepDevEndpoint,
 	})
 
-	resource.AddTestSweepers("aws_glue_job", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_job", &resource.Sweeper{
 		Name: "aws_glue_job",
 		F:    sweepJobs,
 	})
 
-	resource.AddTestSweepers("aws_glue_ml_transform", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_ml_transform", &resource.Sweeper{
 		Name: "aws_glue_ml_transform",
 		F:    sweepMLTransforms,
 	})
 
-	resource.AddTestSweepers("aws_glue_registry", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_registry", &resource.Sweeper{
 		Name: "aws_glue_registry",
 		F:    sweepRegistry,
 	})
 
-	resource.AddTestSweepers("aws_glue_schema", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_schema", &resource.Sweeper{
 		Name: "aws_glue_schema",
 		F:    sweepSchema,
 	})
 
-	resource.AddTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_security_configuration", &resource.Sweeper{
 		Name: "aws_glue_security_configuration",
 		F:    sweepSecurityConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_glue_trigger", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_trigger", &resource.Sweeper{
 		Name: "aws_glue_trigger",
 		F:    sweepTriggers,
 	})
 
-	resource.AddTestSweepers("aws_glue_workflow", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_glue_workflow", &resource.Sweeper{
 		Name: "aws_glue_workflow",
 		F:    sweepWorkflow,
 	})
@@ -85,40 +85,45 @@ func sweepCatalogDatabases(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlueConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &glue.GetDatabasesInput{}
 	err = conn.GetDatabasesPages(input, func(page *glue.GetDatabasesOutput, lastPage bool) bool {
-		if len(page.DatabaseList) == 0 {
-			log.Printf("[INFO] No Glue Catalog Databases to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
+
 		for _, database := range page.DatabaseList {
 			name := aws.StringValue(database.Name)
-
-			log.Printf("[INFO] Deleting Glue Catalog Database: %s", name)
+			catalogID := aws.StringValue(database.CatalogId)
 
 			r := ResourceCatalogDatabase()
 			d := r.Data(nil)
-			d.SetId("???")
+			d.SetId(fmt.Sprintf("%s:%s", catalogID, name))
 			d.Set("name", name)
-			d.Set("catalog_id", database.CatalogId)
+			d.Set("catalog_id", catalogID)
 
-			err := r.Delete(d, client)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Catalog Database %s: %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Catalog Database sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Glue Catalog Databases: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing Glue Catalog Databases for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glue Catalog Databases for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glue Catalog Database sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepClassifiers(region string) error {
@@ -127,13 +132,15 @@ func sweepClassifiers(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlueConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &glue.GetClassifiersInput{}
 	err = conn.GetClassifiersPages(input, func(page *glue.GetClassifiersOutput, lastPage bool) bool {
-		if len(page.Classifiers) == 0 {
-			log.Printf("[INFO] No Glue Classifiers to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
+
 		for _, classifier := range page.Classifiers {
 			var name string
 			if classifier.CsvClassifier != nil {
@@ -150,23 +157,31 @@ func sweepClassifiers(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Glue Classifier: %s", name)
-			err := DeleteClassifier(conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Classifier %s: %s", name, err)
-			}
+			r := ResourceClassifier()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Classifier sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Glue Classifiers: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing Glue Classifiers for %s: %w", region, err))
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glue Classifiers for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glue Classifier sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepConnections(region string) error {
@@ -176,39 +191,43 @@ func sweepConnections(region string) error {
 	}
 	conn := client.(*conns.AWSClient).GlueConn
 	catalogID := client.(*conns.AWSClient).AccountID
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &glue.GetConnectionsInput{
 		CatalogId: aws.String(catalogID),
 	}
 	err = conn.GetConnectionsPages(input, func(page *glue.GetConnectionsOutput, lastPage bool) bool {
-		if len(page.ConnectionList) == 0 {
-			log.Printf("[INFO] No Glue Connections to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
-		for _, connection := range page.ConnectionList {
-			id := fmt.Sprintf("%s:%s", catalogID, aws.StringValue(connection.Name))
 
-			log.Printf("[INFO] Deleting Glue Connection: %s", id)
+		for _, connection := range page.ConnectionList {
 			r := ResourceConnection()
 			d := r.Data(nil)
-			d.SetId(id)
+			d.SetId(fmt.Sprintf("%s:%s", catalogID, aws.StringValue(connection.Name)))
+			d.Set("name", connection.Name)
 
-			err := r.Delete(d, client)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Connection %s: %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Connection sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Glue Connections: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing Glue Connections for %s: %w", region, err))
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glue Connections for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glue Connection sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepCrawlers(region string) error {
@@ -217,36 +236,41 @@ func sweepCrawlers(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlueConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &glue.GetCrawlersInput{}
 	err = conn.GetCrawlersPages(input, func(page *glue.GetCrawlersOutput, lastPage bool) bool {
-		if len(page.Crawlers) == 0 {
-			log.Printf("[INFO] No Glue Crawlers to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
-		for _, crawler := range page.Crawlers {
-			name := aws.StringValue(crawler.Name)
 
+		for _, crawler := range page.Crawlers {
 			r := ResourceCrawler()
 			d := r.Data(nil)
-			d.SetId(name)
+			d.SetId(aws.StringValue(crawler.Name))
+			d.Set("name", crawler.Name)
 
-			err := r.Delete(d, client)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Crawler %s: %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Crawler sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Glue Crawlers: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing Glue Crawlers for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glue Crawlers for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glue Crawler sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepDevEndpoint(region string) error {
@@ -255,13 +279,15 @@ func sweepDevEndpoint(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlueConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &glue.GetDevEndpointsInput{}
 	err = conn.GetDevEndpointsPages(input, func(page *glue.GetDevEndpointsOutput, lastPage bool) bool {
-		if len(page.DevEndpoints) == 0 {
-			log.Printf("[INFO] No Glue Dev Endpoints to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
+
 		for _, endpoint := range page.DevEndpoints {
 			name := aws.StringValue(endpoint.EndpointName)
 			if !strings.HasPrefix(name, sweep.ResourcePrefix) {
@@ -269,25 +295,31 @@ func sweepDevEndpoint(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Glue Dev Endpoint: %s", name)
-			_, err := conn.DeleteDevEndpoint(&glue.DeleteDevEndpointInput{
-				EndpointName: aws.String(name),
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Dev Endpoint %s: %s", name, err)
-			}
+			r := ResourceDevEndpoint()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Dev Endpoint sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("error retrieving Glue Dev Endpoint: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing Glue Dev Endpoints for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glue Dev Endpoints for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glue Dev Endpoint sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepJobs(region string) error {
@@ -296,76 +328,84 @@ func sweepJobs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlueConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &glue.GetJobsInput{}
 	err = conn.GetJobsPages(input, func(page *glue.GetJobsOutput, lastPage bool) bool {
-		if len(page.Jobs) == 0 {
-			log.Printf("[INFO] No Glue Jobs to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
+
 		for _, job := range page.Jobs {
-			name := aws.StringValue(job.Name)
+			r := ResourceJob()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(job.Name))
+			d.Set("name", job.Name)
 
-			log.Printf("[INFO] Deleting Glue Job: %s", name)
-			err := DeleteJob(conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Job %s: %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Job sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Glue Jobs: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing Glue Jobs for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glue Jobs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glue Job sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepMLTransforms(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %w", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlueConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &glue.GetMLTransformsInput{}
 	err = conn.GetMLTransformsPages(input, func(page *glue.GetMLTransformsOutput, lastPage bool) bool {
-		if len(page.Transforms) == 0 {
-			log.Printf("[INFO] No Glue ML Transforms to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
-		for _, transforms := range page.Transforms {
-			id := aws.StringValue(transforms.TransformId)
 
-			log.Printf("[INFO] Deleting Glue ML Transform: %s", id)
+		for _, transform := range page.Transforms {
 			r := ResourceMLTransform()
 			d := r.Data(nil)
-			d.SetId(id)
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(transform.TransformId))
+			d.Set("name", transform.Name)
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Glue ML Transforms sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
 
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Glue ML Transforms: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing Glue ML Transforms for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glue ML Transforms for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glue ML Transform sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepRegistry(region string) error {
@@ -374,28 +414,46 @@ func sweepRegistry(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlueConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	listOutput, err := conn.ListRegistries(&glue.ListRegistriesInput{})
-	if err != nil {
-		// Some endpoints that do not support Glue Registrys return InternalFailure
-		if sweep.SkipSweepError(err) || tfawserr.ErrMessageContains(err, "InternalFailure", "") {
-			log.Printf("[WARN] Skipping Glue Registry sweep for %s: %s", region, err)
-			return nil
+	input := &glue.ListRegistriesInput{}
+	err = conn.ListRegistriesPages(input, func(page *glue.ListRegistriesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
-		return fmt.Errorf("Error retrieving Glue Registry: %s", err)
-	}
-	for _, registry := range listOutput.Registries {
-		arn := aws.StringValue(registry.RegistryArn)
-		r := ResourceRegistry()
-		d := r.Data(nil)
-		d.SetId(arn)
 
-		err := r.Delete(d, client)
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete Glue Registry %s: %s", arn, err)
+		for _, registry := range page.Registries {
+			r := ResourceRegistry()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(registry.RegistryArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
+		return !lastPage
+	})
+
+	// Some endpoints that do not support Glue Registries return InternalFailure
+	if tfawserr.ErrMessageContains(err, "InternalFailure", "") {
+		log.Printf("[WARN] Skipping Glue Registry sweep for %s: %s", region, err)
+		return nil
 	}
-	return nil
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Glue Registries for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glue Registries for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glue Registry sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepSchema(region string) error {
@@ -404,28 +462,46 @@ func sweepSchema(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlueConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	listOutput, err := conn.ListSchemas(&glue.ListSchemasInput{})
-	if err != nil {
-		// Some endpoints that do not support Glue Schemas return InternalFailure
-		if sweep.SkipSweepError(err) || tfawserr.ErrMessageContains(err, "InternalFailure", "") {
-			log.Printf("[WARN] Skipping Glue Schema sweep for %s: %s", region, err)
-			return nil
+	input := &glue.ListSchemasInput{}
+	err = conn.ListSchemasPages(input, func(page *glue.ListSchemasOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
-		return fmt.Errorf("Error retrieving Glue Schema: %s", err)
-	}
-	for _, schema := range listOutput.Schemas {
-		arn := aws.StringValue(schema.SchemaArn)
-		r := ResourceSchema()
-		d := r.Data(nil)
-		d.SetId(arn)
 
-		err := r.Delete(d, client)
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete Glue Schema %s: %s", arn, err)
+		for _, schema := range page.Schemas {
+			r := ResourceSchema()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(schema.SchemaArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
+		return !lastPage
+	})
+
+	// Some endpoints that do not support Glue Schemas return InternalFailure
+	if tfawserr.ErrMessageContains(err, "InternalFailure", "") {
+		log.Printf("[WARN] Skipping Glue Schema sweep for %s: %s", region, err)
+		return nil
 	}
-	return nil
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Glue Schemas for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glue Schemas for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glue Schema sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepSecurityConfigurations(region string) error {
@@ -434,29 +510,25 @@ func sweepSecurityConfigurations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlueConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &glue.GetSecurityConfigurationsInput{}
-
 	for {
 		output, err := conn.GetSecurityConfigurations(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Security Configuration sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving Glue Security Configurations: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing Glue Security Configurations for %s: %w", region, err))
+			break
 		}
 
 		for _, securityConfiguration := range output.SecurityConfigurations {
-			name := aws.StringValue(securityConfiguration.Name)
+			r := ResourceSecurityConfiguration()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(securityConfiguration.Name))
+			d.Set("name", securityConfiguration.Name)
 
-			log.Printf("[INFO] Deleting Glue Security Configuration: %s", name)
-			err := DeleteSecurityConfiguration(conn, name)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Security Configuration %s: %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -466,7 +538,16 @@ func sweepSecurityConfigurations(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glue Security Configurations for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glue Security Configuration sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepTriggers(region string) error {
@@ -475,36 +556,41 @@ func sweepTriggers(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlueConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	input := &glue.GetTriggersInput{}
 	err = conn.GetTriggersPages(input, func(page *glue.GetTriggersOutput, lastPage bool) bool {
-		if page == nil || len(page.Triggers) == 0 {
-			log.Printf("[INFO] No Glue Triggers to sweep")
-			return false
+		if page == nil {
+			return !lastPage
 		}
-		for _, trigger := range page.Triggers {
-			name := aws.StringValue(trigger.Name)
 
-			log.Printf("[INFO] Deleting Glue Trigger: %s", name)
+		for _, trigger := range page.Triggers {
 			r := ResourceTrigger()
 			d := r.Data(nil)
-			d.SetId(name)
-			err := r.Delete(d, client)
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete Glue Trigger %s: %s", name, err)
-			}
+			d.SetId(aws.StringValue(trigger.Name))
+			d.Set("name", trigger.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Glue Trigger sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Glue Triggers: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing Glue Triggers for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glue Triggers for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glue Trigger sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepWorkflow(region string) error {
@@ -513,21 +599,45 @@ func sweepWorkflow(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).GlueConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	listOutput, err := conn.ListWorkflows(&glue.ListWorkflowsInput{})
-	if err != nil {
-		// Some endpoints that do not support Glue Workflows return InternalFailure
-		if sweep.SkipSweepError(err) || tfawserr.ErrMessageContains(err, "InternalFailure", "") {
-			log.Printf("[WARN] Skipping Glue Workflow sweep for %s: %s", region, err)
-			return nil
+	input := &glue.ListWorkflowsInput{}
+	err = conn.ListWorkflowsPages(input, func(page *glue.ListWorkflowsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
-		return fmt.Errorf("Error retrieving Glue Workflow: %s", err)
-	}
-	for _, workflowName := range listOutput.Workflows {
-		err := DeleteWorkflow(conn, *workflowName)
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete Glue Workflow %s: %s", *workflowName, err)
+
+		for _, workflowName := range page.Workflows {
+			r := ResourceWorkflow()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(workflowName))
+			d.Set("name", workflowName)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
+		return !lastPage
+	})
+
+	// Some endpoints that do not support Glue Workflows return InternalFailure
+	if tfawserr.ErrMessageContains(err, "InternalFailure", "") {
+		log.Printf("[WARN] Skipping Glue Workflow sweep for %s: %s", region, err)
+		return nil
 	}
-	return nil
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Glue Workflows for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Glue Workflows for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Glue Workflow sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
@@ -14,12 +14,13 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_iam_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_group", &resource.Sweeper{
 		Name: "aws_iam_group",
 		F:    sweepGroups,
 		Dependencies: []string{
@@ -27,18 +28,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_instance_profile", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_instance_profile", &resource.Sweeper{
 		Name:         "aws_iam_instance_profile",
 		F:            sweepInstanceProfile,
 		Dependencies: []string{"aws_iam_role"},
 	})
 
-	resource.AddTestSweepers("aws_iam_openid_connect_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_openid_connect_provider", &resource.Sweeper{
 		Name: "aws_iam_openid_connect_provider",
 		F:    sweepOpenIDConnectProvider,
 	})
 
-	resource.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_policy", &resource.Sweeper{
 		Name: "aws_iam_policy",
 		F:    sweepPolicies,
 		Dependencies: []string{
@@ -48,7 +49,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_iam_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_role", &resource.Sweeper{
 		Name: "aws_iam_role",
 		Dependencies: []string{
 			"aws_batch_compute_environment",
@@ -76,22 +77,22 @@ func init() {
 		F: sweepRoles,
 	})
 
-	resource.AddTestSweepers("aws_iam_saml_provider", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_saml_provider", &resource.Sweeper{
 		Name: "aws_iam_saml_provider",
 		F:    sweepSamlProvider,
 	})
 
-	resource.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_server_certificate", &resource.Sweeper{
 		Name: "aws_iam_server_certificate",
 		F:    sweepServerCertificates,
 	})
 
-	resource.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_service_linked_role", &resource.Sweeper{
 		Name: "aws_iam_service_linked_role",
 		F:    sweepServiceLinkedRoles,
 	})
 
-	resource.AddTestSweepers("aws_iam_user", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_iam_user", &resource.Sweeper{
 		Name: "aws_iam_user",
 		F:    sweepUsers,
 	})
@@ -99,15 +100,14 @@ func init() {
 
 func sweepGroups(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-
 	conn := client.(*conns.AWSClient).IAMConn
-	input := &iam.ListGroupsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
+	input := &iam.ListGroupsInput{}
 	err = conn.ListGroupsPages(input, func(page *iam.ListGroupsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
@@ -120,96 +120,31 @@ func sweepGroups(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting IAM Group: %s", name)
-
-			getGroupInput := &iam.GetGroupInput{
-				GroupName: group.GroupName,
-			}
-
-			getGroupOutput, err := conn.GetGroup(getGroupInput)
-
-			if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-				continue
-			}
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error reading IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-
-			if getGroupOutput != nil {
-				for _, user := range getGroupOutput.Users {
-					username := aws.StringValue(user.UserName)
-
-					log.Printf("[INFO] Removing IAM User (%s) from Group: %s", username, name)
-
-					input := &iam.RemoveUserFromGroupInput{
-						UserName:  user.UserName,
-						GroupName: group.GroupName,
-					}
-
-					_, err := conn.RemoveUserFromGroup(input)
-
-					if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-						continue
-					}
-
-					if err != nil {
-						sweeperErr := fmt.Errorf("error removing IAM User (%s) from IAM Group (%s): %w", username, name, err)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-						continue
-					}
-				}
-			}
-
-			input := &iam.DeleteGroupInput{
-				GroupName: group.GroupName,
-			}
-
-			if err := DeleteGroupPolicyAttachments(conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policy attachments: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-
-			if err := DeleteGroupPolicies(conn, name); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s) policies: %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-
-			_, err = conn.DeleteGroup(input)
-
-			if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-				continue
-			}
+			r := resourceGroupWithMembers()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Group (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM Group sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IAM Groups for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IAM Groups for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IAM Groups: %w", err))
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IAM Group sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepInstanceProfile(region string) error {
@@ -218,10 +153,11 @@ func sweepInstanceProfile(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	var sweeperErrs *multierror.Error
-
-	err = conn.ListInstanceProfilesPages(&iam.ListInstanceProfilesInput{}, func(page *iam.ListInstanceProfilesOutput, lastPage bool) bool {
+	input := &iam.ListInstanceProfilesInput{}
+	err = conn.ListInstanceProfilesPages(input, func(page *iam.ListInstanceProfilesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -237,36 +173,36 @@ func sweepInstanceProfile(region string) error {
 			r := ResourceInstanceProfile()
 			d := r.Data(nil)
 			d.SetId(name)
+			d.Set("name", name)
 
 			roles := instanceProfile.Roles
-			if r := len(roles); r > 1 {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("unexpected number of roles for IAM Instance Profile (%s): %d", name, r))
-			} else if r == 1 {
+			if n := len(roles); n > 1 {
+				errs = multierror.Append(errs, fmt.Errorf("unexpected number of roles for IAM Instance Profile (%s): %d", name, n))
+				continue
+			} else if n == 1 {
 				d.Set("role", roles[0].RoleName)
 			}
 
-			log.Printf("[INFO] Sweeping IAM Instance Profile %q", name)
-			err := r.Delete(d, client)
-
-			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting IAM Instance Profile (%s): %w", name, err))
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM Instance Profile sweep for %q: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IAM Instance Profiles for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing IAM Instance Profiles: %w", err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IAM Instance Profiles for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IAM Instance Profile sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepOpenIDConnectProvider(region string) error {
@@ -275,105 +211,80 @@ func sweepOpenIDConnectProvider(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	var sweeperErrs *multierror.Error
-
-	out, err := conn.ListOpenIDConnectProviders(&iam.ListOpenIDConnectProvidersInput{})
+	output, err := conn.ListOpenIDConnectProviders(&iam.ListOpenIDConnectProvidersInput{})
 
-	for _, oidcProvider := range out.OpenIDConnectProviderList {
-		arn := aws.StringValue(oidcProvider.Arn)
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IAM OIDC Providers for %s: %w", region, err))
+	}
 
-		r := ResourceOpenIDConnectProvider()
-		d := r.Data(nil)
-		d.SetId(arn)
-		err := r.Delete(d, client)
+	if output != nil {
+		for _, oidcProvider := range output.OpenIDConnectProviderList {
+			r := ResourceOpenIDConnectProvider()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(oidcProvider.Arn))
 
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM OIDC Provider (%s): %w", arn, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 	}
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM OIDC Provider sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IAM OIDC Providers for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing IAM OIDC Providers: %w", err))
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IAM OIDC Provider sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepPolicies(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %w", err)
 	}
-
 	conn := client.(*conns.AWSClient).IAMConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
 	input := &iam.ListPoliciesInput{
 		Scope: aws.String(iam.PolicyScopeTypeLocal),
 	}
-	var sweeperErrs *multierror.Error
-
 	err = conn.ListPoliciesPages(input, func(page *iam.ListPoliciesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
 		for _, policy := range page.Policies {
-			arn := aws.StringValue(policy.Arn)
-			input := &iam.DeletePolicyInput{
-				PolicyArn: policy.Arn,
-			}
-
-			log.Printf("[INFO] Deleting IAM Policy: %s", arn)
-			if err := PolicyDeleteNondefaultVersions(arn, conn); err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Policy (%s) non-default versions: %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-
-			_, err := conn.DeletePolicy(input)
-
-			// Treat this sweeper as best effort for now. There are a lot of edge cases
-			// with lingering aws_iam_role resources in the HashiCorp testing accounts.
-			if tfawserr.ErrMessageContains(err, iam.ErrCodeDeleteConflictException, "") {
-				log.Printf("[WARN] Ignoring IAM Policy (%s) deletion error: %s", arn, err)
-				continue
-			}
-
-			if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
-				continue
-			}
+			r := resourcePolicyIgnoringConflicts()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(policy.Arn))
+			d.Set("name", policy.PolicyName)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Policy (%s): %w", arn, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM Policy sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IAM Policies for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving IAM Policies: %w", err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IAM Policies for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IAM Policy sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepRoles(region string) error {
@@ -382,57 +293,49 @@ func sweepRoles(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	input := &iam.ListRolesInput{}
+	err = conn.ListRolesPages(input, func(page *iam.ListRolesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-	roles := make([]string, 0)
-	err = conn.ListRolesPages(&iam.ListRolesInput{}, func(page *iam.ListRolesOutput, lastPage bool) bool {
 		for _, role := range page.Roles {
-			roleName := aws.StringValue(role.RoleName)
-			if roleNameFilter(roleName) {
-				roles = append(roles, roleName)
-			} else {
-				log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", roleName)
+			name := aws.StringValue(role.RoleName)
+
+			if !roleNameFilter(name) {
+				log.Printf("[INFO] Skipping IAM Role (%s): no match on allow-list", name)
+				continue
 			}
+
+			r := resourceRoleIgnoringAccessDenied()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
+			d.Set("force_detach_policies", true)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("Error retrieving IAM Roles: %w", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing IAM Roles for %s: %w", region, err))
 	}
 
-	if len(roles) == 0 {
-		log.Print("[DEBUG] No IAM Roles to sweep")
-		return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IAM Roles for %s: %w", region, err))
 	}
 
-	var sweeperErrs *multierror.Error
-
-	for _, roleName := range roles {
-		log.Printf("[DEBUG] Deleting IAM Role (%s)", roleName)
-
-		err := DeleteRole(conn, roleName, true, true, true)
-		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
-			continue
-		}
-		if tfawserr.ErrCodeContains(err, "AccessDenied") {
-			log.Printf("[WARN] Skipping IAM Role (%s): %s", roleName, err)
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM Role (%s): %w", roleName, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IAM Role sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepSamlProvider(region string) error {
@@ -441,79 +344,89 @@ func sweepSamlProvider(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	var sweeperErrs *multierror.Error
-
-	out, err := conn.ListSAMLProviders(&iam.ListSAMLProvidersInput{})
+	output, err := conn.ListSAMLProviders(&iam.ListSAMLProvidersInput{})
 
-	for _, sampProvider := range out.SAMLProviderList {
-		arn := aws.StringValue(sampProvider.Arn)
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IAM SAML Providers for %s: %w", region, err))
+	}
 
-		r := ResourceSamlProvider()
-		d := r.Data(nil)
-		d.SetId(arn)
-		err := r.Delete(d, client)
+	if output != nil {
+		for _, samlProvider := range output.SAMLProviderList {
+			r := ResourceSamlProvider()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(samlProvider.Arn))
 
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM SAML Provider (%s): %w", arn, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 	}
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM SAML Provider sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IAM SAML Providers for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing IAM SAML Providers: %w", err))
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IAM SAML Provider sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepServerCertificates(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	err = conn.ListServerCertificatesPages(&iam.ListServerCertificatesInput{}, func(out *iam.ListServerCertificatesOutput, lastPage bool) bool {
-		for _, sc := range out.ServerCertificateMetadataList {
-			log.Printf("[INFO] Deleting IAM Server Certificate: %s", *sc.ServerCertificateName)
+	input := &iam.ListServerCertificatesInput{}
+	err = conn.ListServerCertificatesPages(input, func(page *iam.ListServerCertificatesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-			_, err := conn.DeleteServerCertificate(&iam.DeleteServerCertificateInput{
-				ServerCertificateName: sc.ServerCertificateName,
-			})
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete IAM Server Certificate %s: %s",
-					*sc.ServerCertificateName, err)
-				continue
-			}
+		for _, sc := range page.ServerCertificateMetadataList {
+			r := ResourceServerCertificate()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(sc.ServerCertificateId))
+			d.Set("name", sc.ServerCertificateName)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping IAM Server Certificate sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving IAM Server Certificates: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing IAM Server Certificates for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IAM Server Certificates for %s: %w", region, err))
 	}
 
-	return nil
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IAM Server Certificate sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepServiceLinkedRoles(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
 	input := &iam.ListRolesInput{
 		PathPrefix: aws.String("/aws-service-role/"),
 	}
@@ -523,10 +436,10 @@ func sweepServiceLinkedRoles(region string) error {
 	// TestAccIAMServiceLinkedRole_CustomSuffix_diffSuppressFunc
 	customSuffixRegex := regexp.MustCompile(`_?(tf-acc-test-\d+|ServiceRoleFor(ApplicationAutoScaling_CustomResource|ElasticBeanstalk))$`)
 	err = conn.ListRolesPages(input, func(page *iam.ListRolesOutput, lastPage bool) bool {
-		if len(page.Roles) == 0 {
-			log.Printf("[INFO] No IAM Service Roles to sweep")
-			return true
+		if page == nil {
+			return !lastPage
 		}
+
 		for _, role := range page.Roles {
 			roleName := aws.StringValue(role.RoleName)
 
@@ -538,48 +451,63 @@ func sweepServiceLinkedRoles(region string) error {
 			r := ResourceServiceLinkedRole()
 			d := r.Data(nil)
 			d.SetId(aws.StringValue(role.Arn))
-			err := r.Delete(d, client)
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting IAM Service Linked Role (%s): %w", roleName, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM Service Role sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing IAM Service Roles for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing IAM Service Roles: %w", err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IAM Service Roles for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IAM Service Role sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepUsers(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).IAMConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
 	prefixes := []string{
 		"test-user",
 		"test_user",
 		"tf-acc",
 		"tf_acc",
 	}
-	users := make([]*iam.User, 0)
 
-	err = conn.ListUsersPages(&iam.ListUsersInput{}, func(page *iam.ListUsersOutput, lastPage bool) bool {
+	input := &iam.ListUsersInput{}
+	err = conn.ListUsersPages(input, func(page *iam.ListUsersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
 		for _, user := range page.Users {
+			name := aws.StringValue(user.UserName)
+
 			for _, prefix := range prefixes {
-				if strings.HasPrefix(aws.StringValue(user.UserName), prefix) {
-					users = append(users, user)
+				if strings.HasPrefix(name, prefix) {
+					r := resourceUserWithPolicies()
+					d := r.Data(nil)
+					d.SetId(name)
+					d.Set("name", name)
+					d.Set("force_destroy", true)
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 					break
 				}
 			}
@@ -588,147 +516,20 @@ func sweepUsers(region string) error {
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping IAM User sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("Error retrieving IAM Users: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing IAM Users for %s: %w", region, err))
 	}
 
-	if len(users) == 0 {
-		log.Print("[DEBUG] No IAM Users to sweep")
-		return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping IAM Users for %s: %w", region, err))
 	}
 
-	var sweeperErrs *multierror.Error
-	for _, user := range users {
-		username := aws.StringValue(user.UserName)
-		log.Printf("[DEBUG] Deleting IAM User: %s", username)
-
-		listUserPoliciesInput := &iam.ListUserPoliciesInput{
-			UserName: user.UserName,
-		}
-		listUserPoliciesOutput, err := conn.ListUserPolicies(listUserPoliciesInput)
-
-		if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error listing IAM User (%s) inline policies: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
-
-		for _, inlinePolicyName := range listUserPoliciesOutput.PolicyNames {
-			log.Printf("[DEBUG] Deleting IAM User (%s) inline policy %q", username, *inlinePolicyName)
-
-			input := &iam.DeleteUserPolicyInput{
-				PolicyName: inlinePolicyName,
-				UserName:   user.UserName,
-			}
-
-			if _, err := conn.DeleteUserPolicy(input); err != nil {
-				if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
-					continue
-				}
-				sweeperErr := fmt.Errorf("error deleting IAM User (%s) inline policy %q: %s", username, *inlinePolicyName, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-		}
-
-		listAttachedUserPoliciesInput := &iam.ListAttachedUserPoliciesInput{
-			UserName: user.UserName,
-		}
-		listAttachedUserPoliciesOutput, err := conn.ListAttachedUserPolicies(listAttachedUserPoliciesInput)
-
-		if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error listing IAM User (%s) attached policies: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
-
-		for _, attachedPolicy := range listAttachedUserPoliciesOutput.AttachedPolicies {
-			policyARN := aws.StringValue(attachedPolicy.PolicyArn)
-
-			log.Printf("[DEBUG] Detaching IAM User (%s) attached policy: %s", username, policyARN)
-
-			if err := DetachPolicyFromUser(conn, username, policyARN); err != nil {
-				sweeperErr := fmt.Errorf("error detaching IAM User (%s) attached policy (%s): %s", username, policyARN, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
-		}
-
-		if err := DeleteUserGroupMemberships(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) group memberships: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
-
-		if err := DeleteUserAccessKeys(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) access keys: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
-
-		if err := DeleteUserSSHKeys(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) SSH keys: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
-
-		if err := DeleteUserVirtualMFADevices(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) virtual MFA devices: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
-
-		if err := DeactivateUserMFADevices(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) MFA devices: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
-
-		if err := DeleteUserLoginProfile(conn, username); err != nil {
-			sweeperErr := fmt.Errorf("error removing IAM User (%s) login profile: %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
-
-		input := &iam.DeleteUserInput{
-			UserName: aws.String(username),
-		}
-
-		_, err = conn.DeleteUser(input)
-
-		if tfawserr.ErrMessageContains(err, iam.ErrCodeNoSuchEntityException, "") {
-			continue
-		}
-		if err != nil {
-			sweeperErr := fmt.Errorf("error deleting IAM User (%s): %s", username, err)
-			log.Printf("[ERROR] %s", sweeperErr)
-			sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			continue
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping IAM User sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func roleNameFilter(name string) bool {
@@ -778,3 +579,161 @@ func roleNameFilter(name string) bool {
 
 	return false
 }
+
+// resourceGroupWithMembers is an IAM Group resource which removes the group's
+// users, inline policies and policy attachments before deleting the group.
+func resourceGroupWithMembers() *schema.Resource {
+	r := ResourceGroup()
+	r.Delete = resourceGroupWithMembersDelete
+
+	return r
+}
+
+func resourceGroupWithMembersDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	output, err := conn.GetGroup(&iam.GetGroupInput{
+		GroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IAM Group (%s): %w", d.Id(), err)
+	}
+
+	for _, user := range output.Users {
+		username := aws.StringValue(user.UserName)
+
+		log.Printf("[INFO] Removing IAM User (%s) from Group: %s", username, d.Id())
+		_, err := conn.RemoveUserFromGroup(&iam.RemoveUserFromGroupInput{
+			GroupName: aws.String(d.Id()),
+			UserName:  user.UserName,
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error removing IAM User (%s) from IAM Group (%s): %w", username, d.Id(), err)
+		}
+	}
+
+	if err := DeleteGroupPolicyAttachments(conn, d.Id()); err != nil {
+		return fmt.Errorf("error deleting IAM Group (%s) policy attachments: %w", d.Id(), err)
+	}
+
+	if err := DeleteGroupPolicies(conn, d.Id()); err != nil {
+		return fmt.Errorf("error deleting IAM Group (%s) policies: %w", d.Id(), err)
+	}
+
+	return resourceGroupDelete(d, meta)
+}
+
+// resourcePolicyIgnoringConflicts is an IAM Policy resource whose deletion
+// is best effort: there are a lot of edge cases with lingering aws_iam_role
+// resources in the HashiCorp testing accounts.
+func resourcePolicyIgnoringConflicts() *schema.Resource {
+	r := ResourcePolicy()
+	r.Delete = resourcePolicyIgnoringConflictsDelete
+
+	return r
+}
+
+func resourcePolicyIgnoringConflictsDelete(d *schema.ResourceData, meta interface{}) error {
+	err := resourcePolicyDelete(d, meta)
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeDeleteConflictException) {
+		log.Printf("[WARN] Ignoring IAM Policy (%s) deletion error: %s", d.Id(), err)
+		return nil
+	}
+
+	return err
+}
+
+// resourceRoleIgnoringAccessDenied is an IAM Role resource which skips roles
+// the sweeper is not permitted to delete.
+func resourceRoleIgnoringAccessDenied() *schema.Resource {
+	r := ResourceRole()
+	r.Delete = resourceRoleIgnoringAccessDeniedDelete
+
+	return r
+}
+
+func resourceRoleIgnoringAccessDeniedDelete(d *schema.ResourceData, meta interface{}) error {
+	err := resourceRoleDelete(d, meta)
+
+	if tfawserr.ErrCodeContains(err, "AccessDenied") {
+		log.Printf("[WARN] Skipping IAM Role (%s): %s", d.Id(), err)
+		return nil
+	}
+
+	return err
+}
+
+// resourceUserWithPolicies is an IAM User resource which deletes the user's
+// inline policies and detaches its managed policies before deleting the user.
+func resourceUserWithPolicies() *schema.Resource {
+	r := ResourceUser()
+	r.Delete = resourceUserWithPoliciesDelete
+
+	return r
+}
+
+func resourceUserWithPoliciesDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IAMConn
+
+	listUserPoliciesOutput, err := conn.ListUserPolicies(&iam.ListUserPoliciesInput{
+		UserName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IAM User (%s) inline policies: %w", d.Id(), err)
+	}
+
+	for _, inlinePolicyName := range listUserPoliciesOutput.PolicyNames {
+		log.Printf("[DEBUG] Deleting IAM User (%s) inline policy %q", d.Id(), aws.StringValue(inlinePolicyName))
+		_, err := conn.DeleteUserPolicy(&iam.DeleteUserPolicyInput{
+			PolicyName: inlinePolicyName,
+			UserName:   aws.String(d.Id()),
+		})
+
+		if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("error deleting IAM User (%s) inline policy %q: %w", d.Id(), aws.StringValue(inlinePolicyName), err)
+		}
+	}
+
+	listAttachedUserPoliciesOutput, err := conn.ListAttachedUserPolicies(&iam.ListAttachedUserPoliciesInput{
+		UserName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, iam.ErrCodeNoSuchEntityException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing IAM User (%s) attached policies: %w", d.Id(), err)
+	}
+
+	for _, attachedPolicy := range listAttachedUserPoliciesOutput.AttachedPolicies {
+		policyARN := aws.StringValue(attachedPolicy.PolicyArn)
+
+		log.Printf("[DEBUG] Detaching IAM User (%s) attached policy: %s", d.Id(), policyARN)
+		if err := DetachPolicyFromUser(conn, d.Id(), policyARN); err != nil {
+			return fmt.Errorf("error detaching IAM User (%s) attached policy (%s): %w", d.Id(), policyARN, err)
+		}
+	}
+
+	return resourceUserDelete(d, meta)
+}
@@ -0,0 +1,27 @@
+package identitystore
+
+import (
+	"fmt"
+	"strings"
+)
+
+const resourceIDSeparator = ","
+
+// ResourceCreateResourceID returns the ID of an Identity Store object, which is
+// only unique within its Identity Store.
+func ResourceCreateResourceID(identityStoreID, objectID string) string {
+	parts := []string{identityStoreID, objectID}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func ResourceParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, resourceIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected IDENTITY_STORE_ID%[2]sOBJECT_ID", id, resourceIDSeparator)
+}
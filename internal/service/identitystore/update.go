@@ -0,0 +1,110 @@
+package identitystore
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+)
+
+// attributeOperation replaces the value of the attribute at the specified path.
+// A nil value removes the attribute.
+//
+// The AWS SDK for Go does not support the document type of the AttributeValue
+// member of the Identity Store AttributeOperation structure, so the JSON bodies of
+// update requests are serialized here instead.
+type attributeOperation struct {
+	AttributePath  string
+	AttributeValue interface{} `json:",omitempty"`
+}
+
+func updateGroup(ctx context.Context, conn *identitystore.IdentityStore, identityStoreID, groupID string, operations []attributeOperation) error {
+	input := &identitystore.UpdateGroupInput{
+		GroupId:         aws.String(groupID),
+		IdentityStoreId: aws.String(identityStoreID),
+		Operations:      expandAttributeOperations(operations),
+	}
+
+	body, err := json.Marshal(struct {
+		GroupId         string
+		IdentityStoreId string
+		Operations      []attributeOperation
+	}{
+		GroupId:         groupID,
+		IdentityStoreId: identityStoreID,
+		Operations:      operations,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	req, _ := conn.UpdateGroupRequest(input)
+
+	return sendWithBody(ctx, req, body)
+}
+
+func updateUser(ctx context.Context, conn *identitystore.IdentityStore, identityStoreID, userID string, operations []attributeOperation) error {
+	input := &identitystore.UpdateUserInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		Operations:      expandAttributeOperations(operations),
+		UserId:          aws.String(userID),
+	}
+
+	body, err := json.Marshal(struct {
+		IdentityStoreId string
+		Operations      []attributeOperation
+		UserId          string
+	}{
+		IdentityStoreId: identityStoreID,
+		Operations:      operations,
+		UserId:          userID,
+	})
+
+	if err != nil {
+		return err
+	}
+
+	req, _ := conn.UpdateUserRequest(input)
+
+	return sendWithBody(ctx, req, body)
+}
+
+// sendWithBody sends the request, replacing the body serialized by the SDK.
+// The SDK-modeled input is still used for parameter validation.
+func sendWithBody(ctx context.Context, req *request.Request, body []byte) error {
+	req.SetContext(ctx)
+	req.Handlers.Build.PushBack(func(r *request.Request) {
+		r.SetBufferBody(body)
+	})
+
+	return req.Send()
+}
+
+func expandAttributeOperations(operations []attributeOperation) []*identitystore.AttributeOperation {
+	apiObjects := make([]*identitystore.AttributeOperation, 0, len(operations))
+
+	for _, operation := range operations {
+		apiObjects = append(apiObjects, &identitystore.AttributeOperation{
+			AttributePath: aws.String(operation.AttributePath),
+		})
+	}
+
+	return apiObjects
+}
+
+// stringAttributeOperation returns an operation that sets the attribute at the
+// specified path to a string value, or removes it if the value is empty.
+func stringAttributeOperation(path, value string) attributeOperation {
+	operation := attributeOperation{
+		AttributePath: path,
+	}
+
+	if value != "" {
+		operation.AttributeValue = value
+	}
+
+	return operation
+}
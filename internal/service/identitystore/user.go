@@ -0,0 +1,717 @@
+package identitystore
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/identitystore"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceUserCreate,
+		ReadContext:   resourceUserRead,
+		UpdateContext: resourceUserUpdate,
+		DeleteContext: resourceUserDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"addresses": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"country": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"formatted": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"locality": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"postal_code": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"region": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"street_address": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"display_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"emails": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"external_ids":      externalIDsSchema(),
+			"identity_store_id": identityStoreIDSchema(),
+			"locale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"name": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"family_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"formatted": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"given_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"honorific_prefix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"honorific_suffix": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"middle_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"nickname": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"phone_numbers": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"primary": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+						"value": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(1, 1024),
+						},
+					},
+				},
+			},
+			"preferred_language": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"profile_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"timezone": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"user_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(1, 1024),
+			},
+		},
+	}
+}
+
+// userStringAttributePaths maps top-level string arguments to Identity Store attribute paths.
+var userStringAttributePaths = map[string]string{
+	"display_name":       "displayName",
+	"locale":             "locale",
+	"nickname":           "nickName",
+	"preferred_language": "preferredLanguage",
+	"profile_url":        "profileUrl",
+	"timezone":           "timezone",
+	"title":              "title",
+	"user_type":          "userType",
+}
+
+// userNameAttributePaths maps name block arguments to Identity Store attribute paths.
+var userNameAttributePaths = map[string]string{
+	"family_name":      "name.familyName",
+	"formatted":        "name.formatted",
+	"given_name":       "name.givenName",
+	"honorific_prefix": "name.honorificPrefix",
+	"honorific_suffix": "name.honorificSuffix",
+	"middle_name":      "name.middleName",
+}
+
+func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID := d.Get("identity_store_id").(string)
+	userName := d.Get("user_name").(string)
+	input := &identitystore.CreateUserInput{
+		DisplayName:     aws.String(d.Get("display_name").(string)),
+		IdentityStoreId: aws.String(identityStoreID),
+		UserName:        aws.String(userName),
+	}
+
+	if v, ok := d.GetOk("addresses"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Addresses = []*identitystore.Address{expandAddress(v.([]interface{})[0].(map[string]interface{}))}
+	}
+
+	if v, ok := d.GetOk("emails"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Emails = []*identitystore.Email{expandEmail(v.([]interface{})[0].(map[string]interface{}))}
+	}
+
+	if v, ok := d.GetOk("locale"); ok {
+		input.Locale = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.Name = expandName(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("nickname"); ok {
+		input.NickName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("phone_numbers"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PhoneNumbers = []*identitystore.PhoneNumber{expandPhoneNumber(v.([]interface{})[0].(map[string]interface{}))}
+	}
+
+	if v, ok := d.GetOk("preferred_language"); ok {
+		input.PreferredLanguage = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("profile_url"); ok {
+		input.ProfileUrl = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timezone"); ok {
+		input.Timezone = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("title"); ok {
+		input.Title = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("user_type"); ok {
+		input.UserType = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating Identity Store User: %s", userName)
+	output, err := conn.CreateUserWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Identity Store (%s) User (%s): %s", identityStoreID, userName, err)
+	}
+
+	d.SetId(ResourceCreateResourceID(identityStoreID, aws.StringValue(output.UserId)))
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := ResourceParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	output, err := FindUserByTwoPartKey(ctx, conn, identityStoreID, userID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Identity Store User (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Identity Store User (%s): %s", d.Id(), err)
+	}
+
+	if err := d.Set("addresses", flattenAddresses(output.Addresses)); err != nil {
+		return diag.Errorf("error setting addresses: %s", err)
+	}
+	d.Set("display_name", output.DisplayName)
+	if err := d.Set("emails", flattenEmails(output.Emails)); err != nil {
+		return diag.Errorf("error setting emails: %s", err)
+	}
+	if err := d.Set("external_ids", flattenExternalIDs(output.ExternalIds)); err != nil {
+		return diag.Errorf("error setting external_ids: %s", err)
+	}
+	d.Set("identity_store_id", output.IdentityStoreId)
+	d.Set("locale", output.Locale)
+	if output.Name != nil {
+		if err := d.Set("name", []interface{}{flattenName(output.Name)}); err != nil {
+			return diag.Errorf("error setting name: %s", err)
+		}
+	} else {
+		d.Set("name", nil)
+	}
+	d.Set("nickname", output.NickName)
+	if err := d.Set("phone_numbers", flattenPhoneNumbers(output.PhoneNumbers)); err != nil {
+		return diag.Errorf("error setting phone_numbers: %s", err)
+	}
+	d.Set("preferred_language", output.PreferredLanguage)
+	d.Set("profile_url", output.ProfileUrl)
+	d.Set("timezone", output.Timezone)
+	d.Set("title", output.Title)
+	d.Set("user_id", output.UserId)
+	d.Set("user_name", output.UserName)
+	d.Set("user_type", output.UserType)
+
+	return nil
+}
+
+func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := ResourceParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var operations []attributeOperation
+
+	for key, path := range userStringAttributePaths {
+		if d.HasChange(key) {
+			operations = append(operations, stringAttributeOperation(path, d.Get(key).(string)))
+		}
+	}
+
+	for key, path := range userNameAttributePaths {
+		if key := "name.0." + key; d.HasChange(key) {
+			operations = append(operations, stringAttributeOperation(path, d.Get(key).(string)))
+		}
+	}
+
+	if d.HasChange("addresses") {
+		operation := attributeOperation{AttributePath: "addresses"}
+
+		if v, ok := d.GetOk("addresses"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			operation.AttributeValue = []interface{}{addressAttributeValue(expandAddress(v.([]interface{})[0].(map[string]interface{})))}
+		}
+
+		operations = append(operations, operation)
+	}
+
+	if d.HasChange("emails") {
+		operation := attributeOperation{AttributePath: "emails"}
+
+		if v, ok := d.GetOk("emails"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			operation.AttributeValue = []interface{}{emailAttributeValue(expandEmail(v.([]interface{})[0].(map[string]interface{})))}
+		}
+
+		operations = append(operations, operation)
+	}
+
+	if d.HasChange("phone_numbers") {
+		operation := attributeOperation{AttributePath: "phoneNumbers"}
+
+		if v, ok := d.GetOk("phone_numbers"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			operation.AttributeValue = []interface{}{phoneNumberAttributeValue(expandPhoneNumber(v.([]interface{})[0].(map[string]interface{})))}
+		}
+
+		operations = append(operations, operation)
+	}
+
+	if len(operations) > 0 {
+		log.Printf("[DEBUG] Updating Identity Store User: %s", d.Id())
+		if err := updateUser(ctx, conn, identityStoreID, userID, operations); err != nil {
+			return diag.Errorf("error updating Identity Store User (%s): %s", d.Id(), err)
+		}
+	}
+
+	return resourceUserRead(ctx, d, meta)
+}
+
+func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).IdentityStoreConn
+
+	identityStoreID, userID, err := ResourceParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting Identity Store User: %s", d.Id())
+	_, err = conn.DeleteUserWithContext(ctx, &identitystore.DeleteUserInput{
+		IdentityStoreId: aws.String(identityStoreID),
+		UserId:          aws.String(userID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, identitystore.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Identity Store User (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandAddress(tfMap map[string]interface{}) *identitystore.Address {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &identitystore.Address{}
+
+	if v, ok := tfMap["country"].(string); ok && v != "" {
+		apiObject.Country = aws.String(v)
+	}
+
+	if v, ok := tfMap["formatted"].(string); ok && v != "" {
+		apiObject.Formatted = aws.String(v)
+	}
+
+	if v, ok := tfMap["locality"].(string); ok && v != "" {
+		apiObject.Locality = aws.String(v)
+	}
+
+	if v, ok := tfMap["postal_code"].(string); ok && v != "" {
+		apiObject.PostalCode = aws.String(v)
+	}
+
+	if v, ok := tfMap["primary"].(bool); ok {
+		apiObject.Primary = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["region"].(string); ok && v != "" {
+		apiObject.Region = aws.String(v)
+	}
+
+	if v, ok := tfMap["street_address"].(string); ok && v != "" {
+		apiObject.StreetAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandEmail(tfMap map[string]interface{}) *identitystore.Email {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &identitystore.Email{}
+
+	if v, ok := tfMap["primary"].(bool); ok {
+		apiObject.Primary = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandName(tfMap map[string]interface{}) *identitystore.Name {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &identitystore.Name{}
+
+	if v, ok := tfMap["family_name"].(string); ok && v != "" {
+		apiObject.FamilyName = aws.String(v)
+	}
+
+	if v, ok := tfMap["formatted"].(string); ok && v != "" {
+		apiObject.Formatted = aws.String(v)
+	}
+
+	if v, ok := tfMap["given_name"].(string); ok && v != "" {
+		apiObject.GivenName = aws.String(v)
+	}
+
+	if v, ok := tfMap["honorific_prefix"].(string); ok && v != "" {
+		apiObject.HonorificPrefix = aws.String(v)
+	}
+
+	if v, ok := tfMap["honorific_suffix"].(string); ok && v != "" {
+		apiObject.HonorificSuffix = aws.String(v)
+	}
+
+	if v, ok := tfMap["middle_name"].(string); ok && v != "" {
+		apiObject.MiddleName = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPhoneNumber(tfMap map[string]interface{}) *identitystore.PhoneNumber {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &identitystore.PhoneNumber{}
+
+	if v, ok := tfMap["primary"].(bool); ok {
+		apiObject.Primary = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["value"].(string); ok && v != "" {
+		apiObject.Value = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenAddresses(apiObjects []*identitystore.Address) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"country":        aws.StringValue(apiObject.Country),
+			"formatted":      aws.StringValue(apiObject.Formatted),
+			"locality":       aws.StringValue(apiObject.Locality),
+			"postal_code":    aws.StringValue(apiObject.PostalCode),
+			"primary":        aws.BoolValue(apiObject.Primary),
+			"region":         aws.StringValue(apiObject.Region),
+			"street_address": aws.StringValue(apiObject.StreetAddress),
+			"type":           aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenEmails(apiObjects []*identitystore.Email) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"primary": aws.BoolValue(apiObject.Primary),
+			"type":    aws.StringValue(apiObject.Type),
+			"value":   aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenName(apiObject *identitystore.Name) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return map[string]interface{}{
+		"family_name":      aws.StringValue(apiObject.FamilyName),
+		"formatted":        aws.StringValue(apiObject.Formatted),
+		"given_name":       aws.StringValue(apiObject.GivenName),
+		"honorific_prefix": aws.StringValue(apiObject.HonorificPrefix),
+		"honorific_suffix": aws.StringValue(apiObject.HonorificSuffix),
+		"middle_name":      aws.StringValue(apiObject.MiddleName),
+	}
+}
+
+func flattenPhoneNumbers(apiObjects []*identitystore.PhoneNumber) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"primary": aws.BoolValue(apiObject.Primary),
+			"type":    aws.StringValue(apiObject.Type),
+			"value":   aws.StringValue(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func addressAttributeValue(apiObject *identitystore.Address) map[string]interface{} {
+	m := map[string]interface{}{}
+
+	if v := apiObject.Country; v != nil {
+		m["Country"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Formatted; v != nil {
+		m["Formatted"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Locality; v != nil {
+		m["Locality"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.PostalCode; v != nil {
+		m["PostalCode"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Primary; v != nil {
+		m["Primary"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Region; v != nil {
+		m["Region"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.StreetAddress; v != nil {
+		m["StreetAddress"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		m["Type"] = aws.StringValue(v)
+	}
+
+	return m
+}
+
+func emailAttributeValue(apiObject *identitystore.Email) map[string]interface{} {
+	m := map[string]interface{}{}
+
+	if v := apiObject.Primary; v != nil {
+		m["Primary"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		m["Type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		m["Value"] = aws.StringValue(v)
+	}
+
+	return m
+}
+
+func phoneNumberAttributeValue(apiObject *identitystore.PhoneNumber) map[string]interface{} {
+	m := map[string]interface{}{}
+
+	if v := apiObject.Primary; v != nil {
+		m["Primary"] = aws.BoolValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		m["Type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Value; v != nil {
+		m["Value"] = aws.StringValue(v)
+	}
+
+	return m
+}
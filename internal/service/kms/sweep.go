@@ -9,13 +9,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_kms_key", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_kms_key", &resource.Sweeper{
 		Name: "aws_kms_key",
 		F:    sweepKeys,
 	})
@@ -27,46 +28,61 @@ func sweepKeys(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).KMSConn
+	input := &kms.ListKeysInput{
+		Limit: aws.Int64(1000),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListKeysPages(input, func(page *kms.ListKeysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-	err = conn.ListKeysPages(&kms.ListKeysInput{Limit: aws.Int64(1000)}, func(out *kms.ListKeysOutput, lastPage bool) bool {
-		for _, k := range out.Keys {
-			kKeyId := aws.StringValue(k.KeyId)
-			kOut, err := conn.DescribeKey(&kms.DescribeKeyInput{
+		for _, k := range page.Keys {
+			keyID := aws.StringValue(k.KeyId)
+			output, err := conn.DescribeKey(&kms.DescribeKeyInput{
 				KeyId: k.KeyId,
 			})
+
 			if err != nil {
-				log.Printf("Error: Failed to describe key %q: %s", kKeyId, err)
-				return false
+				errs = multierror.Append(errs, fmt.Errorf("error describing KMS Key (%s): %w", keyID, err))
+				continue
 			}
-			if aws.StringValue(kOut.KeyMetadata.KeyManager) == kms.KeyManagerTypeAws {
+
+			if aws.StringValue(output.KeyMetadata.KeyManager) == kms.KeyManagerTypeAws {
 				// Skip (default) keys which are managed by AWS
 				continue
 			}
-			if aws.StringValue(kOut.KeyMetadata.KeyState) == kms.KeyStatePendingDeletion {
+			if aws.StringValue(output.KeyMetadata.KeyState) == kms.KeyStatePendingDeletion {
 				// Skip keys which are already scheduled for deletion
 				continue
 			}
 
 			r := ResourceKey()
 			d := r.Data(nil)
-			d.SetId(kKeyId)
-			d.Set("key_id", kKeyId)
-			d.Set("deletion_window_in_days", "7")
-			err = r.Delete(d, client)
-			if err != nil {
-				log.Printf("Error: Failed to schedule key %q for deletion: %s", kKeyId, err)
-				return false
-			}
+			d.SetId(keyID)
+			d.Set("key_id", keyID)
+			d.Set("deletion_window_in_days", 7)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping KMS Key sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error describing KMS keys: %w", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing KMS Keys for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping KMS Keys for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping KMS Key sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
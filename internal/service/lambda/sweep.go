@@ -6,20 +6,23 @@ package lambda
 import (
 	"fmt"
 	"log"
+	"strconv"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lambda_function", &resource.Sweeper{
 		Name: "aws_lambda_function",
 		F:    sweepFunctions,
 	})
 
-	resource.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lambda_layer", &resource.Sweeper{
 		Name: "aws_lambda_layer",
 		F:    sweepLayerVersions,
 	})
@@ -30,34 +33,42 @@ func sweepFunctions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-
 	conn := client.(*conns.AWSClient).LambdaConn
+	input := &lambda.ListFunctionsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	resp, err := conn.ListFunctions(&lambda.ListFunctionsInput{})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Lambda Function sweep for %s: %s", region, err)
-			return nil
+	err = conn.ListFunctionsPages(input, func(page *lambda.ListFunctionsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, function := range page.Functions {
+			r := ResourceFunction()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(function.FunctionName))
+			d.Set("function_name", function.FunctionName)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-		return fmt.Errorf("Error retrieving Lambda functions: %s", err)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Lambda Functions for %s: %w", region, err))
 	}
 
-	if len(resp.Functions) == 0 {
-		log.Print("[DEBUG] No aws lambda functions to sweep")
-		return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Lambda Functions for %s: %w", region, err))
 	}
 
-	for _, f := range resp.Functions {
-		_, err := conn.DeleteFunction(
-			&lambda.DeleteFunctionInput{
-				FunctionName: f.FunctionName,
-			})
-		if err != nil {
-			return err
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Lambda Function sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepLayerVersions(region string) error {
@@ -65,40 +76,61 @@ func sweepLayerVersions(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-
 	conn := client.(*conns.AWSClient).LambdaConn
-	resp, err := conn.ListLayers(&lambda.ListLayersInput{})
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Lambda Layer sweep for %s: %s", region, err)
-			return nil
+	input := &lambda.ListLayersInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListLayersPages(input, func(page *lambda.ListLayersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
-		return fmt.Errorf("Error retrieving Lambda layers: %s", err)
-	}
 
-	if len(resp.Layers) == 0 {
-		log.Print("[DEBUG] No aws lambda layers to sweep")
-		return nil
-	}
+		for _, layer := range page.Layers {
+			layerName := aws.StringValue(layer.LayerName)
+			input := &lambda.ListLayerVersionsInput{
+				LayerName: layer.LayerName,
+			}
 
-	for _, l := range resp.Layers {
-		versionResp, err := conn.ListLayerVersions(&lambda.ListLayerVersionsInput{
-			LayerName: l.LayerName,
-		})
-		if err != nil {
-			return fmt.Errorf("Error retrieving versions for lambda layer: %s", err)
-		}
+			err := conn.ListLayerVersionsPages(input, func(page *lambda.ListLayerVersionsOutput, lastPage bool) bool {
+				if page == nil {
+					return !lastPage
+				}
 
-		for _, v := range versionResp.LayerVersions {
-			_, err := conn.DeleteLayerVersion(&lambda.DeleteLayerVersionInput{
-				LayerName:     l.LayerName,
-				VersionNumber: v.Version,
+				for _, layerVersion := range page.LayerVersions {
+					r := ResourceLayerVersion()
+					d := r.Data(nil)
+					d.SetId(aws.StringValue(layerVersion.LayerVersionArn))
+					d.Set("arn", layerVersion.LayerVersionArn)
+					d.Set("layer_name", layerName)
+					d.Set("version", strconv.FormatInt(aws.Int64Value(layerVersion.Version), 10))
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+				}
+
+				return !lastPage
 			})
+
 			if err != nil {
-				return err
+				errs = multierror.Append(errs, fmt.Errorf("error listing Lambda Layer (%s) Versions for %s: %w", layerName, region, err))
 			}
 		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Lambda Layers for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Lambda Layer Versions for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Lambda Layer sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
@@ -9,13 +9,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_licensemanager_license_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_licensemanager_license_configuration", &resource.Sweeper{
 		Name: "aws_licensemanager_license_configuration",
 		F:    sweepLicenseConfigurations,
 	})
@@ -27,37 +28,42 @@ func sweepLicenseConfigurations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).LicenseManagerConn
+	input := &licensemanager.ListLicenseConfigurationsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	resp, err := conn.ListLicenseConfigurations(&licensemanager.ListLicenseConfigurationsInput{})
+	for {
+		output, err := conn.ListLicenseConfigurations(input)
 
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping License Manager License Configuration sweep for %s: %s", region, err)
-			return nil
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing License Manager License Configurations for %s: %w", region, err))
+			break
 		}
-		return fmt.Errorf("Error retrieving License Manager license configurations: %s", err)
-	}
 
-	if len(resp.LicenseConfigurations) == 0 {
-		log.Print("[DEBUG] No License Manager license configurations to sweep")
-		return nil
-	}
-
-	for _, lc := range resp.LicenseConfigurations {
-		id := aws.StringValue(lc.LicenseConfigurationArn)
+		for _, lc := range output.LicenseConfigurations {
+			r := ResourceLicenseConfiguration()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(lc.LicenseConfigurationArn))
+			d.Set("name", lc.Name)
 
-		log.Printf("[INFO] Deleting License Manager license configuration: %s", id)
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
 
-		opts := &licensemanager.DeleteLicenseConfigurationInput{
-			LicenseConfigurationArn: aws.String(id),
+		if aws.StringValue(output.NextToken) == "" {
+			break
 		}
 
-		_, err := conn.DeleteLicenseConfiguration(opts)
+		input.NextToken = output.NextToken
+	}
 
-		if err != nil {
-			log.Printf("[ERROR] Error deleting License Manager license configuration (%s): %s", id, err)
-		}
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping License Manager License Configurations for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping License Manager License Configuration sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
@@ -16,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_instance", &resource.Sweeper{
 		Name: "aws_lightsail_instance",
 		F:    sweepInstances,
 	})
 
-	resource.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_lightsail_static_ip", &resource.Sweeper{
 		Name: "aws_lightsail_static_ip",
 		F:    sweepStaticIPs,
 	})
@@ -33,36 +33,25 @@ func sweepInstances(region string) error {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).LightsailConn
-
 	input := &lightsail.GetInstancesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.GetInstances(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Lightsail Instance sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving Lightsail Instances: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing Lightsail Instances for %s: %w", region, err))
+			break
 		}
 
 		for _, instance := range output.Instances {
-			name := aws.StringValue(instance.Name)
-			input := &lightsail.DeleteInstanceInput{
-				InstanceName: instance.Name,
-			}
-
-			log.Printf("[INFO] Deleting Lightsail Instance: %s", name)
-			_, err := conn.DeleteInstance(input)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Lightsail Instance (%s): %s", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+			r := ResourceInstance()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(instance.Name))
+			d.Set("name", instance.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextPageToken) == "" {
@@ -72,7 +61,16 @@ func sweepInstances(region string) error {
 		input.PageToken = output.NextPageToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Lightsail Instances for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Lightsail Instance sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepStaticIPs(region string) error {
@@ -81,41 +79,42 @@ func sweepStaticIPs(region string) error {
 		return fmt.Errorf("Error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).LightsailConn
-
 	input := &lightsail.GetStaticIpsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.GetStaticIps(input)
-		if err != nil {
-			if sweep.SkipSweepError(err) {
-				log.Printf("[WARN] Skipping Lightsail Static IP sweep for %s: %s", region, err)
-				return nil
-			}
-			return fmt.Errorf("Error retrieving Lightsail Static IPs: %s", err)
-		}
 
-		if len(output.StaticIps) == 0 {
-			log.Print("[DEBUG] No Lightsail Static IPs to sweep")
-			return nil
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Lightsail Static IPs for %s: %w", region, err))
+			break
 		}
 
-		for _, staticIp := range output.StaticIps {
-			name := aws.StringValue(staticIp.Name)
+		for _, staticIP := range output.StaticIps {
+			r := ResourceStaticIP()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(staticIP.Name))
+			d.Set("name", staticIP.Name)
 
-			log.Printf("[INFO] Deleting Lightsail Static IP %s", name)
-			_, err := conn.ReleaseStaticIp(&lightsail.ReleaseStaticIpInput{
-				StaticIpName: aws.String(name),
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Lightsail Static IP %s: %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
-		if output.NextPageToken == nil {
+		if aws.StringValue(output.NextPageToken) == "" {
 			break
 		}
+
 		input.PageToken = output.NextPageToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Lightsail Static IPs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Lightsail Static IP sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
@@ -0,0 +1,110 @@
+package meta
+
+import (
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceARNBuild() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceARNBuildRead,
+
+		Schema: map[string]*schema.Schema{
+			"account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"partition": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"qualifier": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"resource"},
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidRegionName,
+			},
+			"resource": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"resource", "resource_name"},
+			},
+			"resource_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"resource", "resource_name"},
+			},
+			"resource_path": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"resource"},
+			},
+			"resource_type": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"resource"},
+			},
+			"service": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func dataSourceARNBuildRead(d *schema.ResourceData, meta interface{}) error {
+	service := d.Get("service").(string)
+	region := d.Get("region").(string)
+
+	// The partition defaults to that of the ARN's region, falling back to the provider's partition
+	// for global resources and regions unknown to the AWS SDK.
+	partition := meta.(*conns.AWSClient).Partition
+
+	if v, ok := d.GetOk("partition"); ok {
+		partition = v.(string)
+	} else if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok && region != "" {
+		partition = p.ID()
+	}
+
+	resource := d.Get("resource").(string)
+
+	if v, ok := d.GetOk("resource_name"); ok {
+		resource = buildARNResource(service, arnResource{
+			Type:      d.Get("resource_type").(string),
+			Path:      d.Get("resource_path").(string),
+			Name:      v.(string),
+			Qualifier: d.Get("qualifier").(string),
+		})
+	}
+
+	arn := arn.ARN{
+		Partition: partition,
+		Service:   service,
+		Region:    region,
+		AccountID: d.Get("account").(string),
+		Resource:  resource,
+	}.String()
+
+	d.SetId(arn)
+	d.Set("arn", arn)
+	d.Set("partition", partition)
+	d.Set("resource", resource)
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+)
+
+func TestAccMetaARNBuildDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_arn_build.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNBuildDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGlobalARNNoAccount(dataSourceName, "arn", "iam", "role/path/to/name"),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", acctest.Partition()),
+					resource.TestCheckResourceAttr(dataSourceName, "resource", "role/path/to/name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaARNBuildDataSource_region(t *testing.T) {
+	dataSourceName := "data.aws_arn_build.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNBuildDataSourceRegionConfig("cn-north-1"), //lintignore:AWSAT003
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "arn", "arn:aws-cn:lambda:cn-north-1:123456789012:function:name:live"), //lintignore:AWSAT003,AWSAT005
+					resource.TestCheckResourceAttr(dataSourceName, "partition", "aws-cn"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource", "function:name:live"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaARNBuildDataSource_resource(t *testing.T) {
+	dataSourceName := "data.aws_arn_build.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNBuildDataSourceResourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrRegionalARN(dataSourceName, "arn", "dynamodb", "table/name/stream/label"),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", acctest.Partition()),
+				),
+			},
+		},
+	})
+}
+
+const testAccARNBuildDataSourceConfig = `
+data "aws_arn_build" "test" {
+  service       = "iam"
+  resource_type = "role"
+  resource_path = "/path/to/"
+  resource_name = "name"
+}
+`
+
+func testAccARNBuildDataSourceRegionConfig(region string) string {
+	return fmt.Sprintf(`
+data "aws_arn_build" "test" {
+  service       = "lambda"
+  region        = %[1]q
+  account       = "123456789012"
+  resource_type = "function"
+  resource_name = "name"
+  qualifier     = "live"
+}
+`, region)
+}
+
+const testAccARNBuildDataSourceResourceConfig = `
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_arn_build" "test" {
+  service  = "dynamodb"
+  region   = data.aws_region.current.name
+  account  = data.aws_caller_identity.current.account_id
+  resource = "table/name/stream/label"
+}
+`
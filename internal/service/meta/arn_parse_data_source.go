@@ -0,0 +1,83 @@
+package meta
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceARNParse() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceARNParseRead,
+
+		Schema: map[string]*schema.Schema{
+			"account": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"partition": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_path": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"service": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceARNParseRead(d *schema.ResourceData, meta interface{}) error {
+	v := d.Get("arn").(string)
+	arn, err := arn.Parse(v)
+
+	if err != nil {
+		return fmt.Errorf("error parsing ARN (%s): %w", v, err)
+	}
+
+	resource := parseARNResource(arn.Service, arn.Resource)
+
+	d.SetId(arn.String())
+	d.Set("account", arn.AccountID)
+	d.Set("partition", arn.Partition)
+	d.Set("qualifier", resource.Qualifier)
+	d.Set("region", arn.Region)
+	d.Set("resource", arn.Resource)
+	d.Set("resource_name", resource.Name)
+	d.Set("resource_path", resource.Path)
+	d.Set("resource_type", resource.Type)
+	d.Set("service", arn.Service)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+)
+
+func TestAccMetaARNParseDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_arn_parse.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNParseDataSourceConfig("arn:aws-cn:iam::123456789012:role/path/to/name"), //lintignore:AWSAT005
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "account", "123456789012"),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", "aws-cn"),
+					resource.TestCheckResourceAttr(dataSourceName, "qualifier", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "region", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "resource", "role/path/to/name"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_name", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_path", "/path/to/"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_type", "role"),
+					resource.TestCheckResourceAttr(dataSourceName, "service", "iam"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaARNParseDataSource_qualifier(t *testing.T) {
+	dataSourceName := "data.aws_arn_parse.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccARNParseDataSourceConfig("arn:aws-us-gov:lambda:us-gov-west-1:123456789012:function:name:live"), //lintignore:AWSAT003,AWSAT005
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "partition", "aws-us-gov"),
+					resource.TestCheckResourceAttr(dataSourceName, "qualifier", "live"),
+					resource.TestCheckResourceAttr(dataSourceName, "region", "us-gov-west-1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_name", "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_path", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_type", "function"),
+					resource.TestCheckResourceAttr(dataSourceName, "service", "lambda"),
+				),
+			},
+		},
+	})
+}
+
+func testAccARNParseDataSourceConfig(arn string) string {
+	return fmt.Sprintf(`
+data "aws_arn_parse" "test" {
+  arn = %[1]q
+}
+`, arn)
+}
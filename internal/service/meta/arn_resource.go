@@ -0,0 +1,139 @@
+package meta
+
+import (
+	"strings"
+)
+
+// arnResource is the service-specific decomposition of the resource part of an ARN.
+type arnResource struct {
+	Type      string
+	Path      string
+	Name      string
+	Qualifier string
+}
+
+// arnServicesWithoutResourceType are the services whose ARN resource part starts with the resource name,
+// e.g. arn:aws:sns:us-east-1:123456789012:topic-name:subscription-id.
+var arnServicesWithoutResourceType = map[string]bool{
+	"s3":  true,
+	"sns": true,
+	"sqs": true,
+}
+
+// arnResourceTypesWithPath are the resource types, by service, whose names can be preceded by a path,
+// e.g. arn:aws:iam::123456789012:role/path/to/name.
+var arnResourceTypesWithPath = map[string]map[string]bool{
+	"iam": {
+		"group":              true,
+		"instance-profile":   true,
+		"policy":             true,
+		"role":               true,
+		"server-certificate": true,
+		"user":               true,
+	},
+}
+
+// arnServicesWithColonSeparator are the services whose ARN resource parts are separated by colons
+// rather than slashes, e.g. arn:aws:lambda:us-east-1:123456789012:function:name:qualifier.
+var arnServicesWithColonSeparator = map[string]bool{
+	"codedeploy":     true,
+	"elasticache":    true,
+	"lambda":         true,
+	"logs":           true,
+	"rds":            true,
+	"redshift":       true,
+	"secretsmanager": true,
+	"sns":            true,
+	"sqs":            true,
+	"states":         true,
+}
+
+// arnResourceSeparator returns the separator used between the parts of the specified service's ARN resources.
+func arnResourceSeparator(service string) string {
+	if arnServicesWithColonSeparator[service] {
+		return ":"
+	}
+
+	return "/"
+}
+
+// parseARNResource splits the resource part of an ARN for the specified service into its components.
+// The separator is the first of '/' or ':' that appears in the resource.
+func parseARNResource(service, resource string) arnResource {
+	sep := ""
+
+	if i := strings.IndexAny(resource, "/:"); i >= 0 {
+		sep = resource[i : i+1]
+	}
+
+	if sep == "" {
+		return arnResource{Name: resource}
+	}
+
+	var result arnResource
+	parts := strings.Split(resource, sep)
+
+	if !arnServicesWithoutResourceType[service] || (service == "s3" && s3ARNResourceHasType(resource)) {
+		result.Type, parts = parts[0], parts[1:]
+	}
+
+	if arnResourceTypesWithPath[service][result.Type] && sep == "/" {
+		last := len(parts) - 1
+
+		if last > 0 {
+			result.Path = "/" + strings.Join(parts[:last], "/") + "/"
+		} else {
+			result.Path = "/"
+		}
+
+		result.Name = parts[last]
+
+		return result
+	}
+
+	result.Name = parts[0]
+	result.Qualifier = strings.Join(parts[1:], sep)
+
+	return result
+}
+
+// buildARNResource is the inverse of parseARNResource.
+func buildARNResource(service string, resource arnResource) string {
+	sep := arnResourceSeparator(service)
+
+	var b strings.Builder
+
+	if resource.Type != "" {
+		b.WriteString(resource.Type)
+		b.WriteString(sep)
+	}
+
+	if resource.Path != "" {
+		b.WriteString(strings.TrimPrefix(resource.Path, "/"))
+
+		if !strings.HasSuffix(resource.Path, "/") {
+			b.WriteString("/")
+		}
+	}
+
+	b.WriteString(resource.Name)
+
+	if resource.Qualifier != "" {
+		b.WriteString(sep)
+		b.WriteString(resource.Qualifier)
+	}
+
+	return b.String()
+}
+
+// s3ARNResourceHasType returns whether an S3 ARN resource refers to something other than a bucket or object,
+// e.g. arn:aws:s3:us-west-2:123456789012:accesspoint/name.
+func s3ARNResourceHasType(resource string) bool {
+	for _, prefix := range []string{"accesspoint/", "job/", "storage-lens/"} {
+		if strings.HasPrefix(resource, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,95 @@
+package meta
+
+import (
+	"testing"
+)
+
+func TestParseARNResource(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Service  string
+		Resource string
+		Expected arnResource
+	}{
+		{
+			TestName: "name only",
+			Service:  "iam",
+			Resource: "root",
+			Expected: arnResource{Name: "root"},
+		},
+		{
+			TestName: "slash separated",
+			Service:  "ec2",
+			Resource: "instance/i-12345678",
+			Expected: arnResource{Type: "instance", Name: "i-12345678"},
+		},
+		{
+			TestName: "IAM role without path",
+			Service:  "iam",
+			Resource: "role/name",
+			Expected: arnResource{Type: "role", Path: "/", Name: "name"},
+		},
+		{
+			TestName: "IAM role with path",
+			Service:  "iam",
+			Resource: "role/path/to/name",
+			Expected: arnResource{Type: "role", Path: "/path/to/", Name: "name"},
+		},
+		{
+			TestName: "DynamoDB table stream",
+			Service:  "dynamodb",
+			Resource: "table/name/stream/2021-01-01T00:00:00.000",
+			Expected: arnResource{Type: "table", Name: "name", Qualifier: "stream/2021-01-01T00:00:00.000"},
+		},
+		{
+			TestName: "Lambda function",
+			Service:  "lambda",
+			Resource: "function:name",
+			Expected: arnResource{Type: "function", Name: "name"},
+		},
+		{
+			TestName: "Lambda function with qualifier",
+			Service:  "lambda",
+			Resource: "function:name:live",
+			Expected: arnResource{Type: "function", Name: "name", Qualifier: "live"},
+		},
+		{
+			TestName: "S3 bucket",
+			Service:  "s3",
+			Resource: "bucket",
+			Expected: arnResource{Name: "bucket"},
+		},
+		{
+			TestName: "S3 object",
+			Service:  "s3",
+			Resource: "bucket/path/to/key",
+			Expected: arnResource{Name: "bucket", Qualifier: "path/to/key"},
+		},
+		{
+			TestName: "S3 access point",
+			Service:  "s3",
+			Resource: "accesspoint/name",
+			Expected: arnResource{Type: "accesspoint", Name: "name"},
+		},
+		{
+			TestName: "SNS subscription",
+			Service:  "sns",
+			Resource: "topic:12345678-1234-1234-1234-123456789012",
+			Expected: arnResource{Name: "topic", Qualifier: "12345678-1234-1234-1234-123456789012"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := parseARNResource(testCase.Service, testCase.Resource)
+
+			if got != testCase.Expected {
+				t.Errorf("got %+v, expected %+v", got, testCase.Expected)
+			}
+
+			if got, expected := buildARNResource(testCase.Service, got), testCase.Resource; got != expected {
+				t.Errorf("got %s, expected %s", got, expected)
+			}
+		})
+	}
+}
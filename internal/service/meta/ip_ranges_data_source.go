@@ -5,14 +5,34 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/http"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
 )
 
+const (
+	ipRangesSourceIPRanges              = "ip-ranges"
+	ipRangesSourceEC2ManagedPrefixLists = "ec2-managed-prefix-lists"
+)
+
+func ipRangesSource_Values() []string {
+	return []string{
+		ipRangesSourceIPRanges,
+		ipRangesSourceEC2ManagedPrefixLists,
+	}
+}
+
 type dataSourceAwsIPRangesResult struct {
 	CreateDate   string
 	Prefixes     []dataSourceAwsIPRangesPrefix
@@ -32,11 +52,24 @@ type dataSourceAwsIPRangesIpv6Prefix struct {
 	Service    string
 }
 
+// ipRangesCache holds the IP ranges already read from each URL so that the
+// file is only downloaded once per provider process.
+var ipRangesCache = struct {
+	sync.Mutex
+	results map[string]*dataSourceAwsIPRangesResult
+}{
+	results: make(map[string]*dataSourceAwsIPRangesResult),
+}
+
 func DataSourceIPRanges() *schema.Resource {
 	return &schema.Resource{
 		Read: dataSourceIPRangesRead,
 
 		Schema: map[string]*schema.Schema{
+			"cache_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"cidr_blocks": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -61,6 +94,12 @@ func DataSourceIPRanges() *schema.Resource {
 				Required: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"source": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      ipRangesSourceIPRanges,
+				ValidateFunc: validation.StringInSlice(ipRangesSource_Values(), false),
+			},
 			"sync_token": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -75,48 +114,52 @@ func DataSourceIPRanges() *schema.Resource {
 }
 
 func dataSourceIPRangesRead(d *schema.ResourceData, meta interface{}) error {
+	var (
+		result *dataSourceAwsIPRangesResult
+		source string
+		err    error
+	)
 
-	conn := cleanhttp.DefaultClient()
-	url := d.Get("url").(string)
+	switch d.Get("source").(string) {
+	case ipRangesSourceEC2ManagedPrefixLists:
+		conn := meta.(*conns.AWSClient).EC2Conn
+		region := meta.(*conns.AWSClient).Region
+		source = fmt.Sprintf("EC2 managed prefix lists in %s", region)
 
-	log.Printf("[DEBUG] Reading IP ranges from %s", url)
+		result, err = readManagedPrefixListIPRanges(conn)
+
+		if err != nil {
+			return fmt.Errorf("Error listing IP ranges from (%s): %w", source, err)
+		}
 
-	res, err := conn.Get(url)
+		d.SetId(region)
+		d.Set("sync_token", 0)
+	default:
+		source = d.Get("url").(string)
 
-	if err != nil {
-		return fmt.Errorf("Error listing IP ranges from (%s): %w", url, err)
-	}
+		result, err = readCachedIPRanges(source, d.Get("cache_file").(string))
 
-	defer res.Body.Close()
+		if err != nil {
+			return err
+		}
 
-	data, err := io.ReadAll(res.Body)
+		syncToken, err := strconv.Atoi(result.SyncToken)
 
-	if err != nil {
-		return fmt.Errorf("Error reading response body from (%s): %w", url, err)
-	}
+		if err != nil {
+			return fmt.Errorf("Error while converting sync token: %w", err)
+		}
 
-	result := new(dataSourceAwsIPRangesResult)
+		d.SetId(result.SyncToken)
 
-	if err := json.Unmarshal(data, result); err != nil {
-		return fmt.Errorf("Error parsing result from (%s): %w", url, err)
+		if err := d.Set("sync_token", syncToken); err != nil {
+			return fmt.Errorf("Error setting sync token: %w", err)
+		}
 	}
 
 	if err := d.Set("create_date", result.CreateDate); err != nil {
 		return fmt.Errorf("Error setting create date: %w", err)
 	}
 
-	syncToken, err := strconv.Atoi(result.SyncToken)
-
-	if err != nil {
-		return fmt.Errorf("Error while converting sync token: %w", err)
-	}
-
-	d.SetId(result.SyncToken)
-
-	if err := d.Set("sync_token", syncToken); err != nil {
-		return fmt.Errorf("Error setting sync token: %w", err)
-	}
-
 	get := func(key string) *schema.Set {
 
 		set := d.Get(key).(*schema.Set)
@@ -161,7 +204,7 @@ func dataSourceIPRangesRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if len(ipPrefixes) == 0 && len(ipv6Prefixes) == 0 {
-		return fmt.Errorf("No IP ranges result from filters from (%s)", url)
+		return fmt.Errorf("No IP ranges result from filters from (%s)", source)
 	}
 
 	sort.Strings(ipPrefixes)
@@ -179,3 +222,193 @@ func dataSourceIPRangesRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 
 }
+
+// readCachedIPRanges returns the IP ranges published at the specified URL.
+// If a cache file is specified, the newer of the downloaded and cached IP ranges
+// (by sync token) is returned and the cache file is refreshed as necessary.
+// The cached IP ranges are also used if the URL cannot be read.
+func readCachedIPRanges(url, cacheFile string) (*dataSourceAwsIPRangesResult, error) {
+	ipRangesCache.Lock()
+	defer ipRangesCache.Unlock()
+
+	if result, ok := ipRangesCache.results[url]; ok {
+		log.Printf("[DEBUG] Using previously read IP ranges from %s", url)
+		return result, nil
+	}
+
+	log.Printf("[DEBUG] Reading IP ranges from %s", url)
+
+	data, err := readIPRangesURL(url)
+
+	var result *dataSourceAwsIPRangesResult
+
+	if err == nil {
+		result, err = parseIPRanges(data)
+
+		if err != nil {
+			err = fmt.Errorf("Error parsing result from (%s): %w", url, err)
+		}
+	}
+
+	if cacheFile != "" {
+		cached, cacheErr := readIPRangesFile(cacheFile)
+
+		switch {
+		case err != nil && cacheErr != nil:
+			return nil, err
+		case err != nil:
+			log.Printf("[WARN] %s, using cached IP ranges from %s", err, cacheFile)
+			result, err = cached, nil
+		case cacheErr == nil && ipRangesSyncToken(cached) >= ipRangesSyncToken(result):
+			log.Printf("[DEBUG] Cached IP ranges in %s are up to date (sync token %s)", cacheFile, cached.SyncToken)
+			result = cached
+		default:
+			log.Printf("[DEBUG] Writing IP ranges (sync token %s) to %s", result.SyncToken, cacheFile)
+
+			if err := os.WriteFile(cacheFile, data, 0644); err != nil {
+				return nil, fmt.Errorf("Error writing IP ranges to (%s): %w", cacheFile, err)
+			}
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	ipRangesCache.results[url] = result
+
+	return result, nil
+}
+
+// readIPRangesURL reads the contents of an IP ranges file.
+// URLs with the file scheme are read from the local filesystem.
+func readIPRangesURL(url string) ([]byte, error) {
+	if path := strings.TrimPrefix(url, "file://"); path != url {
+		data, err := os.ReadFile(path)
+
+		if err != nil {
+			return nil, fmt.Errorf("Error reading IP ranges from (%s): %w", url, err)
+		}
+
+		return data, nil
+	}
+
+	res, err := cleanhttp.DefaultClient().Get(url)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error listing IP ranges from (%s): %w", url, err)
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Error listing IP ranges from (%s): unexpected HTTP status: %s", url, res.Status)
+	}
+
+	data, err := io.ReadAll(res.Body)
+
+	if err != nil {
+		return nil, fmt.Errorf("Error reading response body from (%s): %w", url, err)
+	}
+
+	return data, nil
+}
+
+func readIPRangesFile(path string) (*dataSourceAwsIPRangesResult, error) {
+	data, err := os.ReadFile(path)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return parseIPRanges(data)
+}
+
+func parseIPRanges(data []byte) (*dataSourceAwsIPRangesResult, error) {
+	result := new(dataSourceAwsIPRangesResult)
+
+	if err := json.Unmarshal(data, result); err != nil {
+		return nil, err
+	}
+
+	if _, err := strconv.Atoi(result.SyncToken); err != nil {
+		return nil, fmt.Errorf("invalid sync token (%s): %w", result.SyncToken, err)
+	}
+
+	return result, nil
+}
+
+func ipRangesSyncToken(result *dataSourceAwsIPRangesResult) int {
+	v, _ := strconv.Atoi(result.SyncToken)
+
+	return v
+}
+
+// readManagedPrefixListIPRanges returns the IP ranges in the AWS-managed prefix lists
+// in the current region. Prefix list names have the form com.amazonaws.<region>.<service>.
+func readManagedPrefixListIPRanges(conn *ec2.EC2) (*dataSourceAwsIPRangesResult, error) {
+	input := &ec2.DescribeManagedPrefixListsInput{
+		Filters: tfec2.BuildAttributeFilterList(map[string]string{
+			"owner-id": "AWS",
+		}),
+	}
+
+	prefixLists, err := tfec2.FindManagedPrefixLists(conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(dataSourceAwsIPRangesResult)
+
+	for _, prefixList := range prefixLists {
+		region, service, ok := managedPrefixListRegionAndService(aws.StringValue(prefixList.PrefixListName))
+
+		if !ok {
+			continue
+		}
+
+		id := aws.StringValue(prefixList.PrefixListId)
+		entries, err := tfec2.FindManagedPrefixListEntriesByID(conn, id)
+
+		if err != nil {
+			return nil, fmt.Errorf("error reading EC2 Managed Prefix List (%s) entries: %w", id, err)
+		}
+
+		for _, entry := range entries {
+			cidr := aws.StringValue(entry.Cidr)
+
+			if aws.StringValue(prefixList.AddressFamily) == "IPv6" {
+				result.Ipv6Prefixes = append(result.Ipv6Prefixes, dataSourceAwsIPRangesIpv6Prefix{
+					Ipv6Prefix: cidr,
+					Region:     region,
+					Service:    service,
+				})
+			} else {
+				result.Prefixes = append(result.Prefixes, dataSourceAwsIPRangesPrefix{
+					IpPrefix: cidr,
+					Region:   region,
+					Service:  service,
+				})
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func managedPrefixListRegionAndService(name string) (string, string, bool) {
+	const prefix = "com.amazonaws."
+
+	if !strings.HasPrefix(name, prefix) {
+		return "", "", false
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(name, prefix), ".", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+
+	return parts[0], parts[1], true
+}
@@ -3,6 +3,8 @@ package meta_test
 import (
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"regexp"
 	"sort"
 	"strconv"
@@ -51,6 +53,94 @@ func TestAccMetaIPRangesDataSource_url(t *testing.T) {
 	})
 }
 
+func TestAccMetaIPRangesDataSource_fileURL(t *testing.T) {
+	dataSourceName := "data.aws_ip_ranges.some"
+	path := filepath.Join(t.TempDir(), "ip-ranges.json")
+
+	if err := os.WriteFile(path, []byte(testAccIPRangesSnapshot("1470267965", "2016-08-03-23-46-05")), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPRangesFileURLConfig("file://"+path, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.0", "52.95.255.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "create_date", "2016-08-03-23-46-05"),
+					resource.TestCheckResourceAttr(dataSourceName, "ipv6_cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "ipv6_cidr_blocks.0", "2a05:d07a:a000::/40"),
+					resource.TestCheckResourceAttr(dataSourceName, "sync_token", "1470267965"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaIPRangesDataSource_cacheFile(t *testing.T) {
+	dataSourceName := "data.aws_ip_ranges.some"
+	dir := t.TempDir()
+	cacheFile := filepath.Join(dir, "cache.json")
+	path := filepath.Join(dir, "ip-ranges.json")
+
+	if err := os.WriteFile(cacheFile, []byte(testAccIPRangesSnapshot("1470267965", "2016-08-03-23-46-05")), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(testAccIPRangesSnapshot("1470354365", "2016-08-04-23-46-05")), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				// The URL cannot be read, so the cached IP ranges are used.
+				Config: testAccIPRangesFileURLConfig("file://"+filepath.Join(dir, "missing.json"), cacheFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "sync_token", "1470267965"),
+				),
+			},
+			{
+				// The URL has newer IP ranges, so the cache file is refreshed.
+				Config: testAccIPRangesFileURLConfig("file://"+path, cacheFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cidr_blocks.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "sync_token", "1470354365"),
+					testAccIPRangesCheckCacheFile(cacheFile, "1470354365"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaIPRangesDataSource_ec2ManagedPrefixLists(t *testing.T) {
+	dataSourceName := "data.aws_ip_ranges.some"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPRangesEC2ManagedPrefixListsConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccIPRangesCheckCIDRBlocksAttribute(dataSourceName, "cidr_blocks"),
+					resource.TestCheckResourceAttr(dataSourceName, "create_date", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "sync_token", "0"),
+				),
+			},
+		},
+	})
+}
+
 func testAccIPRangesCheckAttributes(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -173,3 +263,67 @@ data "aws_ip_ranges" "some" {
   url      = "https://ip-ranges.amazonaws.com/ip-ranges.json"
 }
 `
+
+func testAccIPRangesCheckCacheFile(path, syncToken string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		data, err := os.ReadFile(path)
+
+		if err != nil {
+			return err
+		}
+
+		if want := fmt.Sprintf(`"syncToken": %q`, syncToken); !regexp.MustCompile(regexp.QuoteMeta(want)).Match(data) {
+			return fmt.Errorf("cache file %s does not contain %s", path, want)
+		}
+
+		return nil
+	}
+}
+
+// lintignore:AWSAT003
+func testAccIPRangesSnapshot(syncToken, createDate string) string {
+	return fmt.Sprintf(`{
+  "syncToken": %[1]q,
+  "createDate": %[2]q,
+  "prefixes": [
+    {
+      "ip_prefix": "52.95.255.0/24",
+      "region": "eu-west-1",
+      "service": "EC2"
+    },
+    {
+      "ip_prefix": "54.239.0.0/28",
+      "region": "us-east-1",
+      "service": "EC2"
+    }
+  ],
+  "ipv6_prefixes": [
+    {
+      "ipv6_prefix": "2a05:d07a:a000::/40",
+      "region": "eu-west-1",
+      "service": "EC2"
+    }
+  ]
+}
+`, syncToken, createDate)
+}
+
+// lintignore:AWSAT003
+func testAccIPRangesFileURLConfig(url, cacheFile string) string {
+	return fmt.Sprintf(`
+data "aws_ip_ranges" "some" {
+  regions    = ["eu-west-1"]
+  services   = ["ec2"]
+  url        = %[1]q
+  cache_file = %[2]q
+}
+`, url, cacheFile)
+}
+
+const testAccIPRangesEC2ManagedPrefixListsConfig = `
+data "aws_ip_ranges" "some" {
+  regions  = ["global"]
+  services = ["cloudfront.origin-facing"]
+  source   = "ec2-managed-prefix-lists"
+}
+`
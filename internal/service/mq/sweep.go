@@ -6,19 +6,19 @@ package mq
 import (
 	"fmt"
 	"log"
-	"math/rand"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mq"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mq_broker", &resource.Sweeper{
 		Name: "aws_mq_broker",
 		F:    sweepBrokers,
 	})
@@ -30,52 +30,67 @@ func sweepBrokers(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).MQConn
-
-	resp, err := conn.ListBrokers(&mq.ListBrokersInput{
+	input := &mq.ListBrokersInput{
 		MaxResults: aws.Int64(100),
+	}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.ListBrokersPages(input, func(page *mq.ListBrokersResponse, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, bs := range page.BrokerSummaries {
+			r := resourceBrokerWaitingForCreation()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(bs.BrokerId))
+			d.Set("broker_name", bs.BrokerName)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping MQ Broker sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error listing MQ brokers: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing MQ Brokers for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MQ Brokers for %s: %w", region, err))
 	}
 
-	if len(resp.BrokerSummaries) == 0 {
-		log.Print("[DEBUG] No MQ brokers found to sweep")
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MQ Broker sweep for %s: %s", region, errs)
 		return nil
 	}
-	log.Printf("[DEBUG] %d MQ brokers found", len(resp.BrokerSummaries))
 
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(resp.BrokerSummaries), func(i, j int) {
-		resp.BrokerSummaries[i], resp.BrokerSummaries[j] = resp.BrokerSummaries[j], resp.BrokerSummaries[i]
-	})
+	return errs.ErrorOrNil()
+}
 
-	for _, bs := range resp.BrokerSummaries {
-		log.Printf("[INFO] Deleting MQ broker %s", aws.StringValue(bs.BrokerId))
-		_, err := conn.DeleteBroker(&mq.DeleteBrokerInput{
-			BrokerId: bs.BrokerId,
-		})
-		if tfawserr.ErrMessageContains(err, mq.ErrCodeBadRequestException, "while in state [CREATION_IN_PROGRESS") {
-			log.Printf("[WARN] Broker in state CREATION_IN_PROGRESS and must complete creation before deletion")
-			if _, err = WaitBrokerCreated(conn, aws.StringValue(bs.BrokerId)); err != nil {
-				return err
-			}
-
-			log.Printf("[WARN] Retrying deletion of broker %s", aws.StringValue(bs.BrokerId))
-			_, err = conn.DeleteBroker(&mq.DeleteBrokerInput{
-				BrokerId: bs.BrokerId,
-			})
-		}
-		if err != nil {
-			return err
-		}
-		if _, err = WaitBrokerDeleted(conn, aws.StringValue(bs.BrokerId)); err != nil {
-			return err
+// resourceBrokerWaitingForCreation is an MQ Broker resource which waits for
+// brokers still being created to finish creation before deleting them.
+func resourceBrokerWaitingForCreation() *schema.Resource {
+	r := ResourceBroker()
+	r.Delete = resourceBrokerWaitingForCreationDelete
+
+	return r
+}
+
+func resourceBrokerWaitingForCreationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MQConn
+
+	err := resourceBrokerDelete(d, meta)
+
+	if tfawserr.ErrMessageContains(err, mq.ErrCodeBadRequestException, "while in state [CREATION_IN_PROGRESS") {
+		log.Printf("[WARN] MQ Broker (%s) in state CREATION_IN_PROGRESS and must complete creation before deletion", d.Id())
+		if _, err := WaitBrokerCreated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MQ Broker (%s) creation: %w", d.Id(), err)
 		}
+
+		return resourceBrokerDelete(d, meta)
 	}
 
-	return nil
+	return err
 }
@@ -10,13 +10,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/mwaa"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_mwaa_environment", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_mwaa_environment", &resource.Sweeper{
 		Name: "aws_mwaa_environment",
 		F:    sweepEnvironment,
 	})
@@ -28,25 +29,44 @@ func sweepEnvironment(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).MWAAConn
+	input := &mwaa.ListEnvironmentsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	listOutput, err := conn.ListEnvironments(&mwaa.ListEnvironmentsInput{})
-	if err != nil {
-		if sweep.SkipSweepError(err) || tfawserr.ErrMessageContains(err, "InternalFailure", "") {
-			log.Printf("[WARN] Skipping MWAA Environment sweep for %s: %s", region, err)
-			return nil
+	err = conn.ListEnvironmentsPages(input, func(page *mwaa.ListEnvironmentsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
-		return fmt.Errorf("Error retrieving MWAA Environment: %s", err)
-	}
-	for _, environment := range listOutput.Environments {
-		name := aws.StringValue(environment)
-		r := ResourceEnvironment()
-		d := r.Data(nil)
-		d.SetId(name)
-
-		err := r.Delete(d, client)
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete MWAA Environment %s: %s", name, err)
+
+		for _, environment := range page.Environments {
+			r := ResourceEnvironment()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(environment))
+			d.Set("name", environment)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrMessageContains(err, "InternalFailure", "") {
+		log.Printf("[WARN] Skipping MWAA Environment sweep for %s: %s", region, err)
+		return nil
 	}
-	return nil
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing MWAA Environments for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping MWAA Environments for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping MWAA Environment sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
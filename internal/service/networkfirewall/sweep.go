@@ -11,14 +11,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/networkfirewall"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_networkfirewall_firewall_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall_policy", &resource.Sweeper{
 		Name: "aws_networkfirewall_firewall_policy",
 		F:    sweepFirewallPolicies,
 		Dependencies: []string{
@@ -26,18 +25,18 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_firewall", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_firewall", &resource.Sweeper{
 		Name:         "aws_networkfirewall_firewall",
 		F:            sweepFirewalls,
 		Dependencies: []string{"aws_networkfirewall_logging_configuration"},
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_logging_configuration", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_logging_configuration", &resource.Sweeper{
 		Name: "aws_networkfirewall_logging_configuration",
 		F:    sweepLoggingConfigurations,
 	})
 
-	resource.AddTestSweepers("aws_networkfirewall_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_networkfirewall_rule_group", &resource.Sweeper{
 		Name: "aws_networkfirewall_rule_group",
 		F:    sweepRuleGroups,
 		Dependencies: []string{
@@ -54,16 +53,15 @@ func sweepFirewallPolicies(region string) error {
 	conn := client.(*conns.AWSClient).NetworkFirewallConn
 	ctx := context.Background()
 	input := &networkfirewall.ListFirewallPoliciesInput{MaxResults: aws.Int64(100)}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		resp, err := conn.ListFirewallPoliciesWithContext(ctx, input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping NetworkFirewall Firewall Policy sweep for %s: %s", region, err)
-			return nil
-		}
+
 		if err != nil {
-			return fmt.Errorf("error retrieving NetworkFirewall Firewall Policies: %w", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing NetworkFirewall Firewall Policies for %s: %w", region, err))
+			break
 		}
 
 		for _, fp := range resp.FirewallPolicies {
@@ -71,20 +69,12 @@ func sweepFirewallPolicies(region string) error {
 				continue
 			}
 
-			arn := aws.StringValue(fp.Arn)
-			log.Printf("[INFO] Deleting NetworkFirewall Firewall Policy: %s", arn)
-
 			r := ResourceFirewallPolicy()
 			d := r.Data(nil)
-			d.SetId(arn)
-			diags := r.DeleteContext(ctx, d, client)
-			for i := range diags {
-				if diags[i].Severity == diag.Error {
-					log.Printf("[ERROR] %s", diags[i].Summary)
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf(diags[i].Summary))
-					continue
-				}
-			}
+			d.SetId(aws.StringValue(fp.Arn))
+			d.Set("name", fp.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(resp.NextToken) == "" {
@@ -93,27 +83,35 @@ func sweepFirewallPolicies(region string) error {
 		input.NextToken = resp.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping NetworkFirewall Firewall Policies for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping NetworkFirewall Firewall Policy sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepFirewalls(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).NetworkFirewallConn
-	ctx := context.TODO()
+	ctx := context.Background()
 	input := &networkfirewall.ListFirewallsInput{MaxResults: aws.Int64(100)}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		resp, err := conn.ListFirewallsWithContext(ctx, input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping NetworkFirewall Firewall sweep for %s: %s", region, err)
-			return nil
-		}
+
 		if err != nil {
-			return fmt.Errorf("error retrieving NetworkFirewall firewalls: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing NetworkFirewall Firewalls for %s: %w", region, err))
+			break
 		}
 
 		for _, f := range resp.Firewalls {
@@ -121,21 +119,12 @@ func sweepFirewalls(region string) error {
 				continue
 			}
 
-			arn := aws.StringValue(f.FirewallArn)
-
-			log.Printf("[INFO] Deleting NetworkFirewall Firewall: %s", arn)
-
 			r := ResourceFirewall()
 			d := r.Data(nil)
-			d.SetId(arn)
-			diags := r.DeleteContext(ctx, d, client)
-			for i := range diags {
-				if diags[i].Severity == diag.Error {
-					log.Printf("[ERROR] %s", diags[i].Summary)
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf(diags[i].Summary))
-					continue
-				}
-			}
+			d.SetId(aws.StringValue(f.FirewallArn))
+			d.Set("name", f.FirewallName)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(resp.NextToken) == "" {
@@ -144,27 +133,35 @@ func sweepFirewalls(region string) error {
 		input.NextToken = resp.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping NetworkFirewall Firewalls for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping NetworkFirewall Firewall sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepLoggingConfigurations(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("error getting client: %s", err)
+		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).NetworkFirewallConn
-	ctx := context.TODO()
+	ctx := context.Background()
 	input := &networkfirewall.ListFirewallsInput{MaxResults: aws.Int64(100)}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		resp, err := conn.ListFirewallsWithContext(ctx, input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping NetworkFirewall Logging Configuration sweep for %s: %s", region, err)
-			return nil
-		}
+
 		if err != nil {
-			return fmt.Errorf("error retrieving NetworkFirewall firewalls: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing NetworkFirewall Logging Configurations for %s: %w", region, err))
+			break
 		}
 
 		for _, f := range resp.Firewalls {
@@ -172,21 +169,12 @@ func sweepLoggingConfigurations(region string) error {
 				continue
 			}
 
-			arn := aws.StringValue(f.FirewallArn)
-
-			log.Printf("[INFO] Deleting NetworkFirewall Logging Configuration for firewall: %s", arn)
-
 			r := ResourceLoggingConfiguration()
 			d := r.Data(nil)
-			d.SetId(arn)
-			diags := r.DeleteContext(ctx, d, client)
-			for i := range diags {
-				if diags[i].Severity == diag.Error {
-					log.Printf("[ERROR] %s", diags[i].Summary)
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf(diags[i].Summary))
-					continue
-				}
-			}
+			d.SetId(aws.StringValue(f.FirewallArn))
+			d.Set("firewall_arn", f.FirewallArn)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(resp.NextToken) == "" {
@@ -195,7 +183,16 @@ func sweepLoggingConfigurations(region string) error {
 		input.NextToken = resp.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping NetworkFirewall Logging Configurations for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping NetworkFirewall Logging Configuration sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepRuleGroups(region string) error {
@@ -206,37 +203,28 @@ func sweepRuleGroups(region string) error {
 	conn := client.(*conns.AWSClient).NetworkFirewallConn
 	ctx := context.Background()
 	input := &networkfirewall.ListRuleGroupsInput{MaxResults: aws.Int64(100)}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		resp, err := conn.ListRuleGroupsWithContext(ctx, input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping NetworkFirewall Rule Group sweep for %s: %s", region, err)
-			return nil
-		}
+
 		if err != nil {
-			return fmt.Errorf("error retrieving NetworkFirewall Rule Groups: %w", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing NetworkFirewall Rule Groups for %s: %w", region, err))
+			break
 		}
 
-		for _, r := range resp.RuleGroups {
-			if r == nil {
+		for _, rg := range resp.RuleGroups {
+			if rg == nil {
 				continue
 			}
 
-			arn := aws.StringValue(r.Arn)
-			log.Printf("[INFO] Deleting NetworkFirewall Rule Group: %s", arn)
-
 			r := ResourceRuleGroup()
 			d := r.Data(nil)
-			d.SetId(arn)
-			diags := r.DeleteContext(ctx, d, client)
-			for i := range diags {
-				if diags[i].Severity == diag.Error {
-					log.Printf("[ERROR] %s", diags[i].Summary)
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf(diags[i].Summary))
-					continue
-				}
-			}
+			d.SetId(aws.StringValue(rg.Arn))
+			d.Set("name", rg.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(resp.NextToken) == "" {
@@ -245,5 +233,14 @@ func sweepRuleGroups(region string) error {
 		input.NextToken = resp.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping NetworkFirewall Rule Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping NetworkFirewall Rule Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
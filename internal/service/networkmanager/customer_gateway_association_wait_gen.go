@@ -0,0 +1,124 @@
+// Code generated by "internal/generate/waiter/main.go -Name=CustomerGatewayAssociation -Finder=FindCustomerGatewayAssociationByTwoPartKey -StatusField=State -CreatePending=networkmanager.CustomerGatewayAssociationStatePending -CreateTarget=networkmanager.CustomerGatewayAssociationStateAvailable -DeletePending=networkmanager.CustomerGatewayAssociationStateAvailable,networkmanager.CustomerGatewayAssociationStateDeleting"; DO NOT EDIT.
+
+package networkmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusCustomerGatewayAssociation fetches the resource and its State.
+// Returns a nil result if the resource is not found.
+func statusCustomerGatewayAssociation(conn *networkmanager.NetworkManager, globalNetworkID string, customerGatewayARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindCustomerGatewayAssociationByTwoPartKey(conn, globalNetworkID, customerGatewayARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// waitCustomerGatewayAssociationCreated waits for the resource to be created.
+func waitCustomerGatewayAssociationCreated(conn *networkmanager.NetworkManager, globalNetworkID string, customerGatewayARN string, timeout time.Duration) (*networkmanager.CustomerGatewayAssociation, error) {
+	return waitCustomerGatewayAssociationCreatedWithContext(context.Background(), conn, globalNetworkID, customerGatewayARN, timeout)
+}
+
+func waitCustomerGatewayAssociationCreatedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, customerGatewayARN string, timeout time.Duration) (*networkmanager.CustomerGatewayAssociation, error) {
+	pending := []string{networkmanager.CustomerGatewayAssociationStatePending}
+	target := []string{networkmanager.CustomerGatewayAssociationStateAvailable}
+
+	var output *networkmanager.CustomerGatewayAssociation
+	var notFoundTick int
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusCustomerGatewayAssociation(conn, globalNetworkID, customerGatewayARN)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			// Allow for eventual consistency, as resource.StateChangeConf does.
+			if notFoundTick++; notFoundTick > 20 {
+				return false, &resource.NotFoundError{Retries: notFoundTick}
+			}
+
+			return false, nil
+		}
+
+		notFoundTick = 0
+
+		output = outputRaw.(*networkmanager.CustomerGatewayAssociation)
+
+		for _, v := range target {
+			if status == v {
+				return true, nil
+			}
+		}
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: target,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
+
+// waitCustomerGatewayAssociationDeleted waits for the resource to be deleted.
+func waitCustomerGatewayAssociationDeleted(conn *networkmanager.NetworkManager, globalNetworkID string, customerGatewayARN string, timeout time.Duration) (*networkmanager.CustomerGatewayAssociation, error) {
+	return waitCustomerGatewayAssociationDeletedWithContext(context.Background(), conn, globalNetworkID, customerGatewayARN, timeout)
+}
+
+func waitCustomerGatewayAssociationDeletedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, customerGatewayARN string, timeout time.Duration) (*networkmanager.CustomerGatewayAssociation, error) {
+	pending := []string{networkmanager.CustomerGatewayAssociationStateAvailable, networkmanager.CustomerGatewayAssociationStateDeleting}
+
+	var output *networkmanager.CustomerGatewayAssociation
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusCustomerGatewayAssociation(conn, globalNetworkID, customerGatewayARN)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			output = nil
+
+			return true, nil
+		}
+
+		output = outputRaw.(*networkmanager.CustomerGatewayAssociation)
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: pending,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
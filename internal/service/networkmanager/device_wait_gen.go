@@ -0,0 +1,177 @@
+// Code generated by "internal/generate/waiter/main.go -Name=Device -Finder=FindDeviceByTwoPartKey -StatusField=State -CreatePending=networkmanager.DeviceStatePending -CreateTarget=networkmanager.DeviceStateAvailable -UpdatePending=networkmanager.DeviceStateUpdating -UpdateTarget=networkmanager.DeviceStateAvailable -DeletePending=networkmanager.DeviceStateDeleting"; DO NOT EDIT.
+
+package networkmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusDevice fetches the resource and its State.
+// Returns a nil result if the resource is not found.
+func statusDevice(conn *networkmanager.NetworkManager, globalNetworkID string, deviceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDeviceByTwoPartKey(conn, globalNetworkID, deviceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// waitDeviceCreated waits for the resource to be created.
+func waitDeviceCreated(conn *networkmanager.NetworkManager, globalNetworkID string, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	return waitDeviceCreatedWithContext(context.Background(), conn, globalNetworkID, deviceID, timeout)
+}
+
+func waitDeviceCreatedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	pending := []string{networkmanager.DeviceStatePending}
+	target := []string{networkmanager.DeviceStateAvailable}
+
+	var output *networkmanager.Device
+	var notFoundTick int
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusDevice(conn, globalNetworkID, deviceID)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			// Allow for eventual consistency, as resource.StateChangeConf does.
+			if notFoundTick++; notFoundTick > 20 {
+				return false, &resource.NotFoundError{Retries: notFoundTick}
+			}
+
+			return false, nil
+		}
+
+		notFoundTick = 0
+
+		output = outputRaw.(*networkmanager.Device)
+
+		for _, v := range target {
+			if status == v {
+				return true, nil
+			}
+		}
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: target,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
+
+// waitDeviceUpdated waits for the resource to be updated.
+func waitDeviceUpdated(conn *networkmanager.NetworkManager, globalNetworkID string, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	return waitDeviceUpdatedWithContext(context.Background(), conn, globalNetworkID, deviceID, timeout)
+}
+
+func waitDeviceUpdatedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	pending := []string{networkmanager.DeviceStateUpdating}
+	target := []string{networkmanager.DeviceStateAvailable}
+
+	var output *networkmanager.Device
+	var notFoundTick int
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusDevice(conn, globalNetworkID, deviceID)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			// Allow for eventual consistency, as resource.StateChangeConf does.
+			if notFoundTick++; notFoundTick > 20 {
+				return false, &resource.NotFoundError{Retries: notFoundTick}
+			}
+
+			return false, nil
+		}
+
+		notFoundTick = 0
+
+		output = outputRaw.(*networkmanager.Device)
+
+		for _, v := range target {
+			if status == v {
+				return true, nil
+			}
+		}
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: target,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
+
+// waitDeviceDeleted waits for the resource to be deleted.
+func waitDeviceDeleted(conn *networkmanager.NetworkManager, globalNetworkID string, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	return waitDeviceDeletedWithContext(context.Background(), conn, globalNetworkID, deviceID, timeout)
+}
+
+func waitDeviceDeletedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, deviceID string, timeout time.Duration) (*networkmanager.Device, error) {
+	pending := []string{networkmanager.DeviceStateDeleting}
+
+	var output *networkmanager.Device
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusDevice(conn, globalNetworkID, deviceID)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			output = nil
+
+			return true, nil
+		}
+
+		output = outputRaw.(*networkmanager.Device)
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: pending,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
@@ -0,0 +1,90 @@
+package networkmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerDevicesDataSource_basic(t *testing.T) {
+	dataSourceAllName := "data.aws_networkmanager_devices.all"
+	dataSourceBySiteIDName := "data.aws_networkmanager_devices.by_site_id"
+	dataSourceByTagsName := "data.aws_networkmanager_devices.by_tags"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDevicesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceAllName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceBySiteIDName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceBySiteIDName, "ids.*", "aws_networkmanager_device.test2", "id"),
+					resource.TestCheckResourceAttr(dataSourceByTagsName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceByTagsName, "ids.*", "aws_networkmanager_device.test1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDevicesDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_site" "test" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_device" "test1" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_device" "test2" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+}
+
+data "aws_networkmanager_devices" "all" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  depends_on = [aws_networkmanager_device.test1, aws_networkmanager_device.test2]
+}
+
+data "aws_networkmanager_devices" "by_site_id" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  depends_on = [aws_networkmanager_device.test1, aws_networkmanager_device.test2]
+}
+
+data "aws_networkmanager_devices" "by_tags" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_networkmanager_device.test1, aws_networkmanager_device.test2]
+}
+`, rName)
+}
@@ -0,0 +1,432 @@
+// Code generated by "internal/generate/finder/main.go -ListOps=DescribeGlobalNetworks:ErrCodeResourceNotFoundException,GetCustomerGatewayAssociations:ErrCodeResourceNotFoundException,GetDevices:ErrCodeResourceNotFoundException,GetLinkAssociations:ErrCodeResourceNotFoundException,GetLinks:ErrCodeResourceNotFoundException,GetSites:ErrCodeResourceNotFoundException,GetTransitGatewayRegistrations:ErrCodeResourceNotFoundException"; DO NOT EDIT.
+
+package networkmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// findGlobalNetworks returns all the items returned by DescribeGlobalNetworks for which filter, if not nil, returns true.
+func findGlobalNetworks(conn *networkmanager.NetworkManager, input *networkmanager.DescribeGlobalNetworksInput, filter func(*networkmanager.GlobalNetwork) bool) ([]*networkmanager.GlobalNetwork, error) {
+	return findGlobalNetworksWithContext(context.Background(), conn, input, filter)
+}
+
+func findGlobalNetworksWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.DescribeGlobalNetworksInput, filter func(*networkmanager.GlobalNetwork) bool) ([]*networkmanager.GlobalNetwork, error) {
+	var output []*networkmanager.GlobalNetwork
+
+	err := conn.DescribeGlobalNetworksPagesWithContext(ctx, input, func(page *networkmanager.DescribeGlobalNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.GlobalNetworks {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findGlobalNetwork returns the single item returned by DescribeGlobalNetworks for which filter, if not nil, returns true.
+// Returns NotFoundError if there is not exactly one such item.
+func findGlobalNetwork(conn *networkmanager.NetworkManager, input *networkmanager.DescribeGlobalNetworksInput, filter func(*networkmanager.GlobalNetwork) bool) (*networkmanager.GlobalNetwork, error) {
+	return findGlobalNetworkWithContext(context.Background(), conn, input, filter)
+}
+
+func findGlobalNetworkWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.DescribeGlobalNetworksInput, filter func(*networkmanager.GlobalNetwork) bool) (*networkmanager.GlobalNetwork, error) {
+	output, err := findGlobalNetworksWithContext(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// findCustomerGatewayAssociations returns all the items returned by GetCustomerGatewayAssociations for which filter, if not nil, returns true.
+func findCustomerGatewayAssociations(conn *networkmanager.NetworkManager, input *networkmanager.GetCustomerGatewayAssociationsInput, filter func(*networkmanager.CustomerGatewayAssociation) bool) ([]*networkmanager.CustomerGatewayAssociation, error) {
+	return findCustomerGatewayAssociationsWithContext(context.Background(), conn, input, filter)
+}
+
+func findCustomerGatewayAssociationsWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetCustomerGatewayAssociationsInput, filter func(*networkmanager.CustomerGatewayAssociation) bool) ([]*networkmanager.CustomerGatewayAssociation, error) {
+	var output []*networkmanager.CustomerGatewayAssociation
+
+	err := conn.GetCustomerGatewayAssociationsPagesWithContext(ctx, input, func(page *networkmanager.GetCustomerGatewayAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CustomerGatewayAssociations {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findCustomerGatewayAssociation returns the single item returned by GetCustomerGatewayAssociations for which filter, if not nil, returns true.
+// Returns NotFoundError if there is not exactly one such item.
+func findCustomerGatewayAssociation(conn *networkmanager.NetworkManager, input *networkmanager.GetCustomerGatewayAssociationsInput, filter func(*networkmanager.CustomerGatewayAssociation) bool) (*networkmanager.CustomerGatewayAssociation, error) {
+	return findCustomerGatewayAssociationWithContext(context.Background(), conn, input, filter)
+}
+
+func findCustomerGatewayAssociationWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetCustomerGatewayAssociationsInput, filter func(*networkmanager.CustomerGatewayAssociation) bool) (*networkmanager.CustomerGatewayAssociation, error) {
+	output, err := findCustomerGatewayAssociationsWithContext(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// findDevices returns all the items returned by GetDevices for which filter, if not nil, returns true.
+func findDevices(conn *networkmanager.NetworkManager, input *networkmanager.GetDevicesInput, filter func(*networkmanager.Device) bool) ([]*networkmanager.Device, error) {
+	return findDevicesWithContext(context.Background(), conn, input, filter)
+}
+
+func findDevicesWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetDevicesInput, filter func(*networkmanager.Device) bool) ([]*networkmanager.Device, error) {
+	var output []*networkmanager.Device
+
+	err := conn.GetDevicesPagesWithContext(ctx, input, func(page *networkmanager.GetDevicesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Devices {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findDevice returns the single item returned by GetDevices for which filter, if not nil, returns true.
+// Returns NotFoundError if there is not exactly one such item.
+func findDevice(conn *networkmanager.NetworkManager, input *networkmanager.GetDevicesInput, filter func(*networkmanager.Device) bool) (*networkmanager.Device, error) {
+	return findDeviceWithContext(context.Background(), conn, input, filter)
+}
+
+func findDeviceWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetDevicesInput, filter func(*networkmanager.Device) bool) (*networkmanager.Device, error) {
+	output, err := findDevicesWithContext(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// findLinkAssociations returns all the items returned by GetLinkAssociations for which filter, if not nil, returns true.
+func findLinkAssociations(conn *networkmanager.NetworkManager, input *networkmanager.GetLinkAssociationsInput, filter func(*networkmanager.LinkAssociation) bool) ([]*networkmanager.LinkAssociation, error) {
+	return findLinkAssociationsWithContext(context.Background(), conn, input, filter)
+}
+
+func findLinkAssociationsWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetLinkAssociationsInput, filter func(*networkmanager.LinkAssociation) bool) ([]*networkmanager.LinkAssociation, error) {
+	var output []*networkmanager.LinkAssociation
+
+	err := conn.GetLinkAssociationsPagesWithContext(ctx, input, func(page *networkmanager.GetLinkAssociationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.LinkAssociations {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findLinkAssociation returns the single item returned by GetLinkAssociations for which filter, if not nil, returns true.
+// Returns NotFoundError if there is not exactly one such item.
+func findLinkAssociation(conn *networkmanager.NetworkManager, input *networkmanager.GetLinkAssociationsInput, filter func(*networkmanager.LinkAssociation) bool) (*networkmanager.LinkAssociation, error) {
+	return findLinkAssociationWithContext(context.Background(), conn, input, filter)
+}
+
+func findLinkAssociationWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetLinkAssociationsInput, filter func(*networkmanager.LinkAssociation) bool) (*networkmanager.LinkAssociation, error) {
+	output, err := findLinkAssociationsWithContext(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// findLinks returns all the items returned by GetLinks for which filter, if not nil, returns true.
+func findLinks(conn *networkmanager.NetworkManager, input *networkmanager.GetLinksInput, filter func(*networkmanager.Link) bool) ([]*networkmanager.Link, error) {
+	return findLinksWithContext(context.Background(), conn, input, filter)
+}
+
+func findLinksWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetLinksInput, filter func(*networkmanager.Link) bool) ([]*networkmanager.Link, error) {
+	var output []*networkmanager.Link
+
+	err := conn.GetLinksPagesWithContext(ctx, input, func(page *networkmanager.GetLinksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Links {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findLink returns the single item returned by GetLinks for which filter, if not nil, returns true.
+// Returns NotFoundError if there is not exactly one such item.
+func findLink(conn *networkmanager.NetworkManager, input *networkmanager.GetLinksInput, filter func(*networkmanager.Link) bool) (*networkmanager.Link, error) {
+	return findLinkWithContext(context.Background(), conn, input, filter)
+}
+
+func findLinkWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetLinksInput, filter func(*networkmanager.Link) bool) (*networkmanager.Link, error) {
+	output, err := findLinksWithContext(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// findSites returns all the items returned by GetSites for which filter, if not nil, returns true.
+func findSites(conn *networkmanager.NetworkManager, input *networkmanager.GetSitesInput, filter func(*networkmanager.Site) bool) ([]*networkmanager.Site, error) {
+	return findSitesWithContext(context.Background(), conn, input, filter)
+}
+
+func findSitesWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetSitesInput, filter func(*networkmanager.Site) bool) ([]*networkmanager.Site, error) {
+	var output []*networkmanager.Site
+
+	err := conn.GetSitesPagesWithContext(ctx, input, func(page *networkmanager.GetSitesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.Sites {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findSite returns the single item returned by GetSites for which filter, if not nil, returns true.
+// Returns NotFoundError if there is not exactly one such item.
+func findSite(conn *networkmanager.NetworkManager, input *networkmanager.GetSitesInput, filter func(*networkmanager.Site) bool) (*networkmanager.Site, error) {
+	return findSiteWithContext(context.Background(), conn, input, filter)
+}
+
+func findSiteWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetSitesInput, filter func(*networkmanager.Site) bool) (*networkmanager.Site, error) {
+	output, err := findSitesWithContext(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
+
+// findTransitGatewayRegistrations returns all the items returned by GetTransitGatewayRegistrations for which filter, if not nil, returns true.
+func findTransitGatewayRegistrations(conn *networkmanager.NetworkManager, input *networkmanager.GetTransitGatewayRegistrationsInput, filter func(*networkmanager.TransitGatewayRegistration) bool) ([]*networkmanager.TransitGatewayRegistration, error) {
+	return findTransitGatewayRegistrationsWithContext(context.Background(), conn, input, filter)
+}
+
+func findTransitGatewayRegistrationsWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetTransitGatewayRegistrationsInput, filter func(*networkmanager.TransitGatewayRegistration) bool) ([]*networkmanager.TransitGatewayRegistration, error) {
+	var output []*networkmanager.TransitGatewayRegistration
+
+	err := conn.GetTransitGatewayRegistrationsPagesWithContext(ctx, input, func(page *networkmanager.GetTransitGatewayRegistrationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.TransitGatewayRegistrations {
+			if v != nil && (filter == nil || filter(v)) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, networkmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// findTransitGatewayRegistration returns the single item returned by GetTransitGatewayRegistrations for which filter, if not nil, returns true.
+// Returns NotFoundError if there is not exactly one such item.
+func findTransitGatewayRegistration(conn *networkmanager.NetworkManager, input *networkmanager.GetTransitGatewayRegistrationsInput, filter func(*networkmanager.TransitGatewayRegistration) bool) (*networkmanager.TransitGatewayRegistration, error) {
+	return findTransitGatewayRegistrationWithContext(context.Background(), conn, input, filter)
+}
+
+func findTransitGatewayRegistrationWithContext(ctx context.Context, conn *networkmanager.NetworkManager, input *networkmanager.GetTransitGatewayRegistrationsInput, filter func(*networkmanager.TransitGatewayRegistration) bool) (*networkmanager.TransitGatewayRegistration, error) {
+	output, err := findTransitGatewayRegistrationsWithContext(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(output); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return output[0], nil
+}
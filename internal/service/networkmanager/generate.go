@@ -1,4 +1,11 @@
+//go:generate go run ../../generate/finder/main.go -ListOps=DescribeGlobalNetworks:ErrCodeResourceNotFoundException,GetCustomerGatewayAssociations:ErrCodeResourceNotFoundException,GetDevices:ErrCodeResourceNotFoundException,GetLinkAssociations:ErrCodeResourceNotFoundException,GetLinks:ErrCodeResourceNotFoundException,GetSites:ErrCodeResourceNotFoundException,GetTransitGatewayRegistrations:ErrCodeResourceNotFoundException
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOutTagsElem=TagList -ServiceTagsSlice -UpdateTags
+//go:generate go run ../../generate/waiter/main.go -Name=GlobalNetwork -Finder=FindGlobalNetworkByID -StatusField=State -CreatePending=networkmanager.GlobalNetworkStatePending -CreateTarget=networkmanager.GlobalNetworkStateAvailable -UpdatePending=networkmanager.GlobalNetworkStateUpdating -UpdateTarget=networkmanager.GlobalNetworkStateAvailable -DeletePending=networkmanager.GlobalNetworkStateDeleting
+//go:generate go run ../../generate/waiter/main.go -Name=Site -Finder=FindSiteByTwoPartKey -StatusField=State -CreatePending=networkmanager.SiteStatePending -CreateTarget=networkmanager.SiteStateAvailable -UpdatePending=networkmanager.SiteStateUpdating -UpdateTarget=networkmanager.SiteStateAvailable -DeletePending=networkmanager.SiteStateDeleting
+//go:generate go run ../../generate/waiter/main.go -Name=Device -Finder=FindDeviceByTwoPartKey -StatusField=State -CreatePending=networkmanager.DeviceStatePending -CreateTarget=networkmanager.DeviceStateAvailable -UpdatePending=networkmanager.DeviceStateUpdating -UpdateTarget=networkmanager.DeviceStateAvailable -DeletePending=networkmanager.DeviceStateDeleting
+//go:generate go run ../../generate/waiter/main.go -Name=Link -Finder=FindLinkByTwoPartKey -StatusField=State -CreatePending=networkmanager.LinkStatePending -CreateTarget=networkmanager.LinkStateAvailable -UpdatePending=networkmanager.LinkStateUpdating -UpdateTarget=networkmanager.LinkStateAvailable -DeletePending=networkmanager.LinkStateDeleting
+//go:generate go run ../../generate/waiter/main.go -Name=LinkAssociation -Finder=FindLinkAssociationByThreePartKey -StatusField=LinkAssociationState -CreatePending=networkmanager.LinkAssociationStatePending -CreateTarget=networkmanager.LinkAssociationStateAvailable -DeletePending=networkmanager.LinkAssociationStateAvailable,networkmanager.LinkAssociationStateDeleting
+//go:generate go run ../../generate/waiter/main.go -Name=CustomerGatewayAssociation -Finder=FindCustomerGatewayAssociationByTwoPartKey -StatusField=State -CreatePending=networkmanager.CustomerGatewayAssociationStatePending -CreateTarget=networkmanager.CustomerGatewayAssociationStateAvailable -DeletePending=networkmanager.CustomerGatewayAssociationStateAvailable,networkmanager.CustomerGatewayAssociationStateDeleting
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package networkmanager
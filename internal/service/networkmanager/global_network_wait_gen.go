@@ -0,0 +1,177 @@
+// Code generated by "internal/generate/waiter/main.go -Name=GlobalNetwork -Finder=FindGlobalNetworkByID -StatusField=State -CreatePending=networkmanager.GlobalNetworkStatePending -CreateTarget=networkmanager.GlobalNetworkStateAvailable -UpdatePending=networkmanager.GlobalNetworkStateUpdating -UpdateTarget=networkmanager.GlobalNetworkStateAvailable -DeletePending=networkmanager.GlobalNetworkStateDeleting"; DO NOT EDIT.
+
+package networkmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusGlobalNetwork fetches the resource and its State.
+// Returns a nil result if the resource is not found.
+func statusGlobalNetwork(conn *networkmanager.NetworkManager, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindGlobalNetworkByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// waitGlobalNetworkCreated waits for the resource to be created.
+func waitGlobalNetworkCreated(conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	return waitGlobalNetworkCreatedWithContext(context.Background(), conn, id, timeout)
+}
+
+func waitGlobalNetworkCreatedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	pending := []string{networkmanager.GlobalNetworkStatePending}
+	target := []string{networkmanager.GlobalNetworkStateAvailable}
+
+	var output *networkmanager.GlobalNetwork
+	var notFoundTick int
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusGlobalNetwork(conn, id)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			// Allow for eventual consistency, as resource.StateChangeConf does.
+			if notFoundTick++; notFoundTick > 20 {
+				return false, &resource.NotFoundError{Retries: notFoundTick}
+			}
+
+			return false, nil
+		}
+
+		notFoundTick = 0
+
+		output = outputRaw.(*networkmanager.GlobalNetwork)
+
+		for _, v := range target {
+			if status == v {
+				return true, nil
+			}
+		}
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: target,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
+
+// waitGlobalNetworkUpdated waits for the resource to be updated.
+func waitGlobalNetworkUpdated(conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	return waitGlobalNetworkUpdatedWithContext(context.Background(), conn, id, timeout)
+}
+
+func waitGlobalNetworkUpdatedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	pending := []string{networkmanager.GlobalNetworkStateUpdating}
+	target := []string{networkmanager.GlobalNetworkStateAvailable}
+
+	var output *networkmanager.GlobalNetwork
+	var notFoundTick int
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusGlobalNetwork(conn, id)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			// Allow for eventual consistency, as resource.StateChangeConf does.
+			if notFoundTick++; notFoundTick > 20 {
+				return false, &resource.NotFoundError{Retries: notFoundTick}
+			}
+
+			return false, nil
+		}
+
+		notFoundTick = 0
+
+		output = outputRaw.(*networkmanager.GlobalNetwork)
+
+		for _, v := range target {
+			if status == v {
+				return true, nil
+			}
+		}
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: target,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
+
+// waitGlobalNetworkDeleted waits for the resource to be deleted.
+func waitGlobalNetworkDeleted(conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	return waitGlobalNetworkDeletedWithContext(context.Background(), conn, id, timeout)
+}
+
+func waitGlobalNetworkDeletedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, id string, timeout time.Duration) (*networkmanager.GlobalNetwork, error) {
+	pending := []string{networkmanager.GlobalNetworkStateDeleting}
+
+	var output *networkmanager.GlobalNetwork
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusGlobalNetwork(conn, id)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			output = nil
+
+			return true, nil
+		}
+
+		output = outputRaw.(*networkmanager.GlobalNetwork)
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: pending,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
@@ -0,0 +1,84 @@
+package networkmanager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+)
+
+const linkAssociationIDSeparator = ","
+
+func LinkAssociationCreateResourceID(globalNetworkID, linkID, deviceID string) string {
+	parts := []string{globalNetworkID, linkID, deviceID}
+	id := strings.Join(parts, linkAssociationIDSeparator)
+
+	return id
+}
+
+func LinkAssociationParseResourceID(id string) (string, string, string, error) {
+	parts := strings.Split(id, linkAssociationIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GLOBAL-NETWORK-ID%[2]sLINK-ID%[2]sDEVICE-ID", id, linkAssociationIDSeparator)
+}
+
+const transitGatewayRegistrationIDSeparator = ","
+
+func TransitGatewayRegistrationCreateResourceID(globalNetworkID, transitGatewayARN string) string {
+	parts := []string{globalNetworkID, transitGatewayARN}
+	id := strings.Join(parts, transitGatewayRegistrationIDSeparator)
+
+	return id
+}
+
+func TransitGatewayRegistrationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, transitGatewayRegistrationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GLOBAL-NETWORK-ID%[2]sTRANSIT-GATEWAY-ARN", id, transitGatewayRegistrationIDSeparator)
+}
+
+const customerGatewayAssociationIDSeparator = ","
+
+func CustomerGatewayAssociationCreateResourceID(globalNetworkID, customerGatewayARN string) string {
+	parts := []string{globalNetworkID, customerGatewayARN}
+	id := strings.Join(parts, customerGatewayAssociationIDSeparator)
+
+	return id
+}
+
+func CustomerGatewayAssociationParseResourceID(id string) (string, string, error) {
+	parts := strings.Split(id, customerGatewayAssociationIDSeparator)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected GLOBAL-NETWORK-ID%[2]sCUSTOMER-GATEWAY-ARN", id, customerGatewayAssociationIDSeparator)
+}
+
+// globalNetworkObjectIDsFromARN returns the global network and object IDs from a
+// Network Manager object ARN such as
+// arn:aws:networkmanager::123456789012:site/global-network-01231231231231231/site-444555aaabbb11223.
+func globalNetworkObjectIDsFromARN(v, resourceType string) (string, string, error) {
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing ARN (%s): %w", v, err)
+	}
+
+	parts := strings.Split(parsedARN.Resource, "/")
+
+	if len(parts) != 3 || parts[0] != resourceType || parts[1] == "" || parts[2] == "" {
+		return "", "", fmt.Errorf("unexpected format for ARN resource (%s), expected %s/GLOBAL-NETWORK-ID/ID", parsedARN.Resource, resourceType)
+	}
+
+	return parts[1], parts[2], nil
+}
@@ -0,0 +1,124 @@
+// Code generated by "internal/generate/waiter/main.go -Name=LinkAssociation -Finder=FindLinkAssociationByThreePartKey -StatusField=LinkAssociationState -CreatePending=networkmanager.LinkAssociationStatePending -CreateTarget=networkmanager.LinkAssociationStateAvailable -DeletePending=networkmanager.LinkAssociationStateAvailable,networkmanager.LinkAssociationStateDeleting"; DO NOT EDIT.
+
+package networkmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusLinkAssociation fetches the resource and its LinkAssociationState.
+// Returns a nil result if the resource is not found.
+func statusLinkAssociation(conn *networkmanager.NetworkManager, globalNetworkID string, linkID string, deviceID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLinkAssociationByThreePartKey(conn, globalNetworkID, linkID, deviceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.LinkAssociationState), nil
+	}
+}
+
+// waitLinkAssociationCreated waits for the resource to be created.
+func waitLinkAssociationCreated(conn *networkmanager.NetworkManager, globalNetworkID string, linkID string, deviceID string, timeout time.Duration) (*networkmanager.LinkAssociation, error) {
+	return waitLinkAssociationCreatedWithContext(context.Background(), conn, globalNetworkID, linkID, deviceID, timeout)
+}
+
+func waitLinkAssociationCreatedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, linkID string, deviceID string, timeout time.Duration) (*networkmanager.LinkAssociation, error) {
+	pending := []string{networkmanager.LinkAssociationStatePending}
+	target := []string{networkmanager.LinkAssociationStateAvailable}
+
+	var output *networkmanager.LinkAssociation
+	var notFoundTick int
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusLinkAssociation(conn, globalNetworkID, linkID, deviceID)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			// Allow for eventual consistency, as resource.StateChangeConf does.
+			if notFoundTick++; notFoundTick > 20 {
+				return false, &resource.NotFoundError{Retries: notFoundTick}
+			}
+
+			return false, nil
+		}
+
+		notFoundTick = 0
+
+		output = outputRaw.(*networkmanager.LinkAssociation)
+
+		for _, v := range target {
+			if status == v {
+				return true, nil
+			}
+		}
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: target,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
+
+// waitLinkAssociationDeleted waits for the resource to be deleted.
+func waitLinkAssociationDeleted(conn *networkmanager.NetworkManager, globalNetworkID string, linkID string, deviceID string, timeout time.Duration) (*networkmanager.LinkAssociation, error) {
+	return waitLinkAssociationDeletedWithContext(context.Background(), conn, globalNetworkID, linkID, deviceID, timeout)
+}
+
+func waitLinkAssociationDeletedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, linkID string, deviceID string, timeout time.Duration) (*networkmanager.LinkAssociation, error) {
+	pending := []string{networkmanager.LinkAssociationStateAvailable, networkmanager.LinkAssociationStateDeleting}
+
+	var output *networkmanager.LinkAssociation
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusLinkAssociation(conn, globalNetworkID, linkID, deviceID)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			output = nil
+
+			return true, nil
+		}
+
+		output = outputRaw.(*networkmanager.LinkAssociation)
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: pending,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
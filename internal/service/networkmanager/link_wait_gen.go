@@ -0,0 +1,177 @@
+// Code generated by "internal/generate/waiter/main.go -Name=Link -Finder=FindLinkByTwoPartKey -StatusField=State -CreatePending=networkmanager.LinkStatePending -CreateTarget=networkmanager.LinkStateAvailable -UpdatePending=networkmanager.LinkStateUpdating -UpdateTarget=networkmanager.LinkStateAvailable -DeletePending=networkmanager.LinkStateDeleting"; DO NOT EDIT.
+
+package networkmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusLink fetches the resource and its State.
+// Returns a nil result if the resource is not found.
+func statusLink(conn *networkmanager.NetworkManager, globalNetworkID string, linkID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLinkByTwoPartKey(conn, globalNetworkID, linkID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// waitLinkCreated waits for the resource to be created.
+func waitLinkCreated(conn *networkmanager.NetworkManager, globalNetworkID string, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	return waitLinkCreatedWithContext(context.Background(), conn, globalNetworkID, linkID, timeout)
+}
+
+func waitLinkCreatedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	pending := []string{networkmanager.LinkStatePending}
+	target := []string{networkmanager.LinkStateAvailable}
+
+	var output *networkmanager.Link
+	var notFoundTick int
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusLink(conn, globalNetworkID, linkID)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			// Allow for eventual consistency, as resource.StateChangeConf does.
+			if notFoundTick++; notFoundTick > 20 {
+				return false, &resource.NotFoundError{Retries: notFoundTick}
+			}
+
+			return false, nil
+		}
+
+		notFoundTick = 0
+
+		output = outputRaw.(*networkmanager.Link)
+
+		for _, v := range target {
+			if status == v {
+				return true, nil
+			}
+		}
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: target,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
+
+// waitLinkUpdated waits for the resource to be updated.
+func waitLinkUpdated(conn *networkmanager.NetworkManager, globalNetworkID string, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	return waitLinkUpdatedWithContext(context.Background(), conn, globalNetworkID, linkID, timeout)
+}
+
+func waitLinkUpdatedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	pending := []string{networkmanager.LinkStateUpdating}
+	target := []string{networkmanager.LinkStateAvailable}
+
+	var output *networkmanager.Link
+	var notFoundTick int
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusLink(conn, globalNetworkID, linkID)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			// Allow for eventual consistency, as resource.StateChangeConf does.
+			if notFoundTick++; notFoundTick > 20 {
+				return false, &resource.NotFoundError{Retries: notFoundTick}
+			}
+
+			return false, nil
+		}
+
+		notFoundTick = 0
+
+		output = outputRaw.(*networkmanager.Link)
+
+		for _, v := range target {
+			if status == v {
+				return true, nil
+			}
+		}
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: target,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
+
+// waitLinkDeleted waits for the resource to be deleted.
+func waitLinkDeleted(conn *networkmanager.NetworkManager, globalNetworkID string, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	return waitLinkDeletedWithContext(context.Background(), conn, globalNetworkID, linkID, timeout)
+}
+
+func waitLinkDeletedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, linkID string, timeout time.Duration) (*networkmanager.Link, error) {
+	pending := []string{networkmanager.LinkStateDeleting}
+
+	var output *networkmanager.Link
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusLink(conn, globalNetworkID, linkID)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			output = nil
+
+			return true, nil
+		}
+
+		output = outputRaw.(*networkmanager.Link)
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: pending,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
@@ -0,0 +1,88 @@
+package networkmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerLinksDataSource_basic(t *testing.T) {
+	dataSourceAllName := "data.aws_networkmanager_links.all"
+	dataSourceByProviderName := "data.aws_networkmanager_links.by_provider"
+	dataSourceByTagsName := "data.aws_networkmanager_links.by_tags"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLinksDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceAllName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceByProviderName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceByProviderName, "ids.*", "aws_networkmanager_link.test2", "id"),
+					resource.TestCheckResourceAttr(dataSourceByTagsName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceByTagsName, "ids.*", "aws_networkmanager_link.test1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLinksDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccLinkBaseConfig(rName), fmt.Sprintf(`
+resource "aws_networkmanager_link" "test1" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+
+  bandwidth {
+    download_speed = 50
+    upload_speed   = 10
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_link" "test2" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  site_id           = aws_networkmanager_site.test.id
+  provider_name     = "provider2"
+
+  bandwidth {
+    download_speed = 100
+    upload_speed   = 20
+  }
+}
+
+data "aws_networkmanager_links" "all" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  depends_on = [aws_networkmanager_link.test1, aws_networkmanager_link.test2]
+}
+
+data "aws_networkmanager_links" "by_provider" {
+  global_network_id = aws_networkmanager_global_network.test.id
+  provider_name     = "provider2"
+
+  depends_on = [aws_networkmanager_link.test1, aws_networkmanager_link.test2]
+}
+
+data "aws_networkmanager_links" "by_tags" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_networkmanager_link.test1, aws_networkmanager_link.test2]
+}
+`, rName))
+}
@@ -0,0 +1,177 @@
+// Code generated by "internal/generate/waiter/main.go -Name=Site -Finder=FindSiteByTwoPartKey -StatusField=State -CreatePending=networkmanager.SiteStatePending -CreateTarget=networkmanager.SiteStateAvailable -UpdatePending=networkmanager.SiteStateUpdating -UpdateTarget=networkmanager.SiteStateAvailable -DeletePending=networkmanager.SiteStateDeleting"; DO NOT EDIT.
+
+package networkmanager
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusSite fetches the resource and its State.
+// Returns a nil result if the resource is not found.
+func statusSite(conn *networkmanager.NetworkManager, globalNetworkID string, siteID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindSiteByTwoPartKey(conn, globalNetworkID, siteID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.State), nil
+	}
+}
+
+// waitSiteCreated waits for the resource to be created.
+func waitSiteCreated(conn *networkmanager.NetworkManager, globalNetworkID string, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	return waitSiteCreatedWithContext(context.Background(), conn, globalNetworkID, siteID, timeout)
+}
+
+func waitSiteCreatedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	pending := []string{networkmanager.SiteStatePending}
+	target := []string{networkmanager.SiteStateAvailable}
+
+	var output *networkmanager.Site
+	var notFoundTick int
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusSite(conn, globalNetworkID, siteID)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			// Allow for eventual consistency, as resource.StateChangeConf does.
+			if notFoundTick++; notFoundTick > 20 {
+				return false, &resource.NotFoundError{Retries: notFoundTick}
+			}
+
+			return false, nil
+		}
+
+		notFoundTick = 0
+
+		output = outputRaw.(*networkmanager.Site)
+
+		for _, v := range target {
+			if status == v {
+				return true, nil
+			}
+		}
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: target,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
+
+// waitSiteUpdated waits for the resource to be updated.
+func waitSiteUpdated(conn *networkmanager.NetworkManager, globalNetworkID string, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	return waitSiteUpdatedWithContext(context.Background(), conn, globalNetworkID, siteID, timeout)
+}
+
+func waitSiteUpdatedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	pending := []string{networkmanager.SiteStateUpdating}
+	target := []string{networkmanager.SiteStateAvailable}
+
+	var output *networkmanager.Site
+	var notFoundTick int
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusSite(conn, globalNetworkID, siteID)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			// Allow for eventual consistency, as resource.StateChangeConf does.
+			if notFoundTick++; notFoundTick > 20 {
+				return false, &resource.NotFoundError{Retries: notFoundTick}
+			}
+
+			return false, nil
+		}
+
+		notFoundTick = 0
+
+		output = outputRaw.(*networkmanager.Site)
+
+		for _, v := range target {
+			if status == v {
+				return true, nil
+			}
+		}
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: target,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
+
+// waitSiteDeleted waits for the resource to be deleted.
+func waitSiteDeleted(conn *networkmanager.NetworkManager, globalNetworkID string, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	return waitSiteDeletedWithContext(context.Background(), conn, globalNetworkID, siteID, timeout)
+}
+
+func waitSiteDeletedWithContext(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID string, siteID string, timeout time.Duration) (*networkmanager.Site, error) {
+	pending := []string{networkmanager.SiteStateDeleting}
+
+	var output *networkmanager.Site
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusSite(conn, globalNetworkID, siteID)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			output = nil
+
+			return true, nil
+		}
+
+		output = outputRaw.(*networkmanager.Site)
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: pending,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
@@ -0,0 +1,61 @@
+package networkmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceSites() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSitesRead,
+
+		Schema: map[string]*schema.Schema{
+			"global_network_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"tags": tftags.TagsSchema(),
+		},
+	}
+}
+
+func dataSourceSitesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).NetworkManagerConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tagsToMatch := tftags.New(d.Get("tags").(map[string]interface{})).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	globalNetworkID := d.Get("global_network_id").(string)
+	input := &networkmanager.GetSitesInput{
+		GlobalNetworkId: aws.String(globalNetworkID),
+	}
+
+	output, err := findSitesWithContext(ctx, conn, input, func(v *networkmanager.Site) bool {
+		return len(tagsToMatch) == 0 || KeyValueTags(v.Tags).ContainsAll(tagsToMatch)
+	})
+
+	if err != nil {
+		return diag.Errorf("error listing Network Manager Sites (%s): %s", globalNetworkID, err)
+	}
+
+	var ids []string
+
+	for _, v := range output {
+		ids = append(ids, aws.StringValue(v.SiteId))
+	}
+
+	d.SetId(globalNetworkID)
+	d.Set("ids", ids)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package networkmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccNetworkManagerSitesDataSource_basic(t *testing.T) {
+	dataSourceAllName := "data.aws_networkmanager_sites.all"
+	dataSourceByTagsName := "data.aws_networkmanager_sites.by_tags"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, networkmanager.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSitesDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceAllName, "ids.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceByTagsName, "ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(dataSourceByTagsName, "ids.*", "aws_networkmanager_site.test1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSitesDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_networkmanager_global_network" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_site" "test1" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_networkmanager_site" "test2" {
+  global_network_id = aws_networkmanager_global_network.test.id
+}
+
+data "aws_networkmanager_sites" "all" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  depends_on = [aws_networkmanager_site.test1, aws_networkmanager_site.test2]
+}
+
+data "aws_networkmanager_sites" "by_tags" {
+  global_network_id = aws_networkmanager_global_network.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_networkmanager_site.test1, aws_networkmanager_site.test2]
+}
+`, rName)
+}
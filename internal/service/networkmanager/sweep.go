@@ -0,0 +1,277 @@
+//go:build sweep
+// +build sweep
+
+package networkmanager
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func init() {
+	sweep.AddTestSweepers("aws_networkmanager_global_network", &resource.Sweeper{
+		Name: "aws_networkmanager_global_network",
+		F:    sweepGlobalNetworks,
+		Dependencies: []string{
+			"aws_networkmanager_site",
+			"aws_networkmanager_transit_gateway_registration",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_networkmanager_site", &resource.Sweeper{
+		Name: "aws_networkmanager_site",
+		F:    sweepSites,
+		Dependencies: []string{
+			"aws_networkmanager_device",
+			"aws_networkmanager_link",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_networkmanager_device", &resource.Sweeper{
+		Name: "aws_networkmanager_device",
+		F:    sweepDevices,
+		Dependencies: []string{
+			"aws_networkmanager_customer_gateway_association",
+			"aws_networkmanager_link_association",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_networkmanager_link", &resource.Sweeper{
+		Name: "aws_networkmanager_link",
+		F:    sweepLinks,
+		Dependencies: []string{
+			"aws_networkmanager_customer_gateway_association",
+			"aws_networkmanager_link_association",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_networkmanager_link_association", &resource.Sweeper{
+		Name: "aws_networkmanager_link_association",
+		F:    sweepLinkAssociations,
+	})
+
+	sweep.AddTestSweepers("aws_networkmanager_transit_gateway_registration", &resource.Sweeper{
+		Name: "aws_networkmanager_transit_gateway_registration",
+		F:    sweepTransitGatewayRegistrations,
+		Dependencies: []string{
+			"aws_networkmanager_customer_gateway_association",
+		},
+	})
+
+	sweep.AddTestSweepers("aws_networkmanager_customer_gateway_association", &resource.Sweeper{
+		Name: "aws_networkmanager_customer_gateway_association",
+		F:    sweepCustomerGatewayAssociations,
+	})
+}
+
+// sweepGlobalNetworkObjects calls f for each global network and sweeps the returned resources.
+func sweepGlobalNetworkObjects(region, description string, f func(client interface{}, conn *networkmanager.NetworkManager, globalNetworkID string) ([]*sweep.SweepResource, error)) error {
+	client, err := sweep.SharedRegionalSweepClient(region)
+
+	if err != nil {
+		return fmt.Errorf("error getting client: %w", err)
+	}
+
+	conn := client.(*conns.AWSClient).NetworkManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.DescribeGlobalNetworksPages(&networkmanager.DescribeGlobalNetworksInput{}, func(page *networkmanager.DescribeGlobalNetworksOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.GlobalNetworks {
+			globalNetworkID := aws.StringValue(v.GlobalNetworkId)
+			resources, err := f(client, conn, globalNetworkID)
+
+			if err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager %s (%s): %w", description, globalNetworkID, err))
+				continue
+			}
+
+			sweepResources = append(sweepResources, resources...)
+		}
+
+		return !lastPage
+	})
+
+	if sweep.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping Network Manager %s sweep for %s: %s", description, region, err)
+		return nil
+	}
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Network Manager Global Networks (%s): %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Network Manager %s (%s): %w", description, region, err))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func sweepGlobalNetworks(region string) error {
+	return sweepGlobalNetworkObjects(region, "Global Networks", func(client interface{}, conn *networkmanager.NetworkManager, globalNetworkID string) ([]*sweep.SweepResource, error) {
+		r := ResourceGlobalNetwork()
+		d := r.Data(nil)
+		d.SetId(globalNetworkID)
+
+		return []*sweep.SweepResource{sweep.NewSweepResource(r, d, client)}, nil
+	})
+}
+
+func sweepSites(region string) error {
+	return sweepGlobalNetworkObjects(region, "Sites", func(client interface{}, conn *networkmanager.NetworkManager, globalNetworkID string) ([]*sweep.SweepResource, error) {
+		var sweepResources []*sweep.SweepResource
+
+		err := conn.GetSitesPages(&networkmanager.GetSitesInput{GlobalNetworkId: aws.String(globalNetworkID)}, func(page *networkmanager.GetSitesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Sites {
+				r := ResourceSite()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(v.SiteId))
+				d.Set("global_network_id", globalNetworkID)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		return sweepResources, err
+	})
+}
+
+func sweepDevices(region string) error {
+	return sweepGlobalNetworkObjects(region, "Devices", func(client interface{}, conn *networkmanager.NetworkManager, globalNetworkID string) ([]*sweep.SweepResource, error) {
+		var sweepResources []*sweep.SweepResource
+
+		err := conn.GetDevicesPages(&networkmanager.GetDevicesInput{GlobalNetworkId: aws.String(globalNetworkID)}, func(page *networkmanager.GetDevicesOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Devices {
+				r := ResourceDevice()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(v.DeviceId))
+				d.Set("global_network_id", globalNetworkID)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		return sweepResources, err
+	})
+}
+
+func sweepLinks(region string) error {
+	return sweepGlobalNetworkObjects(region, "Links", func(client interface{}, conn *networkmanager.NetworkManager, globalNetworkID string) ([]*sweep.SweepResource, error) {
+		var sweepResources []*sweep.SweepResource
+
+		err := conn.GetLinksPages(&networkmanager.GetLinksInput{GlobalNetworkId: aws.String(globalNetworkID)}, func(page *networkmanager.GetLinksOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.Links {
+				r := ResourceLink()
+				d := r.Data(nil)
+				d.SetId(aws.StringValue(v.LinkId))
+				d.Set("global_network_id", globalNetworkID)
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		return sweepResources, err
+	})
+}
+
+func sweepLinkAssociations(region string) error {
+	return sweepGlobalNetworkObjects(region, "Link Associations", func(client interface{}, conn *networkmanager.NetworkManager, globalNetworkID string) ([]*sweep.SweepResource, error) {
+		var sweepResources []*sweep.SweepResource
+
+		err := conn.GetLinkAssociationsPages(&networkmanager.GetLinkAssociationsInput{GlobalNetworkId: aws.String(globalNetworkID)}, func(page *networkmanager.GetLinkAssociationsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.LinkAssociations {
+				r := ResourceLinkAssociation()
+				d := r.Data(nil)
+				d.SetId(LinkAssociationCreateResourceID(globalNetworkID, aws.StringValue(v.LinkId), aws.StringValue(v.DeviceId)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		return sweepResources, err
+	})
+}
+
+func sweepTransitGatewayRegistrations(region string) error {
+	return sweepGlobalNetworkObjects(region, "Transit Gateway Registrations", func(client interface{}, conn *networkmanager.NetworkManager, globalNetworkID string) ([]*sweep.SweepResource, error) {
+		var sweepResources []*sweep.SweepResource
+
+		err := conn.GetTransitGatewayRegistrationsPages(&networkmanager.GetTransitGatewayRegistrationsInput{GlobalNetworkId: aws.String(globalNetworkID)}, func(page *networkmanager.GetTransitGatewayRegistrationsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.TransitGatewayRegistrations {
+				r := ResourceTransitGatewayRegistration()
+				d := r.Data(nil)
+				d.SetId(TransitGatewayRegistrationCreateResourceID(globalNetworkID, aws.StringValue(v.TransitGatewayArn)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		return sweepResources, err
+	})
+}
+
+func sweepCustomerGatewayAssociations(region string) error {
+	return sweepGlobalNetworkObjects(region, "Customer Gateway Associations", func(client interface{}, conn *networkmanager.NetworkManager, globalNetworkID string) ([]*sweep.SweepResource, error) {
+		var sweepResources []*sweep.SweepResource
+
+		err := conn.GetCustomerGatewayAssociationsPages(&networkmanager.GetCustomerGatewayAssociationsInput{GlobalNetworkId: aws.String(globalNetworkID)}, func(page *networkmanager.GetCustomerGatewayAssociationsOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			for _, v := range page.CustomerGatewayAssociations {
+				r := ResourceCustomerGatewayAssociation()
+				d := r.Data(nil)
+				d.SetId(CustomerGatewayAssociationCreateResourceID(globalNetworkID, aws.StringValue(v.CustomerGatewayArn)))
+
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+			}
+
+			return !lastPage
+		})
+
+		return sweepResources, err
+	})
+}
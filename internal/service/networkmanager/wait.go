@@ -0,0 +1,54 @@
+package networkmanager
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/networkmanager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func waitTransitGatewayRegistrationCreated(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string, timeout time.Duration) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStatePending},
+		Target:  []string{networkmanager.TransitGatewayRegistrationStateAvailable},
+		Timeout: timeout,
+		Refresh: statusTransitGatewayRegistration(conn, globalNetworkID, transitGatewayARN),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		if status := output.State; status != nil && aws.StringValue(status.Code) == networkmanager.TransitGatewayRegistrationStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitTransitGatewayRegistrationDeleted(ctx context.Context, conn *networkmanager.NetworkManager, globalNetworkID, transitGatewayARN string, timeout time.Duration) (*networkmanager.TransitGatewayRegistration, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{networkmanager.TransitGatewayRegistrationStateAvailable, networkmanager.TransitGatewayRegistrationStateDeleting},
+		Target:  []string{},
+		Timeout: timeout,
+		Refresh: statusTransitGatewayRegistration(conn, globalNetworkID, transitGatewayARN),
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*networkmanager.TransitGatewayRegistration); ok {
+		if status := output.State; status != nil && aws.StringValue(status.Code) == networkmanager.TransitGatewayRegistrationStateFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(status.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
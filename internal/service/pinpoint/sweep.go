@@ -9,13 +9,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_pinpoint_app", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_pinpoint_app", &resource.Sweeper{
 		Name: "aws_pinpoint_app",
 		F:    sweepApps,
 	})
@@ -27,34 +28,25 @@ func sweepApps(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).PinpointConn
-
 	input := &pinpoint.GetAppsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.GetApps(input)
-		if err != nil {
-			if sweep.SkipSweepError(err) {
-				log.Printf("[WARN] Skipping Pinpoint app sweep for %s: %s", region, err)
-				return nil
-			}
-			return fmt.Errorf("Error retrieving Pinpoint apps: %s", err)
-		}
 
-		if len(output.ApplicationsResponse.Item) == 0 {
-			log.Print("[DEBUG] No Pinpoint apps to sweep")
-			return nil
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing Pinpoint Apps for %s: %w", region, err))
+			break
 		}
 
 		for _, item := range output.ApplicationsResponse.Item {
-			name := aws.StringValue(item.Name)
+			r := ResourceApp()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(item.Id))
+			d.Set("name", item.Name)
 
-			log.Printf("[INFO] Deleting Pinpoint app %s", name)
-			_, err := conn.DeleteApp(&pinpoint.DeleteAppInput{
-				ApplicationId: item.Id,
-			})
-			if err != nil {
-				return fmt.Errorf("Error deleting Pinpoint app %s: %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if output.ApplicationsResponse.NextToken == nil {
@@ -63,5 +55,14 @@ func sweepApps(region string) error {
 		input.Token = output.ApplicationsResponse.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Pinpoint Apps for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Pinpoint App sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
@@ -9,13 +9,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_qldb_ledger", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_qldb_ledger", &resource.Sweeper{
 		Name: "aws_qldb_ledger",
 		F:    sweepLedgers,
 	})
@@ -30,34 +31,38 @@ func sweepLedgers(region string) error {
 
 	conn := client.(*conns.AWSClient).QLDBConn
 	input := &qldb.ListLedgersInput{}
-	page, err := conn.ListLedgers(input)
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping QLDB Ledger sweep for %s: %s", region, err)
-			return nil
+	err = conn.ListLedgersPages(input, func(page *qldb.ListLedgersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
-		return fmt.Errorf("Error listing QLDB Ledgers: %s", err)
-	}
 
-	for _, item := range page.Ledgers {
-		input := &qldb.DeleteLedgerInput{
-			Name: item.Name,
+		for _, ledger := range page.Ledgers {
+			r := ResourceLedger()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(ledger.Name))
+			d.Set("name", ledger.Name)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
-		name := aws.StringValue(item.Name)
 
-		log.Printf("[INFO] Deleting QLDB Ledger: %s", name)
-		_, err = conn.DeleteLedger(input)
+		return !lastPage
+	})
 
-		if err != nil {
-			log.Printf("[ERROR] Failed to delete QLDB Ledger %s: %s", name, err)
-			continue
-		}
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing QLDB Ledgers for %s: %w", region, err))
+	}
 
-		if err := WaitForLedgerDeletion(conn, name); err != nil {
-			log.Printf("[ERROR] Error waiting for QLDB Ledger (%s) deletion: %s", name, err)
-		}
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping QLDB Ledgers for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping QLDB Ledger sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
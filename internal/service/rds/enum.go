@@ -22,6 +22,23 @@ const (
 	InstanceStatusStorageOptimization           = "storage-optimization"
 )
 
+const (
+	BlueGreenDeploymentStatusAvailable            = "AVAILABLE"
+	BlueGreenDeploymentStatusDeleting             = "DELETING"
+	BlueGreenDeploymentStatusInvalidConfiguration = "INVALID_CONFIGURATION"
+	BlueGreenDeploymentStatusProvisioning         = "PROVISIONING"
+	BlueGreenDeploymentStatusSwitchoverCompleted  = "SWITCHOVER_COMPLETED"
+	BlueGreenDeploymentStatusSwitchoverFailed     = "SWITCHOVER_FAILED"
+	BlueGreenDeploymentStatusSwitchoverInProgress = "SWITCHOVER_IN_PROGRESS"
+)
+
+const (
+	BlueGreenDeploymentTaskStatusCompleted  = "COMPLETED"
+	BlueGreenDeploymentTaskStatusFailed     = "FAILED"
+	BlueGreenDeploymentTaskStatusInProgress = "IN_PROGRESS"
+	BlueGreenDeploymentTaskStatusPending    = "PENDING"
+)
+
 const (
 	EventSubscriptionStatusActive    = "active"
 	EventSubscriptionStatusCreating  = "creating"
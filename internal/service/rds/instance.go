@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -118,6 +119,19 @@ func ResourceInstance() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: verify.ValidOnceADayWindowFormat,
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"ca_cert_identifier": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -1569,6 +1583,10 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		log.Println("[INFO] Only settings updating, instance changes will be applied in next maintenance window")
 	}
 
+	// Engine version and parameter group changes are applied to the green environment
+	// of a blue/green deployment when blue/green updates are enabled.
+	blueGreenUpdate := instanceBlueGreenUpdateEnabled(d) && d.HasChanges("engine_version", "parameter_group_name")
+
 	requestUpdate := false
 	if d.HasChanges("allocated_storage", "iops") {
 		req.Iops = aws.Int64(int64(d.Get("iops").(int)))
@@ -1604,11 +1622,11 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 		req.DBInstanceClass = aws.String(d.Get("instance_class").(string))
 		requestUpdate = true
 	}
-	if d.HasChange("parameter_group_name") {
+	if d.HasChange("parameter_group_name") && !blueGreenUpdate {
 		req.DBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
 		requestUpdate = true
 	}
-	if d.HasChange("engine_version") {
+	if d.HasChange("engine_version") && !blueGreenUpdate {
 		req.EngineVersion = aws.String(d.Get("engine_version").(string))
 		req.AllowMajorVersionUpgrade = aws.Bool(d.Get("allow_major_version_upgrade").(bool))
 		requestUpdate = true
@@ -1748,34 +1766,19 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Send DB Instance Modification request: %t", requestUpdate)
-	if requestUpdate {
-		log.Printf("[DEBUG] DB Instance Modification request: %s", req)
-
-		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
-			_, err := conn.ModifyDBInstance(req)
-
-			// Retry for IAM eventual consistency
-			if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
-				return resource.RetryableError(err)
-			}
-
-			if err != nil {
-				return resource.NonRetryableError(err)
-			}
-
-			return nil
-		})
-
-		if tfresource.TimedOut(err) {
-			_, err = conn.ModifyDBInstance(req)
+	if blueGreenUpdate {
+		if err := instanceBlueGreenUpdate(d, conn, req, requestUpdate); err != nil {
+			return err
 		}
+	} else if requestUpdate {
+		log.Printf("[DEBUG] DB Instance Modification request: %s", req)
 
-		if err != nil {
+		if err := modifyDBInstance(conn, req); err != nil {
 			return fmt.Errorf("Error modifying DB Instance %s: %s", d.Id(), err)
 		}
 
 		log.Printf("[DEBUG] Waiting for DB Instance (%s) to be available", d.Id())
-		err = waitUntilDBInstanceAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
+		err := waitUntilDBInstanceAvailableAfterUpdate(d.Id(), conn, d.Timeout(schema.TimeoutUpdate))
 		if err != nil {
 			return fmt.Errorf("error waiting for DB Instance (%s) to be available: %s", d.Id(), err)
 		}
@@ -1815,6 +1818,231 @@ func resourceInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
 	return resourceInstanceRead(d, meta)
 }
 
+// modifyDBInstance modifies a DB instance, retrying for IAM eventual consistency.
+func modifyDBInstance(conn *rds.RDS, input *rds.ModifyDBInstanceInput) error {
+	err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
+		_, err := conn.ModifyDBInstance(input)
+
+		// Retry for IAM eventual consistency
+		if tfawserr.ErrMessageContains(err, "InvalidParameterValue", "IAM role ARN value is invalid or does not include the required permissions") {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.ModifyDBInstance(input)
+	}
+
+	return err
+}
+
+func instanceBlueGreenUpdateEnabled(d *schema.ResourceData) bool {
+	if v, ok := d.GetOk("blue_green_update"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		return v.([]interface{})[0].(map[string]interface{})["enabled"].(bool)
+	}
+
+	return false
+}
+
+// instanceBlueGreenUpdate applies engine version and parameter group changes via an RDS blue/green deployment.
+// A green copy of the DB instance is created with the new settings and any other pending modifications,
+// traffic is switched over to it, and the blue/green deployment and the old (blue) DB instance are deleted.
+// The green DB instance takes over the identifier of the original, so the resource ID does not change.
+func instanceBlueGreenUpdate(d *schema.ResourceData, conn *rds.RDS, req *rds.ModifyDBInstanceInput, requestUpdate bool) error {
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	// Fail before creating any resources if the old DB instance could not be deleted afterwards.
+	skipFinalSnapshot := d.Get("skip_final_snapshot").(bool)
+	finalSnapshotID := d.Get("final_snapshot_identifier").(string)
+
+	if !skipFinalSnapshot && finalSnapshotID == "" {
+		return fmt.Errorf("final_snapshot_identifier is required when skip_final_snapshot is false")
+	}
+
+	source, err := FindDBInstanceByID(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error reading DB Instance (%s): %w", d.Id(), err)
+	}
+
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(resource.PrefixedUniqueId("tf-")),
+		Source:                  source.DBInstanceArn,
+	}
+
+	if d.HasChange("engine_version") {
+		input.TargetEngineVersion = aws.String(d.Get("engine_version").(string))
+	}
+
+	if d.HasChange("parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(d.Get("parameter_group_name").(string))
+	}
+
+	log.Printf("[DEBUG] Creating RDS Blue/Green Deployment: %s", input)
+	output, err := conn.CreateBlueGreenDeployment(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating RDS Blue/Green Deployment for DB Instance (%s): %w", d.Id(), err)
+	}
+
+	id := aws.StringValue(output.BlueGreenDeployment.BlueGreenDeploymentIdentifier)
+
+	deployment, err := waitBlueGreenDeploymentAvailable(conn, id, timeout)
+
+	if err != nil {
+		return instanceBlueGreenUpdateAbort(conn, id, d.Id(), fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) to be available: %w", id, err))
+	}
+
+	targetARN, err := arn.Parse(aws.StringValue(deployment.Target))
+
+	if err != nil {
+		return instanceBlueGreenUpdateAbort(conn, id, d.Id(), fmt.Errorf("error parsing RDS Blue/Green Deployment (%s) target (%s): %w", id, aws.StringValue(deployment.Target), err))
+	}
+
+	targetID := strings.TrimPrefix(targetARN.Resource, "db:")
+
+	if err := waitUntilDBInstanceAvailableAfterUpdate(targetID, conn, timeout); err != nil {
+		return instanceBlueGreenUpdateAbort(conn, id, d.Id(), fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) target DB Instance (%s) to be available: %w", id, targetID, err))
+	}
+
+	// Apply all other pending modifications to the green environment before switchover.
+	if requestUpdate {
+		req.ApplyImmediately = aws.Bool(true)
+		req.DBInstanceIdentifier = aws.String(targetID)
+
+		log.Printf("[DEBUG] DB Instance Modification request: %s", req)
+		if err := modifyDBInstance(conn, req); err != nil {
+			return instanceBlueGreenUpdateAbort(conn, id, d.Id(), fmt.Errorf("error modifying RDS Blue/Green Deployment (%s) target DB Instance (%s): %w", id, targetID, err))
+		}
+
+		if err := waitUntilDBInstanceAvailableAfterUpdate(targetID, conn, timeout); err != nil {
+			return instanceBlueGreenUpdateAbort(conn, id, d.Id(), fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) target DB Instance (%s) to be available: %w", id, targetID, err))
+		}
+	}
+
+	log.Printf("[DEBUG] Switching over RDS Blue/Green Deployment: %s", id)
+	_, err = conn.SwitchoverBlueGreenDeployment(&rds.SwitchoverBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+	})
+
+	if err != nil {
+		return instanceBlueGreenUpdateAbort(conn, id, d.Id(), fmt.Errorf("error switching over RDS Blue/Green Deployment (%s): %w", id, err))
+	}
+
+	// RDS rolls back a failed switchover. The deployment is left in place so that it can be inspected.
+	if _, err := waitBlueGreenDeploymentSwitchoverCompleted(conn, id, timeout); err != nil {
+		return fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) switchover: %w", id, err)
+	}
+
+	log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment: %s", id)
+	_, err = conn.DeleteBlueGreenDeployment(&rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+	})
+
+	if err != nil && !tfawserr.ErrCodeEquals(err, rds.ErrCodeBlueGreenDeploymentNotFoundFault) {
+		return fmt.Errorf("error deleting RDS Blue/Green Deployment (%s): %w", id, err)
+	}
+
+	if _, err := waitBlueGreenDeploymentDeleted(conn, id, blueGreenDeploymentDeletedTimeout); err != nil {
+		return fmt.Errorf("error waiting for RDS Blue/Green Deployment (%s) delete: %w", id, err)
+	}
+
+	// The old (blue) DB instance has been renamed, so look it up by its resource ID.
+	old, err := FindDBInstanceByResourceID(conn, aws.StringValue(source.DbiResourceId))
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading old DB Instance (%s) after RDS Blue/Green Deployment (%s) switchover: %w", aws.StringValue(source.DbiResourceId), id, err)
+	}
+
+	oldID := aws.StringValue(old.DBInstanceIdentifier)
+
+	if aws.BoolValue(old.DeletionProtection) {
+		log.Printf("[DEBUG] Disabling deletion protection on old DB Instance: %s", oldID)
+		_, err := conn.ModifyDBInstance(&rds.ModifyDBInstanceInput{
+			ApplyImmediately:     aws.Bool(true),
+			DBInstanceIdentifier: aws.String(oldID),
+			DeletionProtection:   aws.Bool(false),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error disabling deletion protection on old DB Instance (%s): %w", oldID, err)
+		}
+
+		if err := waitUntilDBInstanceAvailableAfterUpdate(oldID, conn, timeout); err != nil {
+			return fmt.Errorf("error waiting for old DB Instance (%s) to be available: %w", oldID, err)
+		}
+	}
+
+	deleteInput := &rds.DeleteDBInstanceInput{
+		DBInstanceIdentifier:   aws.String(oldID),
+		DeleteAutomatedBackups: aws.Bool(d.Get("delete_automated_backups").(bool)),
+		SkipFinalSnapshot:      aws.Bool(skipFinalSnapshot),
+	}
+
+	// final_snapshot_identifier is kept for the final snapshot of the resource itself,
+	// so the old DB instance's snapshot is suffixed with the blue/green deployment ID.
+	if !skipFinalSnapshot {
+		deleteInput.FinalDBSnapshotIdentifier = aws.String(instanceBlueGreenFinalSnapshotID(finalSnapshotID, id))
+	}
+
+	log.Printf("[DEBUG] Deleting old DB Instance: %s", deleteInput)
+	_, err = conn.DeleteDBInstance(deleteInput)
+
+	if tfawserr.ErrCodeEquals(err, rds.ErrCodeDBInstanceNotFoundFault) {
+		return nil
+	}
+
+	if err != nil && !tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidDBInstanceStateFault, "is already being deleted") {
+		return fmt.Errorf("error deleting old DB Instance (%s): %w", oldID, err)
+	}
+
+	if _, err := waitDBInstanceDeleted(conn, oldID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for old DB Instance (%s) delete: %w", oldID, err)
+	}
+
+	return nil
+}
+
+// instanceBlueGreenFinalSnapshotID returns the identifier of the final snapshot of the old (blue) DB instance
+// deleted after a blue/green deployment switchover.
+func instanceBlueGreenFinalSnapshotID(finalSnapshotID, blueGreenDeploymentID string) string {
+	return fmt.Sprintf("%s-%s", finalSnapshotID, blueGreenDeploymentID)
+}
+
+// instanceBlueGreenUpdateAbort deletes a blue/green deployment that failed before switchover, together with its
+// green environment, leaving the source DB instance unchanged. Any error doing so is reported alongside the original error.
+func instanceBlueGreenUpdateAbort(conn *rds.RDS, id, sourceID string, err error) error {
+	log.Printf("[DEBUG] Deleting RDS Blue/Green Deployment (%s) for DB Instance (%s) after error: %s", id, sourceID, err)
+	_, deleteErr := conn.DeleteBlueGreenDeployment(&rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(id),
+		DeleteTarget:                  aws.Bool(true),
+	})
+
+	if tfawserr.ErrCodeEquals(deleteErr, rds.ErrCodeBlueGreenDeploymentNotFoundFault) {
+		return err
+	}
+
+	if deleteErr == nil {
+		_, deleteErr = waitBlueGreenDeploymentDeleted(conn, id, blueGreenDeploymentDeletedTimeout)
+	}
+
+	if deleteErr != nil {
+		return fmt.Errorf("%w; additionally, error deleting RDS Blue/Green Deployment (%s): %s", err, id, deleteErr)
+	}
+
+	return err
+}
+
 // resourceInstanceRetrieve fetches DBInstance information from the AWS
 // API. It returns an error if there is a communication problem or unexpected
 // error with AWS. When the DBInstance is not found, it returns no error and a
@@ -117,6 +117,44 @@ func TestAccRDSInstance_onlyMajorVersion(t *testing.T) {
 	})
 }
 
+func TestAccRDSInstance_BlueGreenDeployment_updateEngineVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var dbInstance1, dbInstance2 rds.DBInstance
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_db_instance.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, rds.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_engineVersion(rName, `"5.7.40", "5.7.41", "5.7.42"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance1),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", "data.aws_rds_engine_version.test", "version"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccInstanceConfig_BlueGreenDeployment_engineVersion(rName, `"8.0.32", "8.0.33", "8.0.34"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(resourceName, &dbInstance2),
+					testAccCheckInstanceReplacedByBlueGreenDeployment(&dbInstance1, &dbInstance2),
+					resource.TestCheckResourceAttr(resourceName, "identifier", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "engine_version", "data.aws_rds_engine_version.test", "version"),
+					resource.TestCheckResourceAttr(resourceName, "parameter_group_name", "default.mysql8.0"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRDSInstance_namePrefix(t *testing.T) {
 	var v rds.DBInstance
 
@@ -2887,6 +2925,22 @@ func testAccCheckInstanceNotRecreated(instance1, instance2 *rds.DBInstance) reso
 	}
 }
 
+// testAccCheckInstanceReplacedByBlueGreenDeployment checks that a DB instance kept its identifier
+// but is backed by a different (green) DB instance.
+func testAccCheckInstanceReplacedByBlueGreenDeployment(instance1, instance2 *rds.DBInstance) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(instance1.DBInstanceIdentifier) != aws.StringValue(instance2.DBInstanceIdentifier) {
+			return fmt.Errorf("database instance identifier changed. expected: %s, got: %s", aws.StringValue(instance1.DBInstanceIdentifier), aws.StringValue(instance2.DBInstanceIdentifier))
+		}
+
+		if aws.StringValue(instance1.DbiResourceId) == aws.StringValue(instance2.DbiResourceId) {
+			return fmt.Errorf("database instance was not replaced by blue/green deployment: %s", aws.StringValue(instance1.DbiResourceId))
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckInstanceExists(n string, v *rds.DBInstance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -3639,6 +3693,44 @@ resource "aws_db_instance" "test" {
 `, engine, engineVersion))
 }
 
+func testAccInstanceConfig_BlueGreenDeployment_engineVersion(rName, preferredVersions string) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine             = "mysql"
+  preferred_versions = [%[2]s]
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine         = data.aws_rds_engine_version.test.engine
+  engine_version = data.aws_rds_engine_version.test.version
+  license_model  = "general-public-license"
+  storage_type   = "gp2"
+
+  preferred_instance_classes = ["db.t3.micro", "db.t3.small", "db.t3.medium"]
+}
+
+resource "aws_db_instance" "test" {
+  identifier                  = %[1]q
+  allocated_storage           = 10
+  allow_major_version_upgrade = true
+  apply_immediately           = true
+  backup_retention_period     = 1
+  engine                      = data.aws_rds_engine_version.test.engine
+  engine_version              = data.aws_rds_engine_version.test.version
+  instance_class              = data.aws_rds_orderable_db_instance.test.instance_class
+  name                        = "test"
+  parameter_group_name        = "default.${data.aws_rds_engine_version.test.parameter_group_family}"
+  password                    = "avoid-plaintext-passwords"
+  skip_final_snapshot         = true
+  username                    = "tfacctest"
+
+  blue_green_update {
+    enabled = true
+  }
+}
+`, rName, preferredVersions)
+}
+
 func testAccInstanceConfig_namePrefix() string {
 	return acctest.ConfigCompose(testAccInstanceConfig_orderableClassMySQL(), `
 resource "aws_db_instance" "test" {
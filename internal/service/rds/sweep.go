@@ -7,11 +7,9 @@ import (
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -19,7 +17,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster_parameter_group", &resource.Sweeper{
 		Name: "aws_rds_cluster_parameter_group",
 		F:    sweepClusterParameterGroups,
 		Dependencies: []string{
@@ -27,12 +25,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_cluster_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_cluster_snapshot", &resource.Sweeper{
 		Name: "aws_db_cluster_snapshot",
 		F:    sweepClusterSnapshots,
 	})
 
-	resource.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_cluster", &resource.Sweeper{
 		Name: "aws_rds_cluster",
 		F:    sweepClusters,
 		Dependencies: []string{
@@ -40,12 +38,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_event_subscription", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_event_subscription", &resource.Sweeper{
 		Name: "aws_db_event_subscription",
 		F:    sweepEventSubscriptions,
 	})
 
-	resource.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_rds_global_cluster", &resource.Sweeper{
 		Name: "aws_rds_global_cluster",
 		F:    sweepGlobalClusters,
 		Dependencies: []string{
@@ -53,17 +51,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_instance", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_instance", &resource.Sweeper{
 		Name: "aws_db_instance",
 		F:    sweepInstances,
 	})
 
-	resource.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_option_group", &resource.Sweeper{
 		Name: "aws_db_option_group",
 		F:    sweepOptionGroups,
 	})
 
-	resource.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_parameter_group", &resource.Sweeper{
 		Name: "aws_db_parameter_group",
 		F:    sweepParameterGroups,
 		Dependencies: []string{
@@ -71,12 +69,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_proxy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_proxy", &resource.Sweeper{
 		Name: "aws_db_proxy",
 		F:    sweepProxies,
 	})
 
-	resource.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_snapshot", &resource.Sweeper{
 		Name: "aws_db_snapshot",
 		F:    sweepSnapshots,
 		Dependencies: []string{
@@ -84,7 +82,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_db_subnet_group", &resource.Sweeper{
 		Name: "aws_db_subnet_group",
 		F:    sweepSubnetGroups,
 		Dependencies: []string{
@@ -99,19 +97,16 @@ func sweepClusterParameterGroups(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).RDSConn
-
 	input := &rds.DescribeDBClusterParameterGroupsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.DescribeDBClusterParameterGroups(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping RDS DB Cluster Parameter Group sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error retrieving DB Cluster Parameter Groups: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error describing RDS DB Cluster Parameter Groups for %s: %w", region, err))
+			break
 		}
 
 		for _, dbcpg := range output.DBClusterParameterGroups {
@@ -119,9 +114,6 @@ func sweepClusterParameterGroups(region string) error {
 				continue
 			}
 
-			input := &rds.DeleteDBClusterParameterGroupInput{
-				DBClusterParameterGroupName: dbcpg.DBClusterParameterGroupName,
-			}
 			name := aws.StringValue(dbcpg.DBClusterParameterGroupName)
 
 			if strings.HasPrefix(name, "default.") {
@@ -129,14 +121,12 @@ func sweepClusterParameterGroups(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting DB Cluster Parameter Group: %s", name)
-
-			_, err := conn.DeleteDBClusterParameterGroup(input)
+			r := ResourceClusterParameterGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DB Cluster Parameter Group %s: %s", name, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.Marker) == "" {
@@ -146,7 +136,16 @@ func sweepClusterParameterGroups(region string) error {
 		input.Marker = output.Marker
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping RDS DB Cluster Parameter Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping RDS DB Cluster Parameter Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepClusterSnapshots(region string) error {
@@ -162,116 +161,106 @@ func sweepClusterSnapshots(region string) error {
 			Values: aws.StringSlice([]string{"manual"}),
 		}},
 	}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.DescribeDBClusterSnapshots(input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping RDS DB Cluster Snapshots sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-		}
+
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving RDS DB Cluster Snapshots: %w", err))
-			return sweeperErrs
+			errs = multierror.Append(errs, fmt.Errorf("error describing RDS DB Cluster Snapshots for %s: %w", region, err))
+			break
 		}
 
 		for _, dbClusterSnapshot := range output.DBClusterSnapshots {
-			id := aws.StringValue(dbClusterSnapshot.DBClusterSnapshotIdentifier)
+			r := ResourceClusterSnapshot()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(dbClusterSnapshot.DBClusterSnapshotIdentifier))
+			d.Set("db_cluster_snapshot_identifier", dbClusterSnapshot.DBClusterSnapshotIdentifier)
 
-			log.Printf("[INFO] Deleting RDS DB Cluster Snapshot: %s", id)
-			_, err := conn.DeleteDBClusterSnapshot(&rds.DeleteDBClusterSnapshotInput{
-				DBClusterSnapshotIdentifier: aws.String(id),
-			})
-			if tfawserr.ErrMessageContains(err, rds.ErrCodeDBClusterSnapshotNotFoundFault, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting RDS DB Cluster Snapshot (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.Marker) == "" {
 			break
 		}
+
 		input.Marker = output.Marker
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping RDS DB Cluster Snapshots for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping RDS DB Cluster Snapshot sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepClusters(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-
 	conn := client.(*conns.AWSClient).RDSConn
 	input := &rds.DescribeDBClustersInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.DescribeDBClustersPages(input, func(page *rds.DescribeDBClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-	err = conn.DescribeDBClustersPages(input, func(out *rds.DescribeDBClustersOutput, lastPage bool) bool {
-		for _, cluster := range out.DBClusters {
+		for _, cluster := range page.DBClusters {
 			id := aws.StringValue(cluster.DBClusterIdentifier)
 
-			// Automatically remove from global cluster to bypass this error on deletion:
+			r := ResourceCluster()
+			d := r.Data(nil)
+			d.SetId(id)
+			d.Set("arn", cluster.DBClusterArn)
+			d.Set("cluster_identifier", id)
+			d.Set("skip_final_snapshot", true)
+
+			// Set the global cluster so that the resource removes the cluster from it
+			// to bypass this error on deletion:
 			// InvalidDBClusterStateFault: This cluster is a part of a global cluster, please remove it from globalcluster first
 			if aws.StringValue(cluster.EngineMode) == "global" {
 				globalCluster, err := DescribeGlobalClusterFromClusterARN(conn, aws.StringValue(cluster.DBClusterArn))
 
 				if err != nil {
-					log.Printf("[ERROR] Failure reading RDS Global Cluster information for DB Cluster (%s): %s", id, err)
+					errs = multierror.Append(errs, fmt.Errorf("error reading RDS Global Cluster information for DB Cluster (%s): %w", id, err))
+					continue
 				}
 
 				if globalCluster != nil {
-					globalClusterID := aws.StringValue(globalCluster.GlobalClusterIdentifier)
-					input := &rds.RemoveFromGlobalClusterInput{
-						DbClusterIdentifier:     cluster.DBClusterArn,
-						GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
-					}
-
-					log.Printf("[INFO] Removing RDS Cluster (%s) from RDS Global Cluster: %s", id, globalClusterID)
-					_, err = conn.RemoveFromGlobalCluster(input)
-
-					if err != nil {
-						log.Printf("[ERROR] Failure removing RDS Cluster (%s) from RDS Global Cluster (%s): %s", id, globalClusterID, err)
-					}
+					d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
 				}
 			}
 
-			input := &rds.DeleteDBClusterInput{
-				DBClusterIdentifier: cluster.DBClusterIdentifier,
-				SkipFinalSnapshot:   aws.Bool(true),
-			}
-
-			log.Printf("[INFO] Deleting RDS DB Cluster: %s", id)
-
-			_, err := conn.DeleteDBCluster(input)
-
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete RDS DB Cluster (%s): %s", id, err)
-				continue
-			}
-
-			if err := WaitForClusterDeletion(conn, id, 40*time.Minute); err != nil { //nolint:gomnd
-				log.Printf("[ERROR] Failure while waiting for RDS DB Cluster (%s) to be deleted: %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping RDS DB Cluster sweep for %s: %s", region, err)
-		return nil
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing RDS DB Clusters for %s: %w", region, err))
 	}
 
-	if err != nil {
-		return fmt.Errorf("error retrieving RDS DB Clusters: %s", err)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping RDS DB Clusters for %s: %w", region, err))
 	}
 
-	return nil
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping RDS DB Cluster sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepEventSubscriptions(region string) error {
@@ -319,47 +308,45 @@ func sweepEventSubscriptions(region string) error {
 
 func sweepGlobalClusters(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-
 	conn := client.(*conns.AWSClient).RDSConn
 	input := &rds.DescribeGlobalClustersInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	err = conn.DescribeGlobalClustersPages(input, func(out *rds.DescribeGlobalClustersOutput, lastPage bool) bool {
-		for _, globalCluster := range out.GlobalClusters {
-			id := aws.StringValue(globalCluster.GlobalClusterIdentifier)
-			input := &rds.DeleteGlobalClusterInput{
-				GlobalClusterIdentifier: globalCluster.GlobalClusterIdentifier,
-			}
-
-			log.Printf("[INFO] Deleting RDS Global Cluster: %s", id)
-
-			_, err := conn.DeleteGlobalCluster(input)
+	err = conn.DescribeGlobalClustersPages(input, func(page *rds.DescribeGlobalClustersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete RDS Global Cluster (%s): %s", id, err)
-				continue
-			}
+		for _, globalCluster := range page.GlobalClusters {
+			r := ResourceGlobalCluster()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(globalCluster.GlobalClusterIdentifier))
+			d.Set("global_cluster_identifier", globalCluster.GlobalClusterIdentifier)
 
-			if err := WaitForGlobalClusterDeletion(conn, id); err != nil {
-				log.Printf("[ERROR] Failure while waiting for RDS Global Cluster (%s) to be deleted: %s", id, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping RDS Global Cluster sweep for %s: %s", region, err)
-		return nil
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing RDS Global Clusters for %s: %w", region, err))
 	}
 
-	if err != nil {
-		return fmt.Errorf("error retrieving RDS Global Clusters: %s", err)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping RDS Global Clusters for %s: %w", region, err))
 	}
 
-	return nil
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping RDS Global Cluster sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepInstances(region string) error {
@@ -397,47 +384,48 @@ func sweepOptionGroups(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-
 	conn := client.(*conns.AWSClient).RDSConn
+	input := &rds.DescribeOptionGroupsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	opts := rds.DescribeOptionGroupsInput{}
-	resp, err := conn.DescribeOptionGroups(&opts)
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping RDS DB Option Group sweep for %s: %s", region, err)
-			return nil
+	err = conn.DescribeOptionGroupsPages(input, func(page *rds.DescribeOptionGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
 		}
-		return fmt.Errorf("error describing DB Option Groups in Sweeper: %s", err)
-	}
 
-	for _, og := range resp.OptionGroupsList {
-		if strings.HasPrefix(aws.StringValue(og.OptionGroupName), "default") {
-			continue
-		}
+		for _, og := range page.OptionGroupsList {
+			name := aws.StringValue(og.OptionGroupName)
 
-		log.Printf("[INFO] Deleting RDS Option Group: %s", aws.StringValue(og.OptionGroupName))
+			if strings.HasPrefix(name, "default") {
+				continue
+			}
 
-		deleteOpts := &rds.DeleteOptionGroupInput{
-			OptionGroupName: og.OptionGroupName,
-		}
+			r := ResourceOptionGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
 
-		ret := resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.DeleteOptionGroup(deleteOpts)
-			if err != nil {
-				if tfawserr.ErrMessageContains(err, rds.ErrCodeInvalidOptionGroupStateFault, "") {
-					log.Printf("[DEBUG] AWS believes the RDS Option Group is still in use, retrying")
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
-		if ret != nil {
-			return fmt.Errorf("Error Deleting DB Option Group (%s) in Sweeper: %s", *og.OptionGroupName, ret)
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing RDS DB Option Groups for %s: %w", region, err))
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping RDS DB Option Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping RDS DB Option Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepParameterGroups(region string) error {
@@ -446,16 +434,20 @@ func sweepParameterGroups(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).RDSConn
+	input := &rds.DescribeDBParameterGroupsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.DescribeDBParameterGroupsPages(input, func(page *rds.DescribeDBParameterGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-	err = conn.DescribeDBParameterGroupsPages(&rds.DescribeDBParameterGroupsInput{}, func(out *rds.DescribeDBParameterGroupsOutput, lastPage bool) bool {
-		for _, dbpg := range out.DBParameterGroups {
+		for _, dbpg := range page.DBParameterGroups {
 			if dbpg == nil {
 				continue
 			}
 
-			input := &rds.DeleteDBParameterGroupInput{
-				DBParameterGroupName: dbpg.DBParameterGroupName,
-			}
 			name := aws.StringValue(dbpg.DBParameterGroupName)
 
 			if strings.HasPrefix(name, "default.") {
@@ -463,169 +455,180 @@ func sweepParameterGroups(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting DB Parameter Group: %s", name)
-
-			_, err := conn.DeleteDBParameterGroup(input)
+			r := ResourceParameterGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DB Parameter Group %s: %s", name, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping RDS DB Parameter Group sweep for %s: %s", region, err)
-		return nil
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing RDS DB Parameter Groups for %s: %w", region, err))
 	}
 
-	if err != nil {
-		return fmt.Errorf("error retrieving DB Parameter Groups: %s", err)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping RDS DB Parameter Groups for %s: %w", region, err))
 	}
 
-	return nil
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping RDS DB Parameter Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepProxies(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
 	if err != nil {
-		return fmt.Errorf("Error getting client: %s", err)
+		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).RDSConn
+	input := &rds.DescribeDBProxiesInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	err = conn.DescribeDBProxiesPages(&rds.DescribeDBProxiesInput{}, func(out *rds.DescribeDBProxiesOutput, lastPage bool) bool {
-		for _, dbpg := range out.DBProxies {
-			if dbpg == nil {
-				continue
-			}
+	err = conn.DescribeDBProxiesPages(input, func(page *rds.DescribeDBProxiesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-			input := &rds.DeleteDBProxyInput{
-				DBProxyName: dbpg.DBProxyName,
+		for _, dbProxy := range page.DBProxies {
+			if dbProxy == nil {
+				continue
 			}
-			name := aws.StringValue(dbpg.DBProxyName)
-
-			log.Printf("[INFO] Deleting DB Proxy: %s", name)
 
-			_, err := conn.DeleteDBProxy(input)
+			r := ResourceProxy()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(dbProxy.DBProxyName))
+			d.Set("name", dbProxy.DBProxyName)
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete DB Proxy %s: %s", name, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping RDS DB Proxy sweep for %s: %s", region, err)
-		return nil
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing RDS DB Proxies for %s: %w", region, err))
 	}
 
-	if err != nil {
-		return fmt.Errorf("Error retrieving DB Proxies: %s", err)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping RDS DB Proxies for %s: %w", region, err))
 	}
 
-	return nil
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping RDS DB Proxy sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepSnapshots(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-
 	conn := client.(*conns.AWSClient).RDSConn
 	input := &rds.DescribeDBSnapshotsInput{}
-	var sweeperErrs error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	err = conn.DescribeDBSnapshotsPages(input, func(out *rds.DescribeDBSnapshotsOutput, lastPage bool) bool {
-		if out == nil {
+	err = conn.DescribeDBSnapshotsPages(input, func(page *rds.DescribeDBSnapshotsOutput, lastPage bool) bool {
+		if page == nil {
 			return !lastPage
 		}
 
-		for _, dbSnapshot := range out.DBSnapshots {
+		for _, dbSnapshot := range page.DBSnapshots {
 			if dbSnapshot == nil {
 				continue
 			}
 
 			id := aws.StringValue(dbSnapshot.DBSnapshotIdentifier)
-			input := &rds.DeleteDBSnapshotInput{
-				DBSnapshotIdentifier: dbSnapshot.DBSnapshotIdentifier,
-			}
 
 			if strings.HasPrefix(id, "rds:") {
 				log.Printf("[INFO] Skipping RDS Automated DB Snapshot: %s", id)
 				continue
 			}
 
-			log.Printf("[INFO] Deleting RDS DB Snapshot: %s", id)
-			_, err := conn.DeleteDBSnapshot(input)
-
-			if tfawserr.ErrMessageContains(err, rds.ErrCodeDBSnapshotNotFoundFault, "") {
-				continue
-			}
+			r := ResourceSnapshot()
+			d := r.Data(nil)
+			d.SetId(id)
+			d.Set("db_snapshot_identifier", id)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting RDS DB Snapshot (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping RDS DB Snapshot sweep for %s: %s", region, err)
-		return nil
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing RDS DB Snapshots for %s: %w", region, err))
 	}
 
-	if err != nil {
-		return fmt.Errorf("error describing RDS DB Snapshots: %s", err)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping RDS DB Snapshots for %s: %w", region, err))
 	}
 
-	return sweeperErrs
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping RDS DB Snapshot sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepSubnetGroups(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-
 	conn := client.(*conns.AWSClient).RDSConn
 	input := &rds.DescribeDBSubnetGroupsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+
+	err = conn.DescribeDBSubnetGroupsPages(input, func(page *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
 
-	err = conn.DescribeDBSubnetGroupsPages(input, func(out *rds.DescribeDBSubnetGroupsOutput, lastPage bool) bool {
-		for _, dbSubnetGroup := range out.DBSubnetGroups {
+		for _, dbSubnetGroup := range page.DBSubnetGroups {
 			name := aws.StringValue(dbSubnetGroup.DBSubnetGroupName)
-			input := &rds.DeleteDBSubnetGroupInput{
-				DBSubnetGroupName: dbSubnetGroup.DBSubnetGroupName,
-			}
 
-			log.Printf("[INFO] Deleting RDS DB Subnet Group: %s", name)
+			if name == "default" {
+				log.Printf("[INFO] Skipping RDS DB Subnet Group: %s", name)
+				continue
+			}
 
-			_, err := conn.DeleteDBSubnetGroup(input)
+			r := ResourceSubnetGroup()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("name", name)
 
-			if err != nil {
-				log.Printf("[ERROR] Failed to delete RDS DB Subnet Group (%s): %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping RDS DB Subnet Group sweep for %s: %s", region, err)
-		return nil
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error describing RDS DB Subnet Groups for %s: %w", region, err))
 	}
 
-	if err != nil {
-		return fmt.Errorf("error retrieving RDS DB Subnet Groups: %s", err)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping RDS DB Subnet Groups for %s: %w", region, err))
 	}
 
-	return nil
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping RDS DB Subnet Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
@@ -1,10 +1,15 @@
 package rds
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 const (
@@ -12,6 +17,8 @@ const (
 
 	dbClusterRoleAssociationCreatedTimeout = 5 * time.Minute
 	dbClusterRoleAssociationDeletedTimeout = 5 * time.Minute
+
+	blueGreenDeploymentDeletedTimeout = 20 * time.Minute
 )
 
 func waitEventSubscriptionCreated(conn *rds.RDS, id string, timeout time.Duration) (*rds.EventSubscription, error) {
@@ -198,3 +205,115 @@ func waitDBClusterInstanceDeleted(conn *rds.RDS, id string, timeout time.Duratio
 
 	return nil, err
 }
+
+func waitBlueGreenDeploymentAvailable(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{BlueGreenDeploymentStatusProvisioning},
+		Target:     []string{BlueGreenDeploymentStatusAvailable},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		tfresource.SetLastError(err, blueGreenDeploymentError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBlueGreenDeploymentSwitchoverCompleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			BlueGreenDeploymentStatusAvailable,
+			BlueGreenDeploymentStatusSwitchoverInProgress,
+		},
+		Target:     []string{BlueGreenDeploymentStatusSwitchoverCompleted},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		tfresource.SetLastError(err, blueGreenDeploymentError(output))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBlueGreenDeploymentDeleted(conn *rds.RDS, id string, timeout time.Duration) (*rds.BlueGreenDeployment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			BlueGreenDeploymentStatusAvailable,
+			BlueGreenDeploymentStatusDeleting,
+			BlueGreenDeploymentStatusInvalidConfiguration,
+			BlueGreenDeploymentStatusProvisioning,
+			BlueGreenDeploymentStatusSwitchoverCompleted,
+			BlueGreenDeploymentStatusSwitchoverFailed,
+		},
+		Target:     []string{},
+		Refresh:    statusBlueGreenDeployment(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+		Delay:      10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*rds.BlueGreenDeployment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+// blueGreenDeploymentError returns an error describing why a blue/green deployment
+// is not progressing, built from its status details, failed tasks and the
+// switchover status of each of its members. It returns nil if there is nothing to report.
+func blueGreenDeploymentError(output *rds.BlueGreenDeployment) error {
+	if output == nil {
+		return nil
+	}
+
+	var details []string
+
+	if v := aws.StringValue(output.StatusDetails); v != "" {
+		details = append(details, v)
+	}
+
+	for _, v := range output.Tasks {
+		if v == nil || aws.StringValue(v.Status) != BlueGreenDeploymentTaskStatusFailed {
+			continue
+		}
+
+		details = append(details, fmt.Sprintf("task %s: %s", aws.StringValue(v.Name), aws.StringValue(v.Status)))
+	}
+
+	for _, v := range output.SwitchoverDetails {
+		if v == nil {
+			continue
+		}
+
+		switch status := aws.StringValue(v.Status); status {
+		case "", BlueGreenDeploymentStatusAvailable, BlueGreenDeploymentStatusProvisioning, BlueGreenDeploymentStatusSwitchoverCompleted, BlueGreenDeploymentStatusSwitchoverInProgress:
+		default:
+			details = append(details, fmt.Sprintf("member %s (target %s): %s", aws.StringValue(v.SourceMember), aws.StringValue(v.TargetMember), status))
+		}
+	}
+
+	if len(details) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(details, "; "))
+}
@@ -0,0 +1,95 @@
+package rds
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/rds"
+)
+
+func TestBlueGreenDeploymentError(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    *rds.BlueGreenDeployment
+		Expected string
+	}{
+		{
+			TestName: "nil",
+			Input:    nil,
+		},
+		{
+			TestName: "no details",
+			Input: &rds.BlueGreenDeployment{
+				Status: aws.String(BlueGreenDeploymentStatusProvisioning),
+				SwitchoverDetails: []*rds.SwitchoverDetail{
+					{
+						SourceMember: aws.String("arn:aws:rds:us-west-2:123456789012:db:blue"),
+						Status:       aws.String(BlueGreenDeploymentStatusProvisioning),
+						TargetMember: aws.String("arn:aws:rds:us-west-2:123456789012:db:green"),
+					},
+				},
+				Tasks: []*rds.BlueGreenDeploymentTask{
+					{
+						Name:   aws.String("CREATING_READ_REPLICA_OF_SOURCE"),
+						Status: aws.String(BlueGreenDeploymentTaskStatusInProgress),
+					},
+				},
+			},
+		},
+		{
+			TestName: "status details",
+			Input: &rds.BlueGreenDeployment{
+				Status:        aws.String(BlueGreenDeploymentStatusInvalidConfiguration),
+				StatusDetails: aws.String("Binary logging must be enabled"),
+			},
+			Expected: "Binary logging must be enabled",
+		},
+		{
+			TestName: "failed task and member",
+			Input: &rds.BlueGreenDeployment{
+				Status:        aws.String(BlueGreenDeploymentStatusSwitchoverFailed),
+				StatusDetails: aws.String("Switchover failed"),
+				SwitchoverDetails: []*rds.SwitchoverDetail{
+					{
+						SourceMember: aws.String("arn:aws:rds:us-west-2:123456789012:db:blue"),
+						Status:       aws.String("MISSING_TARGET"),
+						TargetMember: aws.String("arn:aws:rds:us-west-2:123456789012:db:green"),
+					},
+				},
+				Tasks: []*rds.BlueGreenDeploymentTask{
+					{
+						Name:   aws.String("CREATING_READ_REPLICA_OF_SOURCE"),
+						Status: aws.String(BlueGreenDeploymentTaskStatusCompleted),
+					},
+					{
+						Name:   aws.String("DB_ENGINE_VERSION_UPGRADE"),
+						Status: aws.String(BlueGreenDeploymentTaskStatusFailed),
+					},
+				},
+			},
+			Expected: "Switchover failed; task DB_ENGINE_VERSION_UPGRADE: FAILED; member arn:aws:rds:us-west-2:123456789012:db:blue (target arn:aws:rds:us-west-2:123456789012:db:green): MISSING_TARGET",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := blueGreenDeploymentError(testCase.Input)
+
+			if testCase.Expected == "" {
+				if err != nil {
+					t.Errorf("got error %q, expected no error", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("got no error, expected %q", testCase.Expected)
+			}
+
+			if got := err.Error(); got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
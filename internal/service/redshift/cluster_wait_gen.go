@@ -0,0 +1,71 @@
+// Code generated by "internal/generate/waiter/main.go -Name=Cluster -Finder=FindClusterByID -StatusField=ClusterStatus -DeletePending=clusterStatusAvailable,clusterStatusCreating,clusterStatusDeleting,clusterStatusFinalSnapshot,clusterStatusRebooting,clusterStatusRenaming,clusterStatusResizing"; DO NOT EDIT.
+
+package redshift
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// statusCluster fetches the resource and its ClusterStatus.
+// Returns a nil result if the resource is not found.
+func statusCluster(conn *redshift.Redshift, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindClusterByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.ClusterStatus), nil
+	}
+}
+
+// waitClusterDeleted waits for the resource to be deleted.
+func waitClusterDeleted(conn *redshift.Redshift, id string, timeout time.Duration) (*redshift.Cluster, error) {
+	return waitClusterDeletedWithContext(context.Background(), conn, id, timeout)
+}
+
+func waitClusterDeletedWithContext(ctx context.Context, conn *redshift.Redshift, id string, timeout time.Duration) (*redshift.Cluster, error) {
+	pending := []string{clusterStatusAvailable, clusterStatusCreating, clusterStatusDeleting, clusterStatusFinalSnapshot, clusterStatusRebooting, clusterStatusRenaming, clusterStatusResizing}
+
+	var output *redshift.Cluster
+
+	err := tfresource.WaitUntilContext(ctx, timeout, func() (bool, error) {
+		outputRaw, status, err := statusCluster(conn, id)()
+
+		if err != nil {
+			return false, err
+		}
+
+		if outputRaw == nil {
+			output = nil
+
+			return true, nil
+		}
+
+		output = outputRaw.(*redshift.Cluster)
+
+		for _, v := range pending {
+			if status == v {
+				return false, nil
+			}
+		}
+
+		return false, &resource.UnexpectedStateError{
+			State:         status,
+			ExpectedState: pending,
+		}
+	}, tfresource.WaitOpts{})
+
+	return output, err
+}
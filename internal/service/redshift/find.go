@@ -3,9 +3,6 @@ package redshift
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/redshift"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
 
 func FindClusterByID(conn *redshift.Redshift, id string) (*redshift.Cluster, error) {
@@ -13,28 +10,7 @@ func FindClusterByID(conn *redshift.Redshift, id string) (*redshift.Cluster, err
 		ClusterIdentifier: aws.String(id),
 	}
 
-	output, err := conn.DescribeClusters(input)
-
-	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeClusterNotFoundFault) {
-		return nil, &resource.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	if output == nil || len(output.Clusters) == 0 || output.Clusters[0] == nil {
-		return nil, tfresource.NewEmptyResultError(input)
-	}
-
-	if count := len(output.Clusters); count > 1 {
-		return nil, tfresource.NewTooManyResultsError(count, input)
-	}
-
-	return output.Clusters[0], nil
+	return findCluster(conn, input, nil)
 }
 
 func FindScheduledActionByName(conn *redshift.Redshift, name string) (*redshift.ScheduledAction, error) {
@@ -42,26 +18,5 @@ func FindScheduledActionByName(conn *redshift.Redshift, name string) (*redshift.
 		ScheduledActionName: aws.String(name),
 	}
 
-	output, err := conn.DescribeScheduledActions(input)
-
-	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeScheduledActionNotFoundFault) {
-		return nil, &resource.NotFoundError{
-			LastError:   err,
-			LastRequest: input,
-		}
-	}
-
-	if err != nil {
-		return nil, err
-	}
-
-	if output == nil || len(output.ScheduledActions) == 0 || output.ScheduledActions[0] == nil {
-		return nil, tfresource.NewEmptyResultError(input)
-	}
-
-	if count := len(output.ScheduledActions); count > 1 {
-		return nil, tfresource.NewTooManyResultsError(count, input)
-	}
-
-	return output.ScheduledActions[0], nil
+	return findScheduledAction(conn, input, nil)
 }
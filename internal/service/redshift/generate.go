@@ -1,4 +1,6 @@
+//go:generate go run ../../generate/finder/main.go -ListOps=DescribeClusters:ErrCodeClusterNotFoundFault,DescribeScheduledActions:ErrCodeScheduledActionNotFoundFault
 //go:generate go run ../../generate/tags/main.go -ListTagsOp=DescribeTags -ListTagsInIDElem=ResourceName -ServiceTagsSlice -TagOp=CreateTags -TagInIDElem=ResourceName -UntagOp=DeleteTags -UpdateTags
+//go:generate go run ../../generate/waiter/main.go -Name=Cluster -Finder=FindClusterByID -StatusField=ClusterStatus -DeletePending=clusterStatusAvailable,clusterStatusCreating,clusterStatusDeleting,clusterStatusFinalSnapshot,clusterStatusRebooting,clusterStatusRenaming,clusterStatusResizing
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package redshift
@@ -9,20 +9,20 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_route53_resolver_dnssec_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_dnssec_config", &resource.Sweeper{
 		Name: "aws_route53_resolver_dnssec_config",
 		F:    sweepDNSSECConfig,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_endpoint", &resource.Sweeper{
 		Name: "aws_route53_resolver_endpoint",
 		F:    sweepEndpoints,
 		Dependencies: []string{
@@ -30,7 +30,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_config", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_config",
 		F:    sweepFirewallsConfig,
 		Dependencies: []string{
@@ -38,7 +38,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_domain_list", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_domain_list", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_domain_list",
 		F:    sweepFirewallDomainLists,
 		Dependencies: []string{
@@ -46,12 +46,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule_group_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_rule_group_association", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule_group_association",
 		F:    sweepFirewallRuleGroupAssociations,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_rule_group", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule_group",
 		F:    sweepFirewallRuleGroups,
 		Dependencies: []string{
@@ -60,7 +60,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_firewall_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_firewall_rule", &resource.Sweeper{
 		Name: "aws_route53_resolver_firewall_rule",
 		F:    sweepFirewallRules,
 		Dependencies: []string{
@@ -68,12 +68,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_query_log_config_association", &resource.Sweeper{
 		Name: "aws_route53_resolver_query_log_config_association",
 		F:    sweepQueryLogAssociationsConfig,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_query_log_config", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_query_log_config", &resource.Sweeper{
 		Name: "aws_route53_resolver_query_log_config",
 		F:    sweepQueryLogsConfig,
 		Dependencies: []string{
@@ -81,12 +81,12 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_rule_association", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_rule_association", &resource.Sweeper{
 		Name: "aws_route53_resolver_rule_association",
 		F:    sweepRuleAssociations,
 	})
 
-	resource.AddTestSweepers("aws_route53_resolver_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_route53_resolver_rule", &resource.Sweeper{
 		Name: "aws_route53_resolver_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -102,7 +102,8 @@ func sweepDNSSECConfig(region string) error {
 	}
 	conn := client.(*conns.AWSClient).Route53ResolverConn
 
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 	err = conn.ListResolverDnssecConfigsPages(&route53resolver.ListResolverDnssecConfigsInput{}, func(page *route53resolver.ListResolverDnssecConfigsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
@@ -123,29 +124,26 @@ func sweepDNSSECConfig(region string) error {
 			d.SetId(aws.StringValue(resolverDnssecConfig.Id))
 			d.Set("resource_id", resourceId)
 
-			err := r.Delete(d, client)
-
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting Route 53 Resolver Resolver Dnssec config (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Route 53 Resolver Resolver Dnssec config sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil()
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Route 53 Resolver Resolver Dnssec config for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Route 53 Resolver Resolver Dnssec config for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route 53 Resolver Resolver Dnssec config: %w", err))
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Route 53 Resolver Resolver Dnssec config sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepEndpoints(region string) error {
@@ -154,48 +152,40 @@ func sweepEndpoints(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).Route53ResolverConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	var errors error
 	err = conn.ListResolverEndpointsPages(&route53resolver.ListResolverEndpointsInput{}, func(page *route53resolver.ListResolverEndpointsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
 		for _, resolverEndpoint := range page.ResolverEndpoints {
-			id := aws.StringValue(resolverEndpoint.Id)
-
-			log.Printf("[INFO] Deleting Route53 Resolver endpoint: %s", id)
-			_, err := conn.DeleteResolverEndpoint(&route53resolver.DeleteResolverEndpointInput{
-				ResolverEndpointId: aws.String(id),
-			})
-			if tfawserr.ErrMessageContains(err, route53resolver.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				errors = multierror.Append(errors, fmt.Errorf("error deleting Route53 Resolver endpoint (%s): %w", id, err))
-				continue
-			}
+			r := ResourceEndpoint()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(resolverEndpoint.Id))
+			d.Set("name", resolverEndpoint.Name)
 
-			err = EndpointWaitUntilTargetState(conn, id, endpointDeletedDefaultTimeout,
-				[]string{route53resolver.ResolverEndpointStatusDeleting},
-				[]string{EndpointStatusDeleted})
-			if err != nil {
-				errors = multierror.Append(errors, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Route53 Resolver endpoint sweep for %s: %s", region, err)
-			return nil
-		}
-		errors = multierror.Append(errors, fmt.Errorf("error retrievingRoute53 Resolver endpoints: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing Route53 Resolver endpoints for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Route53 Resolver endpoints for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Route53 Resolver endpoint sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return errors
+	return errs.ErrorOrNil()
 }
 
 func sweepFirewallsConfig(region string) error {
@@ -204,7 +194,8 @@ func sweepFirewallsConfig(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).Route53ResolverConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListFirewallConfigsPages(&route53resolver.ListFirewallConfigsInput{}, func(page *route53resolver.ListFirewallConfigsOutput, lastPage bool) bool {
 		if page == nil {
@@ -218,26 +209,26 @@ func sweepFirewallsConfig(region string) error {
 			r := ResourceFirewallConfig()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
-
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Route53 Resolver DNS Firewall configs sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route53 Resolver DNS Firewall configs: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing Route53 Resolver DNS Firewall configs for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Route53 Resolver DNS Firewall configs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Route53 Resolver DNS Firewall configs sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepFirewallDomainLists(region string) error {
@@ -246,7 +237,8 @@ func sweepFirewallDomainLists(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).Route53ResolverConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListFirewallDomainListsPages(&route53resolver.ListFirewallDomainListsInput{}, func(page *route53resolver.ListFirewallDomainListsOutput, lastPage bool) bool {
 		if page == nil {
@@ -260,26 +252,26 @@ func sweepFirewallDomainLists(region string) error {
 			r := ResourceFirewallDomainList()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
-
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Route53 Resolver DNS Firewall domain lists sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route53 Resolver DNS Firewall domain lists: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing Route53 Resolver DNS Firewall domain lists for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Route53 Resolver DNS Firewall domain lists for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Route53 Resolver DNS Firewall domain lists sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepFirewallRuleGroupAssociations(region string) error {
@@ -288,7 +280,8 @@ func sweepFirewallRuleGroupAssociations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).Route53ResolverConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListFirewallRuleGroupAssociationsPages(&route53resolver.ListFirewallRuleGroupAssociationsInput{}, func(page *route53resolver.ListFirewallRuleGroupAssociationsOutput, lastPage bool) bool {
 		if page == nil {
@@ -296,57 +289,63 @@ func sweepFirewallRuleGroupAssociations(region string) error {
 		}
 
 		for _, firewallRuleGroupAssociation := range page.FirewallRuleGroupAssociations {
-			id := aws.StringValue(firewallRuleGroupAssociation.Id)
-
-			log.Printf("[INFO] Deleting Route53 Resolver DNS Firewall rule group association: %s", id)
-			r := ResourceFirewallRuleGroupAssociation()
+			r := resourceFirewallRuleGroupAssociationWithoutMutationProtection()
 			d := r.Data(nil)
-			d.SetId(id)
+			d.SetId(aws.StringValue(firewallRuleGroupAssociation.Id))
+			d.Set("name", firewallRuleGroupAssociation.Name)
+			d.Set("mutation_protection", firewallRuleGroupAssociation.MutationProtection)
 
-			if aws.StringValue(firewallRuleGroupAssociation.MutationProtection) == route53resolver.MutationProtectionStatusEnabled {
-				input := &route53resolver.UpdateFirewallRuleGroupAssociationInput{
-					FirewallRuleGroupAssociationId: firewallRuleGroupAssociation.Id,
-					Name:                           firewallRuleGroupAssociation.Name,
-					MutationProtection:             aws.String(route53resolver.MutationProtectionStatusDisabled),
-				}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
 
-				_, err := conn.UpdateFirewallRuleGroupAssociation(input)
+		return !lastPage
+	})
 
-				if err != nil {
-					log.Printf("[ERROR] %s", err)
-					sweeperErrs = multierror.Append(sweeperErrs, err)
-					continue
-				}
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Route53 Resolver DNS Firewall rule group associations for %s: %w", region, err))
+	}
 
-				_, err = WaitFirewallRuleGroupAssociationUpdated(conn, d.Id())
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Route53 Resolver DNS Firewall rule group associations for %s: %w", region, err))
+	}
 
-				if err != nil {
-					log.Printf("[ERROR] error waiting for Route53 Resolver DNS Firewall rule group association (%s) to be updated: %s", d.Id(), err)
-					sweeperErrs = multierror.Append(sweeperErrs, err)
-					continue
-				}
-			}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Route53 Resolver DNS Firewall rule group associations sweep for %s: %s", region, errs)
+		return nil
+	}
 
-			err := r.Delete(d, client)
+	return errs.ErrorOrNil()
+}
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+// resourceFirewallRuleGroupAssociationWithoutMutationProtection is a Route53 Resolver DNS Firewall
+// rule group association resource which disables mutation protection before deleting the association.
+func resourceFirewallRuleGroupAssociationWithoutMutationProtection() *schema.Resource {
+	r := ResourceFirewallRuleGroupAssociation()
+	r.Delete = resourceFirewallRuleGroupAssociationWithoutMutationProtectionDelete
+
+	return r
+}
+
+func resourceFirewallRuleGroupAssociationWithoutMutationProtectionDelete(d *schema.ResourceData, meta interface{}) error {
+	if d.Get("mutation_protection").(string) == route53resolver.MutationProtectionStatusEnabled {
+		conn := meta.(*conns.AWSClient).Route53ResolverConn
+
+		input := &route53resolver.UpdateFirewallRuleGroupAssociationInput{
+			FirewallRuleGroupAssociationId: aws.String(d.Id()),
+			Name:                           aws.String(d.Get("name").(string)),
+			MutationProtection:             aws.String(route53resolver.MutationProtectionStatusDisabled),
 		}
 
-		return !lastPage
-	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Route53 Resolver DNS Firewall rule group associations sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route53 Resolver DNS Firewall rule group associations: %w", err))
+		if _, err := conn.UpdateFirewallRuleGroupAssociation(input); err != nil {
+			return fmt.Errorf("error disabling Route53 Resolver DNS Firewall rule group association (%s) mutation protection: %w", d.Id(), err)
+		}
+
+		if _, err := WaitFirewallRuleGroupAssociationUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for Route53 Resolver DNS Firewall rule group association (%s) to be updated: %w", d.Id(), err)
+		}
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return resourceFirewallRuleGroupAssociationDelete(d, meta)
 }
 
 func sweepFirewallRuleGroups(region string) error {
@@ -355,7 +354,8 @@ func sweepFirewallRuleGroups(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).Route53ResolverConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListFirewallRuleGroupsPages(&route53resolver.ListFirewallRuleGroupsInput{}, func(page *route53resolver.ListFirewallRuleGroupsOutput, lastPage bool) bool {
 		if page == nil {
@@ -369,26 +369,26 @@ func sweepFirewallRuleGroups(region string) error {
 			r := ResourceFirewallRuleGroup()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
-
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Route53 Resolver DNS Firewall rule groups sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route53 Resolver DNS Firewall rule groups: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing Route53 Resolver DNS Firewall rule groups for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Route53 Resolver DNS Firewall rule groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Route53 Resolver DNS Firewall rule groups sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepFirewallRules(region string) error {
@@ -397,7 +397,8 @@ func sweepFirewallRules(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).Route53ResolverConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListFirewallRuleGroupsPages(&route53resolver.ListFirewallRuleGroupsInput{}, func(page *route53resolver.ListFirewallRuleGroupsOutput, lastPage bool) bool {
 		if page == nil {
@@ -415,25 +416,21 @@ func sweepFirewallRules(region string) error {
 				FirewallRuleGroupId: ruleGroup.Id,
 			}
 
-			err = conn.ListFirewallRulesPages(input, func(page *route53resolver.ListFirewallRulesOutput, lastPage bool) bool {
+			err := conn.ListFirewallRulesPages(input, func(page *route53resolver.ListFirewallRulesOutput, lastPage bool) bool {
 				if page == nil {
 					return !lastPage
 				}
 
 				for _, firewallRule := range page.FirewallRules {
-					id := FirewallRuleCreateID(*firewallRule.FirewallRuleGroupId, *firewallRule.FirewallDomainListId)
-
-					log.Printf("[INFO] Deleting Route53 Resolver DNS Firewall rule: %s", id)
 					r := ResourceFirewallRule()
 					d := r.Data(nil)
-					d.SetId(id)
-					err := r.Delete(d, client)
-
-					if err != nil {
-						log.Printf("[ERROR] %s", err)
-						sweeperErrs = multierror.Append(sweeperErrs, err)
-						continue
-					}
+					d.SetId(FirewallRuleCreateID(aws.StringValue(firewallRule.FirewallRuleGroupId), aws.StringValue(firewallRule.FirewallDomainListId)))
+					d.Set("name", firewallRule.Name)
+					// The following additional arguments are required during the resource's Delete operation
+					d.Set("firewall_rule_group_id", firewallRule.FirewallRuleGroupId)
+					d.Set("firewall_domain_list_id", firewallRule.FirewallDomainListId)
+
+					sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 				}
 
 				return !lastPage
@@ -445,26 +442,27 @@ func sweepFirewallRules(region string) error {
 			}
 
 			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route53 Resolver DNS Firewall rules for rule group (%s): %w", ruleGroupId, err))
-				continue
+				errs = multierror.Append(errs, fmt.Errorf("error listing Route53 Resolver DNS Firewall rules for rule group (%s): %w", ruleGroupId, err))
 			}
-
-			return !lastPage
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Route53 Resolver DNS Firewall rules sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Route53 Resolver DNS Firewall rule groups for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route53 Resolver DNS Firewall rule groups: %w", err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Route53 Resolver DNS Firewall rules for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Route53 Resolver DNS Firewall rules sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepQueryLogAssociationsConfig(region string) error {
@@ -473,7 +471,8 @@ func sweepQueryLogAssociationsConfig(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).Route53ResolverConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListResolverQueryLogConfigAssociationsPages(&route53resolver.ListResolverQueryLogConfigAssociationsInput{}, func(page *route53resolver.ListResolverQueryLogConfigAssociationsOutput, lastPage bool) bool {
 		if page == nil {
@@ -490,26 +489,26 @@ func sweepQueryLogAssociationsConfig(region string) error {
 			// The following additional arguments are required during the resource's Delete operation
 			d.Set("resolver_query_log_config_id", queryLogConfigAssociation.ResolverQueryLogConfigId)
 			d.Set("resource_id", queryLogConfigAssociation.ResourceId)
-			err := r.Delete(d, client)
-
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Route53 Resolver Query Log Config Associations sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route53 Resolver Query Log Config Associations: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing Route53 Resolver Query Log Config Associations for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Route53 Resolver Query Log Config Associations for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Route53 Resolver Query Log Config Associations sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepQueryLogsConfig(region string) error {
@@ -518,7 +517,8 @@ func sweepQueryLogsConfig(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).Route53ResolverConn
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListResolverQueryLogConfigsPages(&route53resolver.ListResolverQueryLogConfigsInput{}, func(page *route53resolver.ListResolverQueryLogConfigsOutput, lastPage bool) bool {
 		if page == nil {
@@ -532,26 +532,26 @@ func sweepQueryLogsConfig(region string) error {
 			r := ResourceQueryLogConfig()
 			d := r.Data(nil)
 			d.SetId(id)
-			err := r.Delete(d, client)
-
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Route53 Resolver Query Log Configs sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving Route53 Resolver Query Log Configs: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing Route53 Resolver Query Log Configs for %s: %w", region, err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Route53 Resolver Query Log Configs for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Route53 Resolver Query Log Configs sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepRuleAssociations(region string) error {
@@ -560,53 +560,43 @@ func sweepRuleAssociations(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).Route53ResolverConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	var errors error
 	err = conn.ListResolverRuleAssociationsPages(&route53resolver.ListResolverRuleAssociationsInput{}, func(page *route53resolver.ListResolverRuleAssociationsOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
 
 		for _, resolverRuleAssociation := range page.ResolverRuleAssociations {
-			id := aws.StringValue(resolverRuleAssociation.Id)
-
-			log.Printf("[INFO] Deleting Route53 Resolver rule association %q", id)
-			_, err := conn.DisassociateResolverRule(&route53resolver.DisassociateResolverRuleInput{
-				ResolverRuleId: resolverRuleAssociation.ResolverRuleId,
-				VPCId:          resolverRuleAssociation.VPCId,
-			})
-			if tfawserr.ErrMessageContains(err, route53resolver.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
-			if sweep.SkipSweepError(err) {
-				log.Printf("[INFO] Skipping Route53 Resolver rule association %q: %s", id, err)
-				continue
-			}
-			if err != nil {
-				errors = multierror.Append(errors, fmt.Errorf("error deleting Route53 Resolver rule association (%s): %w", id, err))
-				continue
-			}
+			r := ResourceRuleAssociation()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(resolverRuleAssociation.Id))
+			d.Set("name", resolverRuleAssociation.Name)
+			// The following additional arguments are required during the resource's Delete operation
+			d.Set("resolver_rule_id", resolverRuleAssociation.ResolverRuleId)
+			d.Set("vpc_id", resolverRuleAssociation.VPCId)
 
-			err = RuleAssociationWaitUntilTargetState(conn, id, ruleAssociationDeletedDefaultTimeout,
-				[]string{route53resolver.ResolverRuleAssociationStatusDeleting},
-				[]string{RuleAssociationStatusDeleted})
-			if err != nil {
-				errors = multierror.Append(errors, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Route53 Resolver rule association sweep for %s: %s", region, err)
-			return nil
-		}
-		errors = multierror.Append(errors, fmt.Errorf("error retrievingRoute53 Resolver rule associations: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing Route53 Resolver rule associations for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Route53 Resolver rule associations for %s: %w", region, err))
 	}
 
-	return errors
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Route53 Resolver rule association sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepRules(region string) error {
@@ -615,8 +605,9 @@ func sweepRules(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).Route53ResolverConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	var errors error
 	err = conn.ListResolverRulesPages(&route53resolver.ListResolverRulesInput{}, func(page *route53resolver.ListResolverRulesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
@@ -631,36 +622,29 @@ func sweepRules(region string) error {
 				continue
 			}
 
-			log.Printf("[INFO] Deleting Route53 Resolver rule %q", id)
-			_, err := conn.DeleteResolverRule(&route53resolver.DeleteResolverRuleInput{
-				ResolverRuleId: aws.String(id),
-			})
-			if tfawserr.ErrMessageContains(err, route53resolver.ErrCodeResourceNotFoundException, "") {
-				continue
-			}
-			if err != nil {
-				errors = multierror.Append(errors, fmt.Errorf("error deleting Route53 Resolver rule (%s): %w", id, err))
-				continue
-			}
+			r := ResourceRule()
+			d := r.Data(nil)
+			d.SetId(id)
+			d.Set("name", resolverRule.Name)
 
-			err = RuleWaitUntilTargetState(conn, id, ruleDeletedDefaultTimeout,
-				[]string{route53resolver.ResolverRuleStatusDeleting},
-				[]string{RuleStatusDeleted})
-			if err != nil {
-				errors = multierror.Append(errors, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Route53 Resolver rule sweep for %s: %s", region, err)
-			return nil
-		}
-		errors = multierror.Append(errors, fmt.Errorf("error retrievingRoute53 Resolver rules: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing Route53 Resolver rules for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Route53 Resolver rules for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Route53 Resolver rule sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return errors
+	return errs.ErrorOrNil()
 }
@@ -0,0 +1,352 @@
+package s3
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func ResourceBucketACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBucketACLCreate,
+		Read:   resourceBucketACLRead,
+		Update: resourceBucketACLUpdate,
+		Delete: resourceBucketACLDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"access_control_policy": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"access_control_policy", "acl"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grant": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"grantee": granteeSchema(),
+									"permission": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(s3.Permission_Values(), false),
+									},
+								},
+							},
+						},
+						"owner": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"display_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+									},
+									"id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"acl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"access_control_policy", "acl"},
+				ValidateFunc: validation.StringInSlice(BucketCannedACL_Values(), false),
+			},
+			"bucket": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 63),
+			},
+		},
+	}
+}
+
+func resourceBucketACLCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	bucket := d.Get("bucket").(string)
+
+	input := expandBucketACLInput(d, bucket)
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketAcl(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error creating S3 Bucket (%s) ACL: %w", bucket, err)
+	}
+
+	d.SetId(bucket)
+
+	return resourceBucketACLRead(d, meta)
+}
+
+func resourceBucketACLRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := &s3.GetBucketAclInput{
+		Bucket: aws.String(d.Id()),
+	}
+
+	output, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.GetBucketAcl(input)
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, s3.ErrCodeNoSuchBucket) {
+		log.Printf("[WARN] S3 Bucket ACL (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	acl, ok := output.(*s3.GetBucketAclOutput)
+
+	if !ok || acl == nil {
+		return fmt.Errorf("error reading S3 Bucket (%s) ACL: empty output", d.Id())
+	}
+
+	d.Set("bucket", d.Id())
+
+	if err := d.Set("access_control_policy", flattenBucketACLAccessControlPolicy(acl)); err != nil {
+		return fmt.Errorf("error setting access_control_policy: %w", err)
+	}
+
+	return nil
+}
+
+func resourceBucketACLUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3Conn
+
+	input := expandBucketACLInput(d, d.Id())
+
+	_, err := retryWhenBucketNotFound(func() (interface{}, error) {
+		return conn.PutBucketAcl(input)
+	})
+
+	if err != nil {
+		return fmt.Errorf("error updating S3 Bucket (%s) ACL: %w", d.Id(), err)
+	}
+
+	return resourceBucketACLRead(d, meta)
+}
+
+func resourceBucketACLDelete(d *schema.ResourceData, meta interface{}) error {
+	// A bucket always has an ACL, so there is nothing to remove.
+	// The ACL is left in place and the resource is only removed from state.
+	log.Printf("[WARN] Cannot destroy S3 Bucket ACL (%s). Terraform will remove this resource from the state file, however the ACL will remain in place.", d.Id())
+
+	return nil
+}
+
+func expandBucketACLInput(d *schema.ResourceData, bucket string) *s3.PutBucketAclInput {
+	input := &s3.PutBucketAclInput{
+		Bucket: aws.String(bucket),
+	}
+
+	if v, ok := d.GetOk("acl"); ok {
+		input.ACL = aws.String(v.(string))
+	} else if v, ok := d.GetOk("access_control_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.AccessControlPolicy = expandBucketACLAccessControlPolicy(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	return input
+}
+
+func expandBucketACLAccessControlPolicy(tfMap map[string]interface{}) *s3.AccessControlPolicy {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.AccessControlPolicy{}
+
+	if v, ok := tfMap["grant"].(*schema.Set); ok && v.Len() > 0 {
+		for _, tfMapRaw := range v.List() {
+			grant, ok := tfMapRaw.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			apiGrant := &s3.Grant{}
+
+			if v, ok := grant["grantee"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+				apiGrant.Grantee = expandGrantee(v[0].(map[string]interface{}))
+			}
+
+			if v, ok := grant["permission"].(string); ok && v != "" {
+				apiGrant.Permission = aws.String(v)
+			}
+
+			apiObject.Grants = append(apiObject.Grants, apiGrant)
+		}
+	}
+
+	if v, ok := tfMap["owner"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		owner := v[0].(map[string]interface{})
+		apiObject.Owner = &s3.Owner{}
+
+		if v, ok := owner["display_name"].(string); ok && v != "" {
+			apiObject.Owner.DisplayName = aws.String(v)
+		}
+
+		if v, ok := owner["id"].(string); ok && v != "" {
+			apiObject.Owner.ID = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenBucketACLAccessControlPolicy(apiObject *s3.GetBucketAclOutput) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	var grants []interface{}
+
+	for _, grant := range apiObject.Grants {
+		if grant == nil {
+			continue
+		}
+
+		m := map[string]interface{}{}
+
+		if v := grant.Grantee; v != nil {
+			m["grantee"] = []interface{}{flattenGrantee(v)}
+		}
+
+		if v := grant.Permission; v != nil {
+			m["permission"] = aws.StringValue(v)
+		}
+
+		grants = append(grants, m)
+	}
+
+	tfMap["grant"] = grants
+
+	if v := apiObject.Owner; v != nil {
+		tfMap["owner"] = []interface{}{
+			map[string]interface{}{
+				"display_name": aws.StringValue(v.DisplayName),
+				"id":           aws.StringValue(v.ID),
+			},
+		}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func granteeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"display_name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"email_address": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"id": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"type": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice(s3.Type_Values(), false),
+				},
+				"uri": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+func expandGrantee(tfMap map[string]interface{}) *s3.Grantee {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &s3.Grantee{}
+
+	if v, ok := tfMap["email_address"].(string); ok && v != "" {
+		apiObject.EmailAddress = aws.String(v)
+	}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.ID = aws.String(v)
+	}
+
+	if v, ok := tfMap["type"].(string); ok && v != "" {
+		apiObject.Type = aws.String(v)
+	}
+
+	if v, ok := tfMap["uri"].(string); ok && v != "" {
+		apiObject.URI = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenGrantee(apiObject *s3.Grantee) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.DisplayName; v != nil {
+		tfMap["display_name"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.EmailAddress; v != nil {
+		tfMap["email_address"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.ID; v != nil {
+		tfMap["id"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.Type; v != nil {
+		tfMap["type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.URI; v != nil {
+		tfMap["uri"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
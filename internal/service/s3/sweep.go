@@ -9,25 +9,26 @@ import (
 	"log"
 	"regexp"
 	"strings"
-	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_s3_bucket_object", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket_object", &resource.Sweeper{
 		Name: "aws_s3_bucket_object",
 		F:    sweepBucketObjects,
 	})
 
-	resource.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_s3_bucket", &resource.Sweeper{
 		Name: "aws_s3_bucket",
 		F:    sweepBuckets,
 		Dependencies: []string{
@@ -43,72 +44,67 @@ func sweepBucketObjects(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-
 	conn := client.(*conns.AWSClient).S3ConnURICleaningDisabled
 	input := &s3.ListBucketsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	output, err := conn.ListBuckets(input)
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping S3 Bucket Objects sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing S3 Bucket Objects: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing S3 Buckets for %s: %w", region, err))
 	}
 
-	if len(output.Buckets) == 0 {
-		log.Print("[DEBUG] No S3 Bucket Objects to sweep")
-		return nil
-	}
-
-	for _, bucket := range output.Buckets {
-		bucketName := aws.StringValue(bucket.Name)
-
-		hasPrefix := false
+	if output != nil {
 		prefixes := []string{"tf-acc", "tf-object-test", "tf-test", "tf-emr-bootstrap"}
 
-		for _, prefix := range prefixes {
-			if strings.HasPrefix(bucketName, prefix) {
-				hasPrefix = true
-				break
+		for _, bucket := range output.Buckets {
+			bucketName := aws.StringValue(bucket.Name)
+
+			hasPrefix := false
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(bucketName, prefix) {
+					hasPrefix = true
+					break
+				}
 			}
-		}
 
-		if !hasPrefix {
-			log.Printf("[INFO] Skipping S3 Bucket: %s", bucketName)
-			continue
-		}
+			if !hasPrefix {
+				log.Printf("[INFO] Skipping S3 Bucket: %s", bucketName)
+				continue
+			}
 
-		bucketRegion, err := bucketRegion(conn, bucketName)
+			bucketRegion, err := bucketRegion(conn, bucketName)
 
-		if err != nil {
-			log.Printf("[ERROR] Error getting S3 Bucket (%s) Location: %s", bucketName, err)
-			continue
-		}
+			if err != nil {
+				log.Printf("[ERROR] Error getting S3 Bucket (%s) Location: %s", bucketName, err)
+				continue
+			}
 
-		if bucketRegion != region {
-			log.Printf("[INFO] Skipping S3 Bucket (%s) in different region: %s", bucketName, bucketRegion)
-			continue
-		}
+			if bucketRegion != region {
+				log.Printf("[INFO] Skipping S3 Bucket (%s) in different region: %s", bucketName, bucketRegion)
+				continue
+			}
 
-		objectLockEnabled, err := bucketObjectLockEnabled(conn, bucketName)
+			r := resourceBucketObjects()
+			d := r.Data(nil)
+			d.SetId(bucketName)
+			d.Set("bucket", bucketName)
 
-		if err != nil {
-			log.Printf("[ERROR] Error getting S3 Bucket (%s) Object Lock: %s", bucketName, err)
-			continue
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
+	}
 
-		// Delete everything including locked objects. Ignore any object errors.
-		err = DeleteAllObjectVersions(conn, bucketName, "", objectLockEnabled, true)
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping S3 Bucket Objects for %s: %w", region, err))
+	}
 
-		if err != nil {
-			return fmt.Errorf("error listing S3 Bucket (%s) Objects: %s", bucketName, err)
-		}
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping S3 Bucket Objects sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return nil
+	return errs.ErrorOrNil()
 }
 
 func sweepBuckets(region string) error {
@@ -116,90 +112,73 @@ func sweepBuckets(region string) error {
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-
 	conn := client.(*conns.AWSClient).S3Conn
 	input := &s3.ListBucketsInput{}
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	output, err := conn.ListBuckets(input)
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping S3 Buckets sweep for %s: %s", region, err)
-		return nil
-	}
-
 	if err != nil {
-		return fmt.Errorf("error listing S3 Buckets: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing S3 Buckets for %s: %w", region, err))
 	}
 
-	if len(output.Buckets) == 0 {
-		log.Print("[DEBUG] No S3 Buckets to sweep")
-		return nil
-	}
+	if output != nil {
+		defaultNameRegexp := regexp.MustCompile(`^terraform-\d+$`)
+		prefixes := []string{"tf-acc", "tf-object-test", "tf-test", "tf-emr-bootstrap", "terraform-remote-s3-test"}
 
-	defaultNameRegexp := regexp.MustCompile(`^terraform-\d+$`)
-	for _, bucket := range output.Buckets {
-		name := aws.StringValue(bucket.Name)
+		for _, bucket := range output.Buckets {
+			name := aws.StringValue(bucket.Name)
 
-		sweepable := false
-		prefixes := []string{"tf-acc", "tf-object-test", "tf-test", "tf-emr-bootstrap", "terraform-remote-s3-test"}
+			sweepable := false
+			for _, prefix := range prefixes {
+				if strings.HasPrefix(name, prefix) {
+					sweepable = true
+					break
+				}
+			}
 
-		for _, prefix := range prefixes {
-			if strings.HasPrefix(name, prefix) {
+			if defaultNameRegexp.MatchString(name) {
 				sweepable = true
-				break
 			}
-		}
-
-		if defaultNameRegexp.MatchString(name) {
-			sweepable = true
-		}
-
-		if !sweepable {
-			log.Printf("[INFO] Skipping S3 Bucket: %s", name)
-			continue
-		}
 
-		bucketRegion, err := bucketRegion(conn, name)
-
-		if err != nil {
-			log.Printf("[ERROR] Error getting S3 Bucket (%s) Location: %s", name, err)
-			continue
-		}
-
-		if bucketRegion != region {
-			log.Printf("[INFO] Skipping S3 Bucket (%s) in different Region: %s", name, bucketRegion)
-			continue
-		}
-
-		input := &s3.DeleteBucketInput{
-			Bucket: bucket.Name,
-		}
-
-		log.Printf("[INFO] Deleting S3 Bucket: %s", name)
-		err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-			_, err := conn.DeleteBucket(input)
-
-			if tfawserr.ErrMessageContains(err, s3.ErrCodeNoSuchBucket, "") {
-				return nil
+			if !sweepable {
+				log.Printf("[INFO] Skipping S3 Bucket: %s", name)
+				continue
 			}
 
-			if tfawserr.ErrMessageContains(err, "BucketNotEmpty", "") {
-				return resource.RetryableError(err)
-			}
+			bucketRegion, err := bucketRegion(conn, name)
 
 			if err != nil {
-				return resource.NonRetryableError(err)
+				log.Printf("[ERROR] Error getting S3 Bucket (%s) Location: %s", name, err)
+				continue
+			}
+
+			if bucketRegion != region {
+				log.Printf("[INFO] Skipping S3 Bucket (%s) in different Region: %s", name, bucketRegion)
+				continue
 			}
 
-			return nil
-		})
+			r := ResourceBucket()
+			d := r.Data(nil)
+			d.SetId(name)
+			d.Set("bucket", name)
+			d.Set("force_destroy", true)
 
-		if err != nil {
-			return fmt.Errorf("error deleting S3 Bucket (%s): %s", name, err)
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping S3 Buckets for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping S3 Bucket sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func bucketRegion(conn *s3.S3, bucket string) (string, error) {
@@ -234,3 +213,29 @@ func bucketObjectLockEnabled(conn *s3.S3, bucket string) (bool, error) {
 
 	return aws.StringValue(output.ObjectLockConfiguration.ObjectLockEnabled) == s3.ObjectLockEnabledEnabled, nil
 }
+
+// resourceBucketObjects is an S3 Bucket resource whose Delete removes every
+// object version in the bucket, including locked objects, but not the bucket.
+func resourceBucketObjects() *schema.Resource {
+	r := ResourceBucket()
+	r.Delete = resourceBucketObjectsDelete
+
+	return r
+}
+
+func resourceBucketObjectsDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).S3ConnURICleaningDisabled
+
+	objectLockEnabled, err := bucketObjectLockEnabled(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error getting S3 Bucket (%s) Object Lock: %w", d.Id(), err)
+	}
+
+	// Delete everything including locked objects. Ignore any object errors.
+	if err := DeleteAllObjectVersions(conn, d.Id(), "", objectLockEnabled, true); err != nil {
+		return fmt.Errorf("error deleting S3 Bucket (%s) Objects: %w", d.Id(), err)
+	}
+
+	return nil
+}
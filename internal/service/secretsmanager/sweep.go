@@ -9,19 +9,19 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_secretsmanager_secret_policy", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_secretsmanager_secret_policy", &resource.Sweeper{
 		Name: "aws_secretsmanager_secret_policy",
 		F:    sweepSecretPolicies,
 	})
 
-	resource.AddTestSweepers("aws_secretsmanager_secret", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_secretsmanager_secret", &resource.Sweeper{
 		Name: "aws_secretsmanager_secret",
 		F:    sweepSecrets,
 	})
@@ -33,40 +33,38 @@ func sweepSecretPolicies(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).SecretsManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListSecretsPages(&secretsmanager.ListSecretsInput{}, func(page *secretsmanager.ListSecretsOutput, lastPage bool) bool {
-		if len(page.SecretList) == 0 {
-			log.Print("[DEBUG] No Secrets Manager Secrets to sweep")
-			return true
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, secret := range page.SecretList {
-			name := aws.StringValue(secret.Name)
-
-			log.Printf("[INFO] Deleting Secrets Manager Secret Policy: %s", name)
-			input := &secretsmanager.DeleteResourcePolicyInput{
-				SecretId: aws.String(name),
-			}
-
-			_, err := conn.DeleteResourcePolicy(input)
-			if err != nil {
-				if tfawserr.ErrMessageContains(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
-					continue
-				}
-				log.Printf("[ERROR] Failed to delete Secrets Manager Secret Policy (%s): %s", name, err)
-			}
+			r := ResourceSecretPolicy()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(secret.ARN))
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Secrets Manager Secret sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Secrets Manager Secrets: %w", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing Secrets Manager Secret Policies for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Secrets Manager Secret Policies for %s: %w", region, err))
 	}
-	return nil
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Secrets Manager Secret Policy sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepSecrets(region string) error {
@@ -75,39 +73,38 @@ func sweepSecrets(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).SecretsManagerConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListSecretsPages(&secretsmanager.ListSecretsInput{}, func(page *secretsmanager.ListSecretsOutput, lastPage bool) bool {
-		if len(page.SecretList) == 0 {
-			log.Print("[DEBUG] No Secrets Manager Secrets to sweep")
-			return true
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, secret := range page.SecretList {
-			name := aws.StringValue(secret.Name)
-
-			log.Printf("[INFO] Deleting Secrets Manager Secret: %s", name)
-			input := &secretsmanager.DeleteSecretInput{
-				ForceDeleteWithoutRecovery: aws.Bool(true),
-				SecretId:                   aws.String(name),
-			}
-
-			_, err := conn.DeleteSecret(input)
-			if err != nil {
-				if tfawserr.ErrMessageContains(err, secretsmanager.ErrCodeResourceNotFoundException, "") {
-					continue
-				}
-				log.Printf("[ERROR] Failed to delete Secrets Manager Secret (%s): %s", name, err)
-			}
+			r := ResourceSecret()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(secret.ARN))
+			d.Set("name", secret.Name)
+			d.Set("recovery_window_in_days", 0)
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Secrets Manager Secret sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Secrets Manager Secrets: %s", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing Secrets Manager Secrets for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Secrets Manager Secrets for %s: %w", region, err))
 	}
-	return nil
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Secrets Manager Secret sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
@@ -12,27 +12,28 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_configuration_set", &resource.Sweeper{
 		Name: "aws_ses_configuration_set",
 		F:    sweepConfigurationSets,
 	})
 
-	resource.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_domain_identity", &resource.Sweeper{
 		Name: "aws_ses_domain_identity",
 		F:    func(region string) error { return sweepIdentities(region, ses.IdentityTypeDomain) },
 	})
 
-	resource.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_email_identity", &resource.Sweeper{
 		Name: "aws_ses_email_identity",
 		F:    func(region string) error { return sweepIdentities(region, ses.IdentityTypeEmailAddress) },
 	})
 
-	resource.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ses_receipt_rule_set", &resource.Sweeper{
 		Name: "aws_ses_receipt_rule_set",
 		F:    sweepReceiptRuleSets,
 	})
@@ -45,35 +46,24 @@ func sweepConfigurationSets(region string) error {
 	}
 	conn := client.(*conns.AWSClient).SESConn
 	input := &ses.ListConfigurationSetsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.ListConfigurationSets(input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping SES Configuration Sets sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-		}
+
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SES Configuration Sets: %w", err))
-			return sweeperErrs
+			errs = multierror.Append(errs, fmt.Errorf("error listing SES Configuration Sets for %s: %w", region, err))
+			break
 		}
 
 		for _, configurationSet := range output.ConfigurationSets {
-			name := aws.StringValue(configurationSet.Name)
+			r := ResourceConfigurationSet()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(configurationSet.Name))
+			d.Set("name", configurationSet.Name)
 
-			log.Printf("[INFO] Deleting SES Configuration Set: %s", name)
-			_, err := conn.DeleteConfigurationSet(&ses.DeleteConfigurationSetInput{
-				ConfigurationSetName: aws.String(name),
-			})
-			if tfawserr.ErrMessageContains(err, ses.ErrCodeConfigurationSetDoesNotExistException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting SES Configuration Set (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -82,7 +72,16 @@ func sweepConfigurationSets(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SES Configuration Sets for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping SES Configuration Sets sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepIdentities(region, identityType string) error {
@@ -94,7 +93,8 @@ func sweepIdentities(region, identityType string) error {
 	input := &ses.ListIdentitiesInput{
 		IdentityType: aws.String(identityType),
 	}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListIdentitiesPages(input, func(page *ses.ListIdentitiesOutput, lastPage bool) bool {
 		if page == nil {
@@ -102,31 +102,44 @@ func sweepIdentities(region, identityType string) error {
 		}
 
 		for _, identity := range page.Identities {
-			identity := aws.StringValue(identity)
-
-			log.Printf("[INFO] Deleting SES Identity: %s", identity)
-			_, err = conn.DeleteIdentity(&ses.DeleteIdentityInput{
-				Identity: aws.String(identity),
-			})
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting SES Identity (%s): %w", identity, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
+			var r *schema.Resource
+			var d *schema.ResourceData
+
+			switch identityType {
+			case ses.IdentityTypeDomain:
+				r = ResourceDomainIdentity()
+				d = r.Data(nil)
+				d.Set("domain", identity)
+			case ses.IdentityTypeEmailAddress:
+				r = ResourceEmailIdentity()
+				d = r.Data(nil)
+				d.Set("email", identity)
+			default:
 				continue
 			}
+
+			d.SetId(aws.StringValue(identity))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping SES Identities sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-	}
+
 	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SES Identities: %w", err))
+		errs = multierror.Append(errs, fmt.Errorf("error listing SES Identities for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SES Identities for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping SES Identities sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepReceiptRuleSets(region string) error {
@@ -135,49 +148,25 @@ func sweepReceiptRuleSets(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).SESConn
-
-	// You cannot delete the receipt rule set that is currently active.
-	// Setting the name of the receipt rule set to make active to null disables all email receiving.
-	log.Printf("[INFO] Disabling any currently active SES Receipt Rule Set")
-	_, err = conn.SetActiveReceiptRuleSet(&ses.SetActiveReceiptRuleSetInput{})
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
-		return nil
-	}
-	if err != nil {
-		return fmt.Errorf("error disabling any currently active SES Receipt Rule Set: %w", err)
-	}
-
 	input := &ses.ListReceiptRuleSetsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.ListReceiptRuleSets(input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, err)
-			return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
-		}
+
 		if err != nil {
-			sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving SES Receipt Rule Sets: %w", err))
-			return sweeperErrs
+			errs = multierror.Append(errs, fmt.Errorf("error listing SES Receipt Rule Sets for %s: %w", region, err))
+			break
 		}
 
 		for _, ruleSet := range output.RuleSets {
-			name := aws.StringValue(ruleSet.Name)
+			r := resourceReceiptRuleSetWithDeactivation()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(ruleSet.Name))
+			d.Set("rule_set_name", ruleSet.Name)
 
-			log.Printf("[INFO] Deleting SES Receipt Rule Set: %s", name)
-			_, err := conn.DeleteReceiptRuleSet(&ses.DeleteReceiptRuleSetInput{
-				RuleSetName: aws.String(name),
-			})
-			if tfawserr.ErrMessageContains(err, ses.ErrCodeRuleSetDoesNotExistException, "") {
-				continue
-			}
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting SES Receipt Rule Set (%s): %w", name, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -186,5 +175,50 @@ func sweepReceiptRuleSets(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SES Receipt Rule Sets for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping SES Receipt Rule Sets sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// resourceReceiptRuleSetWithDeactivation is an SES Receipt Rule Set resource which
+// deactivates the rule set first if it is the currently active one, as an active
+// receipt rule set cannot be deleted.
+func resourceReceiptRuleSetWithDeactivation() *schema.Resource {
+	r := ResourceReceiptRuleSet()
+	r.Delete = resourceReceiptRuleSetWithDeactivationDelete
+
+	return r
+}
+
+func resourceReceiptRuleSetWithDeactivationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SESConn
+
+	output, err := conn.DescribeActiveReceiptRuleSet(&ses.DescribeActiveReceiptRuleSetInput{})
+
+	if err != nil {
+		return fmt.Errorf("error describing SES Active Receipt Rule Set: %w", err)
+	}
+
+	if output.Metadata != nil && aws.StringValue(output.Metadata.Name) == d.Id() {
+		// Setting the name of the receipt rule set to make active to null disables all email receiving.
+		log.Printf("[INFO] Deactivating SES Receipt Rule Set: %s", d.Id())
+		if _, err := conn.SetActiveReceiptRuleSet(&ses.SetActiveReceiptRuleSetInput{}); err != nil {
+			return fmt.Errorf("error deactivating SES Receipt Rule Set (%s): %w", d.Id(), err)
+		}
+	}
+
+	err = resourceReceiptRuleSetDelete(d, meta)
+
+	if tfawserr.ErrMessageContains(err, ses.ErrCodeRuleSetDoesNotExistException, "") {
+		return nil
+	}
+
+	return err
 }
@@ -9,7 +9,6 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ssm"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -17,12 +16,12 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_maintenance_window", &resource.Sweeper{
 		Name: "aws_ssm_maintenance_window",
 		F:    sweepMaintenanceWindows,
 	})
 
-	resource.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_ssm_resource_data_sync", &resource.Sweeper{
 		Name: "aws_ssm_resource_data_sync",
 		F:    sweepResourceDataSyncs,
 	})
@@ -30,47 +29,29 @@ func init() {
 
 func sweepMaintenanceWindows(region string) error {
 	client, err := sweep.SharedRegionalSweepClient(region)
-
 	if err != nil {
 		return fmt.Errorf("error getting client: %s", err)
 	}
-
 	conn := client.(*conns.AWSClient).SSMConn
 	input := &ssm.DescribeMaintenanceWindowsInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.DescribeMaintenanceWindows(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping SSM Maintenance Window sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("Error retrieving SSM Maintenance Windows: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing SSM Maintenance Windows for %s: %w", region, err))
+			break
 		}
 
 		for _, window := range output.WindowIdentities {
-			id := aws.StringValue(window.WindowId)
-			input := &ssm.DeleteMaintenanceWindowInput{
-				WindowId: window.WindowId,
-			}
-
-			log.Printf("[INFO] Deleting SSM Maintenance Window: %s", id)
-
-			_, err := conn.DeleteMaintenanceWindow(input)
-
-			if tfawserr.ErrMessageContains(err, ssm.ErrCodeDoesNotExistException, "") {
-				continue
-			}
+			r := ResourceMaintenanceWindow()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(window.WindowId))
+			d.Set("name", window.Name)
 
-			if err != nil {
-				sweeperErr := fmt.Errorf("error deleting SSM Maintenance Window (%s): %w", id, err)
-				log.Printf("[ERROR] %s", sweeperErr)
-				sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -80,7 +61,16 @@ func sweepMaintenanceWindows(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return nil
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping SSM Maintenance Windows for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping SSM Maintenance Window sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepResourceDataSyncs(region string) error {
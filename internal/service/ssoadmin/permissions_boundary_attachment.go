@@ -0,0 +1,211 @@
+package ssoadmin
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssoadmin"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePermissionsBoundaryAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourcePermissionsBoundaryAttachmentPut,
+		ReadContext:   resourcePermissionsBoundaryAttachmentRead,
+		UpdateContext: resourcePermissionsBoundaryAttachmentPut,
+		DeleteContext: resourcePermissionsBoundaryAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"permission_set_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"permissions_boundary": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"customer_managed_policy_reference": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 128),
+									},
+									"path": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Default:      "/",
+										ValidateFunc: validation.StringLenBetween(1, 512),
+									},
+								},
+							},
+							ExactlyOneOf: []string{"permissions_boundary.0.customer_managed_policy_reference", "permissions_boundary.0.managed_policy_arn"},
+						},
+						"managed_policy_arn": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidARN,
+							ExactlyOneOf: []string{"permissions_boundary.0.customer_managed_policy_reference", "permissions_boundary.0.managed_policy_arn"},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourcePermissionsBoundaryAttachmentPut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	instanceArn := d.Get("instance_arn").(string)
+	permissionSetArn := d.Get("permission_set_arn").(string)
+
+	input := &ssoadmin.PutPermissionsBoundaryToPermissionSetInput{
+		InstanceArn:         aws.String(instanceArn),
+		PermissionSetArn:    aws.String(permissionSetArn),
+		PermissionsBoundary: expandPermissionsBoundary(d.Get("permissions_boundary").([]interface{})),
+	}
+
+	log.Printf("[DEBUG] Putting SSO Permissions Boundary: %s", input)
+	_, err := conn.PutPermissionsBoundaryToPermissionSetWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error putting Permissions Boundary for SSO Permission Set (%s): %s", permissionSetArn, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(fmt.Sprintf("%s,%s", permissionSetArn, instanceArn))
+	}
+
+	// (Re)provision ALL accounts after making the above changes
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourcePermissionsBoundaryAttachmentRead(ctx, d, meta)
+}
+
+func resourcePermissionsBoundaryAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	permissionSetArn, instanceArn, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.Errorf("error parsing SSO Permissions Boundary Attachment ID: %s", err)
+	}
+
+	permissionsBoundary, err := FindPermissionsBoundary(ctx, conn, permissionSetArn, instanceArn)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Permissions Boundary for SSO Permission Set (%s) not found, removing from state", permissionSetArn)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Permissions Boundary for SSO Permission Set (%s): %s", permissionSetArn, err)
+	}
+
+	d.Set("instance_arn", instanceArn)
+	d.Set("permission_set_arn", permissionSetArn)
+	if err := d.Set("permissions_boundary", []interface{}{flattenPermissionsBoundary(permissionsBoundary)}); err != nil {
+		return diag.Errorf("error setting permissions_boundary: %s", err)
+	}
+
+	return nil
+}
+
+func resourcePermissionsBoundaryAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).SSOAdminConn
+
+	permissionSetArn, instanceArn, err := ParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.Errorf("error parsing SSO Permissions Boundary Attachment ID: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting SSO Permissions Boundary Attachment: %s", d.Id())
+	_, err = conn.DeletePermissionsBoundaryFromPermissionSetWithContext(ctx, &ssoadmin.DeletePermissionsBoundaryFromPermissionSetInput{
+		InstanceArn:      aws.String(instanceArn),
+		PermissionSetArn: aws.String(permissionSetArn),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ssoadmin.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Permissions Boundary from SSO Permission Set (%s): %s", permissionSetArn, err)
+	}
+
+	// Provision ALL accounts after deleting the permissions boundary
+	if err := provisionSsoAdminPermissionSet(conn, permissionSetArn, instanceArn); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func expandPermissionsBoundary(tfList []interface{}) *ssoadmin.PermissionsBoundary {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	apiObject := &ssoadmin.PermissionsBoundary{}
+
+	if v, ok := tfMap["customer_managed_policy_reference"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CustomerManagedPolicyReference = expandCustomerManagedPolicyReference(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["managed_policy_arn"].(string); ok && v != "" {
+		apiObject.ManagedPolicyArn = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPermissionsBoundary(apiObject *ssoadmin.PermissionsBoundary) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CustomerManagedPolicyReference; v != nil {
+		tfMap["customer_managed_policy_reference"] = []interface{}{flattenCustomerManagedPolicyReference(v)}
+	}
+
+	if v := apiObject.ManagedPolicyArn; v != nil {
+		tfMap["managed_policy_arn"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
@@ -9,14 +9,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_storagegateway_gateway", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_storagegateway_gateway", &resource.Sweeper{
 		Name: "aws_storagegateway_gateway",
 		F:    sweepGateways,
 	})
@@ -28,38 +28,38 @@ func sweepGateways(region string) error {
 		return fmt.Errorf("error getting client: %w", err)
 	}
 	conn := client.(*conns.AWSClient).StorageGatewayConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	err = conn.ListGatewaysPages(&storagegateway.ListGatewaysInput{}, func(page *storagegateway.ListGatewaysOutput, lastPage bool) bool {
-		if len(page.Gateways) == 0 {
-			log.Print("[DEBUG] No Storage Gateway Gateways to sweep")
-			return true
+		if page == nil {
+			return !lastPage
 		}
 
 		for _, gateway := range page.Gateways {
-			name := aws.StringValue(gateway.GatewayName)
+			r := ResourceGateway()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(gateway.GatewayARN))
+			d.Set("gateway_name", gateway.GatewayName)
 
-			log.Printf("[INFO] Deleting Storage Gateway Gateway: %s", name)
-			input := &storagegateway.DeleteGatewayInput{
-				GatewayARN: gateway.GatewayARN,
-			}
-
-			_, err := conn.DeleteGateway(input)
-			if err != nil {
-				if tfawserr.ErrMessageContains(err, storagegateway.ErrorCodeGatewayNotFound, "") {
-					continue
-				}
-				log.Printf("[ERROR] Failed to delete Storage Gateway Gateway (%s): %s", name, err)
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
+
 	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Storage Gateway Gateway sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error retrieving Storage Gateway Gateways: %w", err)
+		errs = multierror.Append(errs, fmt.Errorf("error listing Storage Gateway Gateways for %s: %w", region, err))
+	}
+
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Storage Gateway Gateways for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Storage Gateway Gateway sweep for %s: %s", region, errs)
+		return nil
 	}
-	return nil
+
+	return errs.ErrorOrNil()
 }
@@ -16,7 +16,7 @@ import (
 )
 
 func init() {
-	resource.AddTestSweepers("aws_synthetics_canary", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_synthetics_canary", &resource.Sweeper{
 		Name: "aws_synthetics_canary",
 		F:    sweepCanaries,
 		Dependencies: []string{
@@ -34,32 +34,24 @@ func sweepCanaries(region string) error {
 	}
 	conn := client.(*conns.AWSClient).SyntheticsConn
 	input := &synthetics.DescribeCanariesInput{}
-	var sweeperErrs *multierror.Error
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
 	for {
 		output, err := conn.DescribeCanaries(input)
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping Synthetics Canary sweep for %s: %s", region, err)
-			return nil
-		}
+
 		if err != nil {
-			return fmt.Errorf("error retrieving Synthetics Canaries: %w", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing Synthetics Canaries for %s: %w", region, err))
+			break
 		}
 
 		for _, canary := range output.Canaries {
-			name := aws.StringValue(canary.Name)
-			log.Printf("[INFO] Deleting Synthetics Canary: %s", name)
-
 			r := ResourceCanary()
 			d := r.Data(nil)
-			d.SetId(name)
-			err := r.Delete(d, client)
+			d.SetId(aws.StringValue(canary.Name))
+			d.Set("name", canary.Name)
 
-			if err != nil {
-				log.Printf("[ERROR] %s", err)
-				sweeperErrs = multierror.Append(sweeperErrs, err)
-				continue
-			}
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		if aws.StringValue(output.NextToken) == "" {
@@ -68,5 +60,14 @@ func sweepCanaries(region string) error {
 		input.NextToken = output.NextToken
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Synthetics Canaries for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Synthetics Canary sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
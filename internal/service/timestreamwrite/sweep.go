@@ -11,20 +11,19 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/timestreamwrite"
 	"github.com/hashicorp/go-multierror"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_timestreamwrite_database", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_timestreamwrite_database", &resource.Sweeper{
 		Name:         "aws_timestreamwrite_database",
 		F:            sweepDatabases,
 		Dependencies: []string{"aws_timestreamwrite_table"},
 	})
 
-	resource.AddTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_timestreamwrite_table", &resource.Sweeper{
 		Name: "aws_timestreamwrite_table",
 		F:    sweepTables,
 	})
@@ -37,12 +36,10 @@ func sweepDatabases(region string) error {
 	}
 	conn := client.(*conns.AWSClient).TimestreamWriteConn
 	ctx := context.Background()
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	var sweeperErrs *multierror.Error
-
-	input := &timestreamwrite.ListDatabasesInput{}
-
-	err = conn.ListDatabasesPagesWithContext(ctx, input, func(page *timestreamwrite.ListDatabasesOutput, lastPage bool) bool {
+	err = conn.ListDatabasesPagesWithContext(ctx, &timestreamwrite.ListDatabasesInput{}, func(page *timestreamwrite.ListDatabasesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -52,39 +49,31 @@ func sweepDatabases(region string) error {
 				continue
 			}
 
-			dbName := aws.StringValue(database.DatabaseName)
-
-			log.Printf("[INFO] Deleting Timestream Database (%s)", dbName)
 			r := ResourceDatabase()
 			d := r.Data(nil)
-			d.SetId(dbName)
-
-			diags := r.DeleteWithoutTimeout(ctx, d, client)
-
-			if diags != nil && diags.HasError() {
-				for _, d := range diags {
-					if d.Severity == diag.Error {
-						sweeperErr := fmt.Errorf("error deleting Timestream Database (%s): %s", dbName, d.Summary)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					}
-				}
-			}
+			d.SetId(aws.StringValue(database.DatabaseName))
+			d.Set("database_name", database.DatabaseName)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Timestream Database sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Timestream Databases for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Timestream Databases: %w", err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Timestream Databases for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Timestream Database sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
 
 func sweepTables(region string) error {
@@ -94,12 +83,10 @@ func sweepTables(region string) error {
 	}
 	conn := client.(*conns.AWSClient).TimestreamWriteConn
 	ctx := context.Background()
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
 
-	var sweeperErrs *multierror.Error
-
-	input := &timestreamwrite.ListTablesInput{}
-
-	err = conn.ListTablesPagesWithContext(ctx, input, func(page *timestreamwrite.ListTablesOutput, lastPage bool) bool {
+	err = conn.ListTablesPagesWithContext(ctx, &timestreamwrite.ListTablesInput{}, func(page *timestreamwrite.ListTablesOutput, lastPage bool) bool {
 		if page == nil {
 			return !lastPage
 		}
@@ -109,38 +96,30 @@ func sweepTables(region string) error {
 				continue
 			}
 
-			tableName := aws.StringValue(table.TableName)
-			dbName := aws.StringValue(table.TableName)
-
-			log.Printf("[INFO] Deleting Timestream Table (%s) from Database (%s)", tableName, dbName)
 			r := ResourceTable()
 			d := r.Data(nil)
-			d.SetId(fmt.Sprintf("%s:%s", tableName, dbName))
-
-			diags := r.DeleteWithoutTimeout(ctx, d, client)
-
-			if diags != nil && diags.HasError() {
-				for _, d := range diags {
-					if d.Severity == diag.Error {
-						sweeperErr := fmt.Errorf("error deleting Timestream Table (%s): %s", dbName, d.Summary)
-						log.Printf("[ERROR] %s", sweeperErr)
-						sweeperErrs = multierror.Append(sweeperErrs, sweeperErr)
-					}
-				}
-			}
+			d.SetId(fmt.Sprintf("%s:%s", aws.StringValue(table.TableName), aws.StringValue(table.DatabaseName)))
+			d.Set("database_name", table.DatabaseName)
+			d.Set("table_name", table.TableName)
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 		}
 
 		return !lastPage
 	})
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping Timestream Table sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+	if err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error listing Timestream Tables for %s: %w", region, err))
 	}
 
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error listing Timestream Tables: %w", err))
+	if err := sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping Timestream Tables for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping Timestream Table sweep for %s: %s", region, errs)
+		return nil
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	return errs.ErrorOrNil()
 }
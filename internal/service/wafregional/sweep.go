@@ -6,20 +6,18 @@ package wafregional
 import (
 	"fmt"
 	"log"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/waf"
-	"github.com/aws/aws-sdk-go/service/wafregional"
-	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
-	tfwaf "github.com/hashicorp/terraform-provider-aws/internal/service/waf"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 )
 
 func init() {
-	resource.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rate_based_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rate_based_rule",
 		F:    sweepRateBasedRules,
 		Dependencies: []string{
@@ -27,17 +25,17 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_regex_match_set", &resource.Sweeper{
 		Name: "aws_wafregional_regex_match_set",
 		F:    sweepRegexMatchSet,
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rule_group", &resource.Sweeper{
 		Name: "aws_wafregional_rule_group",
 		F:    sweepRuleGroups,
 	})
 
-	resource.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_rule", &resource.Sweeper{
 		Name: "aws_wafregional_rule",
 		F:    sweepRules,
 		Dependencies: []string{
@@ -45,7 +43,7 @@ func init() {
 		},
 	})
 
-	resource.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
+	sweep.AddTestSweepers("aws_wafregional_web_acl", &resource.Sweeper{
 		Name: "aws_wafregional_web_acl",
 		F:    sweepWebACLs,
 	})
@@ -57,81 +55,50 @@ func sweepRateBasedRules(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).WAFRegionalConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+	var g multierror.Group
+	var mutex = &sync.Mutex{}
 
 	input := &waf.ListRateBasedRulesInput{}
 
 	for {
 		output, err := conn.ListRateBasedRules(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping WAF Regional Rate-Based Rule sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing WAF Regional Rate-Based Rules: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing WAF Regional Rate-Based Rules for %s: %w", region, err))
+			break
 		}
 
 		for _, rule := range output.Rules {
-			deleteInput := &waf.DeleteRateBasedRuleInput{
-				RuleId: rule.RuleId,
-			}
-			id := aws.StringValue(rule.RuleId)
-			wr := NewRetryer(conn, region)
-
-			_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
-				return conn.DeleteRateBasedRule(deleteInput)
-			})
-
-			if tfawserr.ErrMessageContains(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
-				getRateBasedRuleInput := &waf.GetRateBasedRuleInput{
-					RuleId: rule.RuleId,
-				}
+			r := ResourceRateBasedRule()
+			d := r.Data(nil)
 
-				getRateBasedRuleOutput, getRateBasedRuleErr := conn.GetRateBasedRule(getRateBasedRuleInput)
-
-				if getRateBasedRuleErr != nil {
-					return fmt.Errorf("error getting WAF Regional Rate-Based Rule (%s): %s", id, getRateBasedRuleErr)
-				}
-
-				var updates []*waf.RuleUpdate
-				updateRateBasedRuleInput := &waf.UpdateRateBasedRuleInput{
-					RateLimit: getRateBasedRuleOutput.Rule.RateLimit,
-					RuleId:    rule.RuleId,
-					Updates:   updates,
-				}
+			id := aws.StringValue(rule.RuleId)
+			d.SetId(id)
 
-				for _, predicate := range getRateBasedRuleOutput.Rule.MatchPredicates {
-					update := &waf.RuleUpdate{
-						Action:    aws.String(waf.ChangeActionDelete),
-						Predicate: predicate,
-					}
+			// read concurrently and gather errors
+			g.Go(func() error {
+				// Need to Read first to fill in predicates attribute
+				err := r.Read(d, client)
 
-					updateRateBasedRuleInput.Updates = append(updateRateBasedRuleInput.Updates, update)
+				if err != nil {
+					sweeperErr := fmt.Errorf("error reading WAF Regional Rate-Based Rule (%s): %w", id, err)
+					log.Printf("[ERROR] %s", sweeperErr)
+					return sweeperErr
 				}
 
-				_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
-					updateRateBasedRuleInput.ChangeToken = token
-					log.Printf("[INFO] Removing Predicates from WAF Regional Rate-Based Rule: %s", id)
-					return conn.UpdateRateBasedRule(updateRateBasedRuleInput)
-				})
-
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing predicates from WAF Regional Rate-Based Rule (%s): %s", id, updateWebACLErr)
+				// In case it was already deleted
+				if d.Id() == "" {
+					return nil
 				}
 
-				_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Rate-Based Rule: %s", id)
-					return conn.DeleteRateBasedRule(deleteInput)
-				})
-			}
+				mutex.Lock()
+				defer mutex.Unlock()
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Rate-Based Rule (%s): %s", id, err)
-			}
+				return nil
+			})
 		}
 
 		if aws.StringValue(output.NextMarker) == "" {
@@ -141,7 +108,20 @@ func sweepRateBasedRules(region string) error {
 		input.NextMarker = output.NextMarker
 	}
 
-	return nil
+	if err = g.Wait().ErrorOrNil(); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Regional Rate-Based Rules: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Regional Rate-Based Rules for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping WAF Regional Rate-Based Rule sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepRegexMatchSet(region string) error {
@@ -150,44 +130,73 @@ func sweepRegexMatchSet(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).WAFRegionalConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+	var g multierror.Group
+	var mutex = &sync.Mutex{}
 
-	var sweeperErrs *multierror.Error
+	input := &waf.ListRegexMatchSetsInput{}
+
+	for {
+		output, err := conn.ListRegexMatchSets(input)
 
-	err = listRegexMatchSetsPages(conn, &waf.ListRegexMatchSetsInput{}, func(page *waf.ListRegexMatchSetsOutput, lastPage bool) bool {
-		if page == nil {
-			return !lastPage
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("error listing WAF Regional Regex Match Sets for %s: %w", region, err))
+			break
 		}
 
-		for _, r := range page.RegexMatchSets {
-			id := aws.StringValue(r.RegexMatchSetId)
+		for _, regexMatchSet := range output.RegexMatchSets {
+			r := ResourceRegexMatchSet()
+			d := r.Data(nil)
+
+			id := aws.StringValue(regexMatchSet.RegexMatchSetId)
+			d.SetId(id)
 
-			set, err := FindRegexMatchSetByID(conn, id)
-			if err != nil {
-				sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error retrieving WAF Regional Regex Match Set (%s): %w", id, err))
-				continue
-			}
+			// read concurrently and gather errors
+			g.Go(func() error {
+				// Need to Read first to fill in regex_match_tuple attribute
+				err := r.Read(d, client)
 
-			err = DeleteRegexMatchSetResource(conn, region, region, id, GetRegexMatchTuplesFromAPIResource(set))
-			if err != nil {
-				if !tfawserr.ErrCodeEquals(err, wafregional.ErrCodeWAFNonexistentItemException) {
-					sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error deleting WAF Regional Regex Match Set (%s): %w", id, err))
+				if err != nil {
+					sweeperErr := fmt.Errorf("error reading WAF Regional Regex Match Set (%s): %w", id, err)
+					log.Printf("[ERROR] %s", sweeperErr)
+					return sweeperErr
 				}
-				continue
-			}
+
+				// In case it was already deleted
+				if d.Id() == "" {
+					return nil
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+
+				return nil
+			})
 		}
 
-		return !lastPage
-	})
+		if aws.StringValue(output.NextMarker) == "" {
+			break
+		}
 
-	if sweep.SkipSweepError(err) {
-		log.Printf("[WARN] Skipping WAF Regional Regex Match Set sweep for %s: %s", region, err)
-		return sweeperErrs.ErrorOrNil() // In case we have completed some pages, but had errors
+		input.NextMarker = output.NextMarker
 	}
-	if err != nil {
-		sweeperErrs = multierror.Append(sweeperErrs, fmt.Errorf("error describing WAF Regional Regex Match Sets: %w", err))
+
+	if err = g.Wait().ErrorOrNil(); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Regional Regex Match Sets: %w", err))
 	}
 
-	return sweeperErrs.ErrorOrNil()
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Regional Regex Match Sets for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping WAF Regional Regex Match Set sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepRuleGroups(region string) error {
@@ -196,37 +205,73 @@ func sweepRuleGroups(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).WAFRegionalConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+	var g multierror.Group
+	var mutex = &sync.Mutex{}
 
-	req := &waf.ListRuleGroupsInput{}
-	resp, err := conn.ListRuleGroups(req)
-	if err != nil {
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping WAF Regional Rule Group sweep for %s: %s", region, err)
-			return nil
-		}
-		return fmt.Errorf("Error describing WAF Regional Rule Groups: %s", err)
-	}
+	input := &waf.ListRuleGroupsInput{}
 
-	if len(resp.RuleGroups) == 0 {
-		log.Print("[DEBUG] No AWS WAF Regional Rule Groups to sweep")
-		return nil
-	}
+	for {
+		output, err := conn.ListRuleGroups(input)
 
-	for _, group := range resp.RuleGroups {
-		rResp, err := conn.ListActivatedRulesInRuleGroup(&waf.ListActivatedRulesInRuleGroupInput{
-			RuleGroupId: group.RuleGroupId,
-		})
 		if err != nil {
-			return err
+			errs = multierror.Append(errs, fmt.Errorf("error listing WAF Regional Rule Groups for %s: %w", region, err))
+			break
 		}
-		oldRules := tfwaf.FlattenActivatedRules(rResp.ActivatedRules)
-		err = DeleteRuleGroup(*group.RuleGroupId, oldRules, conn, region)
-		if err != nil {
-			return err
+
+		for _, ruleGroup := range output.RuleGroups {
+			r := ResourceRuleGroup()
+			d := r.Data(nil)
+
+			id := aws.StringValue(ruleGroup.RuleGroupId)
+			d.SetId(id)
+
+			// read concurrently and gather errors
+			g.Go(func() error {
+				// Need to Read first to fill in activated_rule attribute
+				err := r.Read(d, client)
+
+				if err != nil {
+					sweeperErr := fmt.Errorf("error reading WAF Regional Rule Group (%s): %w", id, err)
+					log.Printf("[ERROR] %s", sweeperErr)
+					return sweeperErr
+				}
+
+				// In case it was already deleted
+				if d.Id() == "" {
+					return nil
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+
+				return nil
+			})
 		}
+
+		if aws.StringValue(output.NextMarker) == "" {
+			break
+		}
+
+		input.NextMarker = output.NextMarker
+	}
+
+	if err = g.Wait().ErrorOrNil(); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Regional Rule Groups: %w", err))
 	}
 
-	return nil
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Regional Rule Groups for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping WAF Regional Rule Group sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepRules(region string) error {
@@ -235,80 +280,50 @@ func sweepRules(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).WAFRegionalConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+	var g multierror.Group
+	var mutex = &sync.Mutex{}
 
 	input := &waf.ListRulesInput{}
 
 	for {
 		output, err := conn.ListRules(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping WAF Regional Rule sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing WAF Regional Rules: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing WAF Regional Rules for %s: %w", region, err))
+			break
 		}
 
 		for _, rule := range output.Rules {
-			deleteInput := &waf.DeleteRuleInput{
-				RuleId: rule.RuleId,
-			}
-			id := aws.StringValue(rule.RuleId)
-			wr := NewRetryer(conn, region)
-
-			_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
-				return conn.DeleteRule(deleteInput)
-			})
-
-			if tfawserr.ErrMessageContains(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
-				getRuleInput := &waf.GetRuleInput{
-					RuleId: rule.RuleId,
-				}
-
-				getRuleOutput, getRuleErr := conn.GetRule(getRuleInput)
-
-				if getRuleErr != nil {
-					return fmt.Errorf("error getting WAF Regional Rule (%s): %s", id, getRuleErr)
-				}
+			r := ResourceRule()
+			d := r.Data(nil)
 
-				var updates []*waf.RuleUpdate
-				updateRuleInput := &waf.UpdateRuleInput{
-					RuleId:  rule.RuleId,
-					Updates: updates,
-				}
+			id := aws.StringValue(rule.RuleId)
+			d.SetId(id)
 
-				for _, predicate := range getRuleOutput.Rule.Predicates {
-					update := &waf.RuleUpdate{
-						Action:    aws.String(waf.ChangeActionDelete),
-						Predicate: predicate,
-					}
+			// read concurrently and gather errors
+			g.Go(func() error {
+				// Need to Read first to fill in predicates attribute
+				err := r.Read(d, client)
 
-					updateRuleInput.Updates = append(updateRuleInput.Updates, update)
+				if err != nil {
+					sweeperErr := fmt.Errorf("error reading WAF Regional Rule (%s): %w", id, err)
+					log.Printf("[ERROR] %s", sweeperErr)
+					return sweeperErr
 				}
 
-				_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
-					updateRuleInput.ChangeToken = token
-					log.Printf("[INFO] Removing Predicates from WAF Regional Rule: %s", id)
-					return conn.UpdateRule(updateRuleInput)
-				})
-
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing predicates from WAF Regional Rule (%s): %s", id, updateWebACLErr)
+				// In case it was already deleted
+				if d.Id() == "" {
+					return nil
 				}
 
-				_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Rule: %s", id)
-					return conn.DeleteRule(deleteInput)
-				})
-			}
+				mutex.Lock()
+				defer mutex.Unlock()
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Rule (%s): %s", id, err)
-			}
+				return nil
+			})
 		}
 
 		if aws.StringValue(output.NextMarker) == "" {
@@ -318,7 +333,20 @@ func sweepRules(region string) error {
 		input.NextMarker = output.NextMarker
 	}
 
-	return nil
+	if err = g.Wait().ErrorOrNil(); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Regional Rules: %w", err))
+	}
+
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Regional Rules for %s: %w", region, err))
+	}
+
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping WAF Regional Rule sweep for %s: %s", region, errs)
+		return nil
+	}
+
+	return errs.ErrorOrNil()
 }
 
 func sweepWebACLs(region string) error {
@@ -327,81 +355,50 @@ func sweepWebACLs(region string) error {
 		return fmt.Errorf("error getting client: %s", err)
 	}
 	conn := client.(*conns.AWSClient).WAFRegionalConn
+	sweepResources := make([]*sweep.SweepResource, 0)
+	var errs *multierror.Error
+	var g multierror.Group
+	var mutex = &sync.Mutex{}
 
 	input := &waf.ListWebACLsInput{}
 
 	for {
 		output, err := conn.ListWebACLs(input)
 
-		if sweep.SkipSweepError(err) {
-			log.Printf("[WARN] Skipping WAF Regional Web ACL sweep for %s: %s", region, err)
-			return nil
-		}
-
 		if err != nil {
-			return fmt.Errorf("error listing WAF Regional Web ACLs: %s", err)
+			errs = multierror.Append(errs, fmt.Errorf("error listing WAF Regional Web ACLs for %s: %w", region, err))
+			break
 		}
 
 		for _, webACL := range output.WebACLs {
-			deleteInput := &waf.DeleteWebACLInput{
-				WebACLId: webACL.WebACLId,
-			}
-			id := aws.StringValue(webACL.WebACLId)
-			wr := NewRetryer(conn, region)
-
-			_, err := wr.RetryWithToken(func(token *string) (interface{}, error) {
-				deleteInput.ChangeToken = token
-				log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
-				return conn.DeleteWebACL(deleteInput)
-			})
-
-			if tfawserr.ErrMessageContains(err, wafregional.ErrCodeWAFNonEmptyEntityException, "") {
-				getWebACLInput := &waf.GetWebACLInput{
-					WebACLId: webACL.WebACLId,
-				}
+			r := ResourceWebACL()
+			d := r.Data(nil)
 
-				getWebACLOutput, getWebACLErr := conn.GetWebACL(getWebACLInput)
+			id := aws.StringValue(webACL.WebACLId)
+			d.SetId(id)
 
-				if getWebACLErr != nil {
-					return fmt.Errorf("error getting WAF Regional Web ACL (%s): %s", id, getWebACLErr)
-				}
+			// read concurrently and gather errors
+			g.Go(func() error {
+				// Need to Read first to fill in rules attribute
+				err := r.Read(d, client)
 
-				var updates []*waf.WebACLUpdate
-				updateWebACLInput := &waf.UpdateWebACLInput{
-					DefaultAction: getWebACLOutput.WebACL.DefaultAction,
-					Updates:       updates,
-					WebACLId:      webACL.WebACLId,
+				if err != nil {
+					sweeperErr := fmt.Errorf("error reading WAF Regional Web ACL (%s): %w", id, err)
+					log.Printf("[ERROR] %s", sweeperErr)
+					return sweeperErr
 				}
 
-				for _, rule := range getWebACLOutput.WebACL.Rules {
-					update := &waf.WebACLUpdate{
-						Action:        aws.String(waf.ChangeActionDelete),
-						ActivatedRule: rule,
-					}
-
-					updateWebACLInput.Updates = append(updateWebACLInput.Updates, update)
+				// In case it was already deleted
+				if d.Id() == "" {
+					return nil
 				}
 
-				_, updateWebACLErr := wr.RetryWithToken(func(token *string) (interface{}, error) {
-					updateWebACLInput.ChangeToken = token
-					log.Printf("[INFO] Removing Rules from WAF Regional Web ACL: %s", id)
-					return conn.UpdateWebACL(updateWebACLInput)
-				})
-
-				if updateWebACLErr != nil {
-					return fmt.Errorf("error removing rules from WAF Regional Web ACL (%s): %s", id, updateWebACLErr)
-				}
+				mutex.Lock()
+				defer mutex.Unlock()
+				sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
 
-				_, err = wr.RetryWithToken(func(token *string) (interface{}, error) {
-					deleteInput.ChangeToken = token
-					log.Printf("[INFO] Deleting WAF Regional Web ACL: %s", id)
-					return conn.DeleteWebACL(deleteInput)
-				})
-			}
-
-			if err != nil {
-				return fmt.Errorf("error deleting WAF Regional Web ACL (%s): %s", id, err)
-			}
+				return nil
+			})
 		}
 
 		if aws.StringValue(output.NextMarker) == "" {
@@ -411,22 +408,18 @@ func sweepWebACLs(region string) error {
 		input.NextMarker = output.NextMarker
 	}
 
-	return nil
-}
-
-func listRegexMatchSetsPages(conn *wafregional.WAFRegional, input *waf.ListRegexMatchSetsInput, fn func(*waf.ListRegexMatchSetsOutput, bool) bool) error {
-	for {
-		output, err := conn.ListRegexMatchSets(input)
-		if err != nil {
-			return err
-		}
+	if err = g.Wait().ErrorOrNil(); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error concurrently reading WAF Regional Web ACLs: %w", err))
+	}
 
-		lastPage := aws.StringValue(output.NextMarker) == ""
-		if !fn(output, lastPage) || lastPage {
-			break
-		}
+	if err = sweep.SweepOrchestrator(sweepResources); err != nil {
+		errs = multierror.Append(errs, fmt.Errorf("error sweeping WAF Regional Web ACLs for %s: %w", region, err))
+	}
 
-		input.NextMarker = output.NextMarker
+	if sweep.SkipSweepError(errs.ErrorOrNil()) {
+		log.Printf("[WARN] Skipping WAF Regional Web ACL sweep for %s: %s", region, errs)
+		return nil
 	}
-	return nil
+
+	return errs.ErrorOrNil()
 }
@@ -0,0 +1,228 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// creationTimeAttributes are the resource attributes, in order of preference,
+// from which a resource's creation time is read when filtering by age.
+var creationTimeAttributes = []string{
+	"creation_date",
+	"creation_time",
+	"create_date",
+	"create_time",
+	"created_date",
+	"created_time",
+	"created_at",
+	"launch_time",
+}
+
+// Filter restricts the resources deleted by sweepers.
+// A resource must satisfy every configured condition to be deleted.
+// The zero value matches all resources.
+type Filter struct {
+	// NamePrefixes, if not empty, requires the resource name to start with one of the prefixes.
+	NamePrefixes []string
+	// NameRegexp, if set, requires the resource name to match the regular expression.
+	NameRegexp *regexp.Regexp
+	// Tags requires the resource to have all the tags.
+	// An empty value requires only that the tag key is present.
+	Tags map[string]string
+	// MinAge, if non-zero, requires the resource to have been created at least this long ago.
+	MinAge time.Duration
+}
+
+// FilterFromEnv returns the Filter configured by the sweeper environment variables.
+func FilterFromEnv() (*Filter, error) {
+	f := &Filter{}
+
+	if v := os.Getenv(conns.EnvVarSweepNamePrefixes); v != "" {
+		for _, prefix := range strings.Split(v, ",") {
+			if prefix = strings.TrimSpace(prefix); prefix != "" {
+				f.NamePrefixes = append(f.NamePrefixes, prefix)
+			}
+		}
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepNameRegex); v != "" {
+		re, err := regexp.Compile(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepNameRegex, err)
+		}
+
+		f.NameRegexp = re
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepTags); v != "" {
+		f.Tags = make(map[string]string)
+
+		for _, tag := range strings.Split(v, ",") {
+			if tag = strings.TrimSpace(tag); tag == "" {
+				continue
+			}
+
+			parts := strings.SplitN(tag, "=", 2)
+			key := strings.TrimSpace(parts[0])
+
+			if key == "" {
+				return nil, fmt.Errorf("environment variable %s: empty tag key in %q", conns.EnvVarSweepTags, tag)
+			}
+
+			if len(parts) == 2 {
+				f.Tags[key] = strings.TrimSpace(parts[1])
+			} else {
+				f.Tags[key] = ""
+			}
+		}
+	}
+
+	if v := os.Getenv(conns.EnvVarSweepMinAge); v != "" {
+		d, err := time.ParseDuration(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepMinAge, err)
+		}
+
+		f.MinAge = d
+	}
+
+	return f, nil
+}
+
+// Match returns whether the resource satisfies the filter and, if not, the reason why.
+// Resources for which a condition cannot be evaluated (e.g. no tags or creation time
+// were read by the sweeper) do not satisfy that condition.
+func (f *Filter) Match(sweepResource *SweepResource, now time.Time) (bool, string) {
+	if f == nil {
+		return true, ""
+	}
+
+	name := sweepResource.name()
+
+	if len(f.NamePrefixes) > 0 {
+		matched := false
+
+		for _, prefix := range f.NamePrefixes {
+			if strings.HasPrefix(name, prefix) {
+				matched = true
+				break
+			}
+		}
+
+		if !matched {
+			return false, fmt.Sprintf("name %q does not start with any of: %s", name, strings.Join(f.NamePrefixes, ", "))
+		}
+	}
+
+	if f.NameRegexp != nil && !f.NameRegexp.MatchString(name) {
+		return false, fmt.Sprintf("name %q does not match %q", name, f.NameRegexp.String())
+	}
+
+	if len(f.Tags) > 0 {
+		tags := sweepResource.tags()
+		keys := make([]string, 0, len(f.Tags))
+
+		for k := range f.Tags {
+			keys = append(keys, k)
+		}
+
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			v, ok := tags[k]
+
+			if !ok {
+				return false, fmt.Sprintf("tag %q is missing", k)
+			}
+
+			if want := f.Tags[k]; want != "" && v != want {
+				return false, fmt.Sprintf("tag %q has value %q, expected %q", k, v, want)
+			}
+		}
+	}
+
+	if f.MinAge > 0 {
+		created, ok := sweepResource.creationTime()
+
+		if !ok {
+			return false, "creation time is unknown"
+		}
+
+		if age := now.Sub(created); age < f.MinAge {
+			return false, fmt.Sprintf("age %s is less than %s", age.Round(time.Second), f.MinAge)
+		}
+	}
+
+	return true, ""
+}
+
+// name returns the resource's name attribute, if any, otherwise its ID.
+func (sr *SweepResource) name() string {
+	if _, ok := sr.resource.Schema["name"]; ok {
+		if v, ok := sr.d.GetOk("name"); ok {
+			return v.(string)
+		}
+	}
+
+	return sr.d.Id()
+}
+
+func (sr *SweepResource) tags() map[string]string {
+	tags := make(map[string]string)
+
+	for _, attr := range []string{"tags_all", "tags"} {
+		if s, ok := sr.resource.Schema[attr]; !ok || s.Type != schema.TypeMap {
+			continue
+		}
+
+		for k, v := range sr.d.Get(attr).(map[string]interface{}) {
+			if _, ok := tags[k]; !ok {
+				tags[k] = v.(string)
+			}
+		}
+	}
+
+	return tags
+}
+
+func (sr *SweepResource) creationTime() (time.Time, bool) {
+	for _, attr := range creationTimeAttributes {
+		s, ok := sr.resource.Schema[attr]
+
+		if !ok || s.Type != schema.TypeString {
+			continue
+		}
+
+		v, ok := sr.d.GetOk(attr)
+
+		if !ok {
+			continue
+		}
+
+		if t, err := time.Parse(time.RFC3339, v.(string)); err == nil {
+			return t, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (sr *SweepResource) region() string {
+	if client, ok := sr.meta.(*conns.AWSClient); ok {
+		return client.Region
+	}
+
+	return ""
+}
@@ -0,0 +1,241 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func testSweepResource(t *testing.T, id string, attributes map[string]interface{}) *SweepResource {
+	r := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"creation_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+
+	d := r.Data(nil)
+	d.SetId(id)
+
+	for k, v := range attributes {
+		if err := d.Set(k, v); err != nil {
+			t.Fatalf("error setting %s: %s", k, err)
+		}
+	}
+
+	return NewSweepResource(r, d, &conns.AWSClient{Region: "us-west-2"}) //lintignore:AWSAT003
+}
+
+func TestFilterMatch(t *testing.T) {
+	now := time.Date(2021, 12, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name          string
+		Filter        *Filter
+		SweepResource *SweepResource
+		Expected      bool
+	}{
+		{
+			Name:          "nil filter",
+			Filter:        nil,
+			SweepResource: testSweepResource(t, "id-1", nil),
+			Expected:      true,
+		},
+		{
+			Name:          "empty filter",
+			Filter:        &Filter{},
+			SweepResource: testSweepResource(t, "id-1", nil),
+			Expected:      true,
+		},
+		{
+			Name:          "name prefix matches name",
+			Filter:        &Filter{NamePrefixes: []string{"other", "tf-acc-test"}},
+			SweepResource: testSweepResource(t, "id-1", map[string]interface{}{"name": "tf-acc-test-1234"}),
+			Expected:      true,
+		},
+		{
+			Name:          "name prefix does not match name",
+			Filter:        &Filter{NamePrefixes: []string{"tf-acc-test"}},
+			SweepResource: testSweepResource(t, "tf-acc-test-id", map[string]interface{}{"name": "production"}),
+			Expected:      false,
+		},
+		{
+			Name:          "name prefix matches ID",
+			Filter:        &Filter{NamePrefixes: []string{"tf-acc-test"}},
+			SweepResource: testSweepResource(t, "tf-acc-test-id", nil),
+			Expected:      true,
+		},
+		{
+			Name:          "name regexp matches",
+			Filter:        &Filter{NameRegexp: regexp.MustCompile(`^tf-acc-test-\d+$`)},
+			SweepResource: testSweepResource(t, "id-1", map[string]interface{}{"name": "tf-acc-test-1234"}),
+			Expected:      true,
+		},
+		{
+			Name:          "name regexp does not match",
+			Filter:        &Filter{NameRegexp: regexp.MustCompile(`^tf-acc-test-\d+$`)},
+			SweepResource: testSweepResource(t, "id-1", map[string]interface{}{"name": "tf-acc-test-abcd"}),
+			Expected:      false,
+		},
+		{
+			Name:          "tags match",
+			Filter:        &Filter{Tags: map[string]string{"Environment": "test", "Owner": ""}},
+			SweepResource: testSweepResource(t, "id-1", map[string]interface{}{"tags": map[string]interface{}{"Environment": "test", "Owner": "team1"}}),
+			Expected:      true,
+		},
+		{
+			Name:          "tag value does not match",
+			Filter:        &Filter{Tags: map[string]string{"Environment": "test"}},
+			SweepResource: testSweepResource(t, "id-1", map[string]interface{}{"tags": map[string]interface{}{"Environment": "production"}}),
+			Expected:      false,
+		},
+		{
+			Name:          "tag missing",
+			Filter:        &Filter{Tags: map[string]string{"Owner": ""}},
+			SweepResource: testSweepResource(t, "id-1", map[string]interface{}{"tags": map[string]interface{}{"Environment": "test"}}),
+			Expected:      false,
+		},
+		{
+			Name:          "old enough",
+			Filter:        &Filter{MinAge: 2 * time.Hour},
+			SweepResource: testSweepResource(t, "id-1", map[string]interface{}{"creation_date": "2021-12-01T09:00:00Z"}),
+			Expected:      true,
+		},
+		{
+			Name:          "too new",
+			Filter:        &Filter{MinAge: 2 * time.Hour},
+			SweepResource: testSweepResource(t, "id-1", map[string]interface{}{"creation_date": "2021-12-01T11:00:00Z"}),
+			Expected:      false,
+		},
+		{
+			Name:          "creation time unknown",
+			Filter:        &Filter{MinAge: 2 * time.Hour},
+			SweepResource: testSweepResource(t, "id-1", nil),
+			Expected:      false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, reason := testCase.Filter.Match(testCase.SweepResource, now)
+
+			if got != testCase.Expected {
+				t.Errorf("got %t (%s), expected %t", got, reason, testCase.Expected)
+			}
+
+			if !got && reason == "" {
+				t.Errorf("expected reason for non-matching resource")
+			}
+		})
+	}
+}
+
+func TestFilterFromEnv(t *testing.T) {
+	os.Setenv(conns.EnvVarSweepNamePrefixes, "tf-acc-test, tf_acc_test")
+	defer os.Unsetenv(conns.EnvVarSweepNamePrefixes)
+	os.Setenv(conns.EnvVarSweepNameRegex, `^tf`)
+	defer os.Unsetenv(conns.EnvVarSweepNameRegex)
+	os.Setenv(conns.EnvVarSweepTags, "Environment=test,Owner")
+	defer os.Unsetenv(conns.EnvVarSweepTags)
+	os.Setenv(conns.EnvVarSweepMinAge, "1h")
+	defer os.Unsetenv(conns.EnvVarSweepMinAge)
+
+	f, err := FilterFromEnv()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, expected := len(f.NamePrefixes), 2; got != expected {
+		t.Errorf("got %d name prefixes, expected %d", got, expected)
+	}
+
+	if f.NameRegexp == nil || f.NameRegexp.String() != `^tf` {
+		t.Errorf("got name regexp %v, expected %q", f.NameRegexp, `^tf`)
+	}
+
+	if v, ok := f.Tags["Environment"]; !ok || v != "test" {
+		t.Errorf("got Environment tag %q, expected %q", v, "test")
+	}
+
+	if v, ok := f.Tags["Owner"]; !ok || v != "" {
+		t.Errorf("got Owner tag %q, expected key only", v)
+	}
+
+	if f.MinAge != time.Hour {
+		t.Errorf("got min age %s, expected %s", f.MinAge, time.Hour)
+	}
+
+	os.Setenv(conns.EnvVarSweepMinAge, "an hour")
+
+	if _, err := FilterFromEnv(); err == nil {
+		t.Errorf("expected error for invalid %s", conns.EnvVarSweepMinAge)
+	}
+}
+
+func TestReportJSON(t *testing.T) {
+	report := NewReport(true)
+
+	report.AddDeleted(testSweepResource(t, "id-2", map[string]interface{}{"name": "tf-acc-test-2"}))
+	report.AddDeleted(testSweepResource(t, "id-1", nil))
+	report.AddSkipped(testSweepResource(t, "id-3", nil), "name does not match")
+	report.AddFailed(testSweepResource(t, "id-4", nil), errors.New("DependencyViolation"))
+
+	b, err := report.JSON()
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var got Report
+
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !got.DryRun {
+		t.Errorf("expected dry_run to be true")
+	}
+
+	rr, ok := got.Regions["us-west-2"] //lintignore:AWSAT003
+
+	if !ok {
+		t.Fatalf("expected region in report: %s", b)
+	}
+
+	if got, expected := len(rr.Deleted), 2; got != expected {
+		t.Fatalf("got %d deleted, expected %d", got, expected)
+	}
+
+	if rr.Deleted[0].ID != "id-1" || rr.Deleted[1].Name != "tf-acc-test-2" {
+		t.Errorf("unexpected deleted entries: %v", rr.Deleted)
+	}
+
+	if len(rr.Skipped) != 1 || rr.Skipped[0].Reason != "name does not match" {
+		t.Errorf("unexpected skipped entries: %v", rr.Skipped)
+	}
+
+	if len(rr.Failed) != 1 || rr.Failed[0].Error != "DependencyViolation" {
+		t.Errorf("unexpected failed entries: %v", rr.Failed)
+	}
+}
@@ -0,0 +1,113 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// readOnlyOperation matches AWS API operations which do not modify resources.
+var readOnlyOperation = regexp.MustCompile(`^(Batch)?(Describe|Get|Head|List|Lookup|Query|Scan|Search|Select)`)
+
+// mutatingFunction matches package-level functions which modify resources.
+var mutatingFunction = regexp.MustCompile(`^(Delete|Deregister|Detach|Disable|Disassociate|Purge|Remove|Revoke|Stop|Terminate|Update)`)
+
+// TestSweepersUseOrchestrator ensures that no sweeper deletes resources itself.
+// Resources must be handed to SweepOrchestrator so that dry runs and the
+// sweep filters apply to every sweeper.
+func TestSweepersUseOrchestrator(t *testing.T) {
+	files, err := filepath.Glob("../service/*/sweep.go")
+
+	if err != nil {
+		t.Fatalf("error listing sweeper files: %s", err)
+	}
+
+	if len(files) == 0 {
+		t.Fatal("no sweeper files found")
+	}
+
+	for _, file := range files {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, file, nil, 0)
+
+		if err != nil {
+			t.Fatalf("error parsing %s: %s", file, err)
+		}
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			// Delete handlers of sweep-only resources are only called by the orchestrator.
+			if fd, ok := n.(*ast.FuncDecl); ok {
+				return !isResourceHandler(fd)
+			}
+
+			call, ok := n.(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			if name := directDeleteCall(call); name != "" {
+				t.Errorf("%s: sweeper calls %s instead of using SweepOrchestrator", fset.Position(call.Pos()), name)
+			}
+
+			return true
+		})
+	}
+}
+
+// isResourceHandler returns whether the function takes a *schema.ResourceData as its first parameter.
+func isResourceHandler(fd *ast.FuncDecl) bool {
+	if fd.Type.Params.NumFields() == 0 {
+		return false
+	}
+
+	star, ok := fd.Type.Params.List[0].Type.(*ast.StarExpr)
+
+	if !ok {
+		return false
+	}
+
+	sel, ok := star.X.(*ast.SelectorExpr)
+
+	return ok && sel.Sel.Name == "ResourceData"
+}
+
+// directDeleteCall returns the name of the call if it deletes or modifies a resource directly, otherwise "".
+func directDeleteCall(call *ast.CallExpr) string {
+	switch fun := call.Fun.(type) {
+	case *ast.Ident:
+		if mutatingFunction.MatchString(fun.Name) {
+			return fun.Name
+		}
+	case *ast.SelectorExpr:
+		x, ok := fun.X.(*ast.Ident)
+
+		if !ok {
+			return ""
+		}
+
+		name := x.Name + "." + fun.Sel.Name
+
+		switch {
+		case x.Name == "sweep" && fun.Sel.Name == "DeleteResource":
+			return name
+		case x.Name != "d" && (fun.Sel.Name == "Delete" || fun.Sel.Name == "DeleteContext" || fun.Sel.Name == "DeleteWithoutTimeout"):
+			return name
+		case strings.HasSuffix(strings.ToLower(x.Name), "conn"):
+			operation := strings.TrimSuffix(strings.TrimSuffix(fun.Sel.Name, "WithContext"), "Pages")
+
+			if !readOnlyOperation.MatchString(operation) && !strings.HasPrefix(operation, "Wait") {
+				return name
+			}
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,278 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// sweepers is the registry of all sweepers, keyed by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper.
+// It should be used instead of resource.AddTestSweepers so that the sweeper is
+// run in dependency order by TestMain.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", name)
+	}
+
+	sweepers[name] = s
+
+	resource.AddTestSweepers(name, s)
+}
+
+// TestMain runs the registered sweepers if the -sweep flag is set; otherwise runs the tests.
+// Sweepers are run in waves. Each wave contains the sweepers whose dependencies all ran
+// in earlier waves, and the sweepers in a wave are run concurrently.
+// The sweeper report, if configured, is written once all regions have been swept.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := flagValue("sweep")
+
+	if regions == "" {
+		os.Exit(m.Run())
+	}
+
+	allowFailures := flagValue("sweep-allow-failures") == "true"
+	filtered := filterSweepers(flagValue("sweep-run"), sweepers)
+
+	waves, err := sweeperWaves(filtered)
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	config, err := loadSweepConfig()
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	failed := false
+
+	for _, region := range strings.Split(regions, ",") {
+		region = strings.TrimSpace(region)
+
+		if err := runSweepers(region, waves, config.concurrency, allowFailures); err != nil {
+			log.Printf("[ERROR] Sweepers for region (%s) failed: %s", region, err)
+			failed = true
+
+			if !allowFailures {
+				break
+			}
+		}
+	}
+
+	if config.reportFile != "" {
+		if err := config.report.WriteFile(config.reportFile); err != nil {
+			log.Printf("[ERROR] %s", err)
+			failed = true
+		}
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+
+	os.Exit(0)
+}
+
+func flagValue(name string) string {
+	if f := flag.Lookup(name); f != nil {
+		return f.Value.String()
+	}
+
+	return ""
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated
+// filter values, along with all their dependencies. An empty filter returns all sweepers.
+func filterSweepers(filter string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	if filter == "" {
+		return source
+	}
+
+	result := make(map[string]*resource.Sweeper)
+
+	for name := range source {
+		for _, v := range strings.Split(strings.ToLower(filter), ",") {
+			if v != "" && strings.Contains(strings.ToLower(name), v) {
+				addSweeperWithDependencies(name, source, result)
+			}
+		}
+	}
+
+	return result
+}
+
+func addSweeperWithDependencies(name string, source, result map[string]*resource.Sweeper) {
+	if _, ok := result[name]; ok {
+		return
+	}
+
+	s, ok := source[name]
+
+	if !ok {
+		log.Printf("[WARN] Sweeper has dependency (%s), but that sweeper was not found", name)
+		return
+	}
+
+	result[name] = s
+
+	for _, dependency := range s.Dependencies {
+		addSweeperWithDependencies(dependency, source, result)
+	}
+}
+
+// sweeperWaves orders the sweepers into waves such that every sweeper runs in
+// a later wave than all its dependencies. Sweepers within a wave are sorted by name.
+// Dependencies that are not registered are ignored.
+func sweeperWaves(source map[string]*resource.Sweeper) ([][]string, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[string]int)
+	level := make(map[string]int)
+
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("sweeper dependency cycle: %s", strings.Join(append(path, name), " -> "))
+		}
+
+		state[name] = visiting
+		l := 0
+
+		for _, dependency := range source[name].Dependencies {
+			if _, ok := source[dependency]; !ok {
+				continue
+			}
+
+			if err := visit(dependency, append(path, name)); err != nil {
+				return err
+			}
+
+			if v := level[dependency] + 1; v > l {
+				l = v
+			}
+		}
+
+		state[name] = visited
+		level[name] = l
+
+		return nil
+	}
+
+	names := make([]string, 0, len(source))
+
+	for name := range source {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	var waves [][]string
+
+	for _, name := range names {
+		l := level[name]
+
+		for len(waves) <= l {
+			waves = append(waves, nil)
+		}
+
+		waves[l] = append(waves[l], name)
+	}
+
+	return waves, nil
+}
+
+// runSweepers runs the waves of sweepers in the specified region.
+// Unless failures are allowed, no further waves are run once a sweeper fails.
+func runSweepers(region string, waves [][]string, concurrency int, allowFailures bool) error {
+	start := time.Now()
+	log.Printf("[DEBUG] Running Sweepers for region (%s)", region)
+
+	var errs *multierror.Error
+
+	for i, wave := range waves {
+		log.Printf("[DEBUG] Running Sweeper wave %d of %d in region (%s): %s", i+1, len(waves), region, strings.Join(wave, ", "))
+
+		var mu sync.Mutex
+		var waveErrs *multierror.Error
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, concurrency)
+
+		for _, name := range wave {
+			name := name
+			s := sweepers[name]
+
+			wg.Add(1)
+			sem <- struct{}{}
+
+			go func() {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+
+				log.Printf("[DEBUG] Running Sweeper (%s) in region (%s)", name, region)
+
+				start := time.Now()
+				err := s.F(region)
+
+				log.Printf("[DEBUG] Completed Sweeper (%s) in region (%s) in %s", name, region, time.Since(start))
+
+				if err != nil {
+					log.Printf("[ERROR] Error running Sweeper (%s) in region (%s): %s", name, region, err)
+
+					mu.Lock()
+					waveErrs = multierror.Append(waveErrs, fmt.Errorf("sweeper (%s): %w", name, err))
+					mu.Unlock()
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		if waveErrs != nil {
+			errs = multierror.Append(errs, waveErrs.Errors...)
+
+			if !allowFailures {
+				break
+			}
+		}
+	}
+
+	log.Printf("[DEBUG] Completed Sweepers for region (%s) in %s", region, time.Since(start))
+
+	return errs.ErrorOrNil()
+}
@@ -0,0 +1,120 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestSweeperWaves(t *testing.T) {
+	testCases := []struct {
+		Name          string
+		Sweepers      map[string]*resource.Sweeper
+		ExpectedWaves [][]string
+		ExpectError   bool
+	}{
+		{
+			Name: "no dependencies",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_b": {},
+				"aws_a": {},
+			},
+			ExpectedWaves: [][]string{{"aws_a", "aws_b"}},
+		},
+		{
+			Name: "dependencies",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_vpc":               {Dependencies: []string{"aws_subnet", "aws_internet_gateway"}},
+				"aws_subnet":            {Dependencies: []string{"aws_network_interface"}},
+				"aws_internet_gateway":  {},
+				"aws_network_interface": {},
+			},
+			ExpectedWaves: [][]string{
+				{"aws_internet_gateway", "aws_network_interface"},
+				{"aws_subnet"},
+				{"aws_vpc"},
+			},
+		},
+		{
+			Name: "unregistered dependency",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_a": {Dependencies: []string{"aws_missing"}},
+			},
+			ExpectedWaves: [][]string{{"aws_a"}},
+		},
+		{
+			Name: "cycle",
+			Sweepers: map[string]*resource.Sweeper{
+				"aws_a": {Dependencies: []string{"aws_b"}},
+				"aws_b": {Dependencies: []string{"aws_a"}},
+			},
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := sweeperWaves(testCase.Sweepers)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatal("expected error")
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !reflect.DeepEqual(got, testCase.ExpectedWaves) {
+				t.Errorf("got %v, expected %v", got, testCase.ExpectedWaves)
+			}
+		})
+	}
+}
+
+func TestFilterSweepers(t *testing.T) {
+	source := map[string]*resource.Sweeper{
+		"aws_vpc":               {Dependencies: []string{"aws_subnet"}},
+		"aws_subnet":            {Dependencies: []string{"aws_network_interface"}},
+		"aws_network_interface": {},
+		"aws_s3_bucket":         {},
+	}
+
+	testCases := []struct {
+		Filter   string
+		Expected []string
+	}{
+		{
+			Filter:   "",
+			Expected: []string{"aws_network_interface", "aws_s3_bucket", "aws_subnet", "aws_vpc"},
+		},
+		{
+			Filter:   "aws_vpc",
+			Expected: []string{"aws_network_interface", "aws_subnet", "aws_vpc"},
+		},
+		{
+			Filter:   "S3,aws_network_interface",
+			Expected: []string{"aws_network_interface", "aws_s3_bucket"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Filter, func(t *testing.T) {
+			var got []string
+
+			for name := range filterSweepers(testCase.Filter, source) {
+				got = append(got, name)
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, testCase.Expected) {
+				t.Errorf("got %v, expected %v", got, testCase.Expected)
+			}
+		})
+	}
+}
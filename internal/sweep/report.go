@@ -0,0 +1,122 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+)
+
+// Report records the outcome of sweeping each resource, grouped by region.
+type Report struct {
+	mu sync.Mutex
+
+	DryRun  bool                     `json:"dry_run"`
+	Regions map[string]*RegionReport `json:"regions"`
+}
+
+// RegionReport records the outcome of sweeping each resource in a region.
+// In dry-run mode, Deleted lists the resources that would have been deleted.
+type RegionReport struct {
+	Deleted []ReportEntry `json:"deleted"`
+	Skipped []ReportEntry `json:"skipped"`
+	Failed  []ReportEntry `json:"failed"`
+}
+
+// ReportEntry identifies a swept resource.
+type ReportEntry struct {
+	ID     string `json:"id"`
+	Name   string `json:"name,omitempty"`
+	Reason string `json:"reason,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+func NewReport(dryRun bool) *Report {
+	return &Report{
+		DryRun:  dryRun,
+		Regions: make(map[string]*RegionReport),
+	}
+}
+
+func (r *Report) AddDeleted(sweepResource *SweepResource) {
+	r.add(sweepResource, func(rr *RegionReport, entry ReportEntry) {
+		rr.Deleted = append(rr.Deleted, entry)
+	})
+}
+
+func (r *Report) AddSkipped(sweepResource *SweepResource, reason string) {
+	r.add(sweepResource, func(rr *RegionReport, entry ReportEntry) {
+		entry.Reason = reason
+		rr.Skipped = append(rr.Skipped, entry)
+	})
+}
+
+func (r *Report) AddFailed(sweepResource *SweepResource, err error) {
+	r.add(sweepResource, func(rr *RegionReport, entry ReportEntry) {
+		entry.Error = err.Error()
+		rr.Failed = append(rr.Failed, entry)
+	})
+}
+
+func (r *Report) add(sweepResource *SweepResource, f func(*RegionReport, ReportEntry)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	region := sweepResource.region()
+	rr, ok := r.Regions[region]
+
+	if !ok {
+		rr = &RegionReport{
+			Deleted: []ReportEntry{},
+			Skipped: []ReportEntry{},
+			Failed:  []ReportEntry{},
+		}
+		r.Regions[region] = rr
+	}
+
+	entry := ReportEntry{
+		ID: sweepResource.d.Id(),
+	}
+
+	if name := sweepResource.name(); name != entry.ID {
+		entry.Name = name
+	}
+
+	f(rr, entry)
+}
+
+// JSON returns the report as indented JSON with entries in a stable order.
+func (r *Report) JSON() ([]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, rr := range r.Regions {
+		for _, entries := range [][]ReportEntry{rr.Deleted, rr.Skipped, rr.Failed} {
+			entries := entries
+			sort.SliceStable(entries, func(i, j int) bool {
+				return entries[i].ID < entries[j].ID
+			})
+		}
+	}
+
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// WriteFile writes the report to the specified file, replacing any existing contents.
+func (r *Report) WriteFile(filename string) error {
+	b, err := r.JSON()
+
+	if err != nil {
+		return fmt.Errorf("error encoding sweeper report: %w", err)
+	}
+
+	if err := os.WriteFile(filename, b, 0644); err != nil {
+		return fmt.Errorf("error writing sweeper report (%s): %w", filename, err)
+	}
+
+	return nil
+}
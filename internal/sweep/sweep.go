@@ -9,7 +9,7 @@ import (
 	"log"
 	"os"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -30,13 +30,21 @@ const (
 
 const defaultSweeperAssumeRoleDurationSeconds = 3600
 
+const defaultSweepConcurrency = 10
+
 // SweeperClients is a shared cache of regional conns.AWSClient
 // This prevents client re-initialization for every resource with no benefit.
 var SweeperClients map[string]interface{}
 
+// sweeperClientsMu guards SweeperClients as sweepers are run concurrently.
+var sweeperClientsMu sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper
 // functions for a given region
 func SharedRegionalSweepClient(region string) (interface{}, error) {
+	sweeperClientsMu.Lock()
+	defer sweeperClientsMu.Unlock()
+
 	if client, ok := SweeperClients[region]; ok {
 		return client, nil
 	}
@@ -109,24 +117,54 @@ func SweepOrchestrator(sweepResources []*SweepResource) error {
 }
 
 func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResource, delay time.Duration, delayRand time.Duration, minTimeout time.Duration, pollInterval time.Duration, timeout time.Duration) error {
+	config, err := loadSweepConfig()
+
+	if err != nil {
+		return err
+	}
+
 	var g multierror.Group
 
 	for _, sweepResource := range sweepResources {
 		sweepResource := sweepResource
 
+		if ok, reason := config.filter.Match(sweepResource, time.Now()); !ok {
+			log.Printf("[DEBUG] Skipping resource (%s): %s", sweepResource.d.Id(), reason)
+			config.report.AddSkipped(sweepResource, reason)
+			continue
+		}
+
+		if config.dryRun {
+			log.Printf("[INFO] Dry run, would delete resource (%s)", sweepResource.d.Id())
+			config.report.AddDeleted(sweepResource)
+			continue
+		}
+
 		g.Go(func() error {
+			config.deletions <- struct{}{}
+			defer func() { <-config.deletions }()
+
+			throttle := regionThrottle(sweepResource.region())
+
 			err := tfresource.RetryConfigContext(ctx, delay, delayRand, minTimeout, pollInterval, timeout, func() *resource.RetryError {
+				if err := throttle.wait(ctx); err != nil {
+					return resource.NonRetryableError(err)
+				}
+
 				err := DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 
-				if err != nil {
-					if strings.Contains(err.Error(), "Throttling") {
-						log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
-						return resource.RetryableError(err)
-					}
+				if isThrottlingError(err) {
+					throttle.throttled()
+					log.Printf("[INFO] While sweeping resource (%s), encountered throttling error (%s). Retrying...", sweepResource.d.Id(), err)
+					return resource.RetryableError(err)
+				}
 
+				if err != nil {
 					return resource.NonRetryableError(err)
 				}
 
+				throttle.succeeded()
+
 				return nil
 			})
 
@@ -134,13 +172,89 @@ func SweepOrchestratorContext(ctx context.Context, sweepResources []*SweepResour
 				err = DeleteResource(sweepResource.resource, sweepResource.d, sweepResource.meta)
 			}
 
+			if err != nil {
+				config.report.AddFailed(sweepResource, err)
+			} else {
+				config.report.AddDeleted(sweepResource)
+			}
+
 			return err
 		})
 	}
 
+	// The report is written once all sweepers have run, by TestMain.
 	return g.Wait().ErrorOrNil()
 }
 
+type sweepConfig struct {
+	concurrency int
+	// deletions limits the number of resources deleted at the same time by all sweepers.
+	deletions  chan struct{}
+	dryRun     bool
+	filter     *Filter
+	report     *Report
+	reportFile string
+}
+
+var (
+	sweepConfigOnce   sync.Once
+	sweepConfigCached *sweepConfig
+	sweepConfigErr    error
+)
+
+// loadSweepConfig returns the sweeper configuration read from the environment.
+// The configuration, including the report, is shared by all sweepers.
+func loadSweepConfig() (*sweepConfig, error) {
+	sweepConfigOnce.Do(func() {
+		config := &sweepConfig{
+			concurrency: defaultSweepConcurrency,
+			reportFile:  os.Getenv(conns.EnvVarSweepReport),
+		}
+
+		if v := os.Getenv(conns.EnvVarSweepConcurrency); v != "" {
+			concurrency, err := strconv.Atoi(v)
+
+			if err == nil && concurrency < 1 {
+				err = fmt.Errorf("must be at least 1, got %d", concurrency)
+			}
+
+			if err != nil {
+				sweepConfigErr = fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepConcurrency, err)
+				return
+			}
+
+			config.concurrency = concurrency
+		}
+
+		config.deletions = make(chan struct{}, config.concurrency)
+
+		if v := os.Getenv(conns.EnvVarSweepDryRun); v != "" {
+			dryRun, err := strconv.ParseBool(v)
+
+			if err != nil {
+				sweepConfigErr = fmt.Errorf("environment variable %s: %w", conns.EnvVarSweepDryRun, err)
+				return
+			}
+
+			config.dryRun = dryRun
+		}
+
+		filter, err := FilterFromEnv()
+
+		if err != nil {
+			sweepConfigErr = err
+			return
+		}
+
+		config.filter = filter
+		config.report = NewReport(config.dryRun)
+
+		sweepConfigCached = config
+	})
+
+	return sweepConfigCached, sweepConfigErr
+}
+
 // Check sweeper API call error for reasons to skip sweeping
 // These include missing API endpoints and unsupported API calls
 func SkipSweepError(err error) bool {
@@ -0,0 +1,149 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+const (
+	throttleMinDelay = 1 * time.Second
+	throttleMaxDelay = 30 * time.Second
+)
+
+// throttlingErrorCodes are the AWS error codes returned when API requests are throttled.
+var throttlingErrorCodes = []string{
+	"EC2ThrottledException",
+	"PriorRequestNotComplete",
+	"ProvisionedThroughputExceededException",
+	"RequestLimitExceeded",
+	"RequestThrottled",
+	"RequestThrottledException",
+	"SlowDown",
+	"ThrottledException",
+	"Throttling",
+	"ThrottlingException",
+	"TooManyRequestsException",
+	"TransactionInProgressException",
+}
+
+// isThrottlingError returns whether the error is an AWS API throttling error.
+func isThrottlingError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var requestFailure awserr.RequestFailure
+
+	if errors.As(err, &requestFailure) && requestFailure.StatusCode() == http.StatusTooManyRequests {
+		return true
+	}
+
+	var awsErr awserr.Error
+
+	if errors.As(err, &awsErr) {
+		for _, code := range throttlingErrorCodes {
+			if awsErr.Code() == code {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// Errors returned via diagnostics have been flattened to strings.
+	message := err.Error()
+
+	for _, code := range throttlingErrorCodes {
+		if strings.Contains(message, code+":") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// throttle delays all deletions in a region after any of them is throttled.
+// The delay doubles on each throttling error and halves on each success.
+type throttle struct {
+	mu    sync.Mutex
+	delay time.Duration
+	until time.Time
+}
+
+var (
+	throttlesMu sync.Mutex
+	throttles   = make(map[string]*throttle)
+)
+
+// regionThrottle returns the throttle shared by all deletions in the specified region.
+func regionThrottle(region string) *throttle {
+	throttlesMu.Lock()
+	defer throttlesMu.Unlock()
+
+	t, ok := throttles[region]
+
+	if !ok {
+		t = &throttle{}
+		throttles[region] = t
+	}
+
+	return t
+}
+
+// wait blocks until any backoff has elapsed or the context is done.
+func (t *throttle) wait(ctx context.Context) error {
+	t.mu.Lock()
+	d := time.Until(t.until)
+	t.mu.Unlock()
+
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (t *throttle) throttled() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.delay == 0 {
+		t.delay = throttleMinDelay
+	} else if t.delay *= 2; t.delay > throttleMaxDelay {
+		t.delay = throttleMaxDelay
+	}
+
+	// Add jitter so that waiting deletions don't all resume at once.
+	until := time.Now().Add(t.delay + time.Duration(rand.Int63n(int64(t.delay/2)+1))) //nolint:gosec
+
+	if until.After(t.until) {
+		t.until = until
+	}
+}
+
+func (t *throttle) succeeded() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.delay /= 2; t.delay < throttleMinDelay {
+		t.delay = 0
+	}
+}
@@ -0,0 +1,65 @@
+//go:build sweep
+// +build sweep
+
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func TestIsThrottlingError(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		Err      error
+		Expected bool
+	}{
+		{
+			Name: "nil",
+		},
+		{
+			Name:     "throttling code",
+			Err:      awserr.New("ThrottlingException", "Rate exceeded", nil),
+			Expected: true,
+		},
+		{
+			Name:     "EC2 throttling code",
+			Err:      awserr.New("RequestLimitExceeded", "Request limit exceeded.", nil),
+			Expected: true,
+		},
+		{
+			Name:     "wrapped throttling code",
+			Err:      fmt.Errorf("error deleting VPC: %w", awserr.New("Throttling", "Rate exceeded", nil)),
+			Expected: true,
+		},
+		{
+			Name:     "too many requests status",
+			Err:      awserr.NewRequestFailure(awserr.New("Unknown", "", nil), 429, ""),
+			Expected: true,
+		},
+		{
+			Name: "other code",
+			Err:  awserr.New("DependencyViolation", "Throttling is not the reason", nil),
+		},
+		{
+			Name:     "diagnostic summary",
+			Err:      errors.New("error deleting resource: error deleting Example Thing (abc): ThrottlingException: Rate exceeded"),
+			Expected: true,
+		},
+		{
+			Name: "other message",
+			Err:  errors.New("error deleting resource: not found"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			if got := isThrottlingError(testCase.Err); got != testCase.Expected {
+				t.Errorf("got %t, expected %t", got, testCase.Expected)
+			}
+		})
+	}
+}
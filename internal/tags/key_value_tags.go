@@ -22,6 +22,8 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// ExcludeResourceTypes lists the resource types (e.g. aws_s3_bucket_object) to which the tags are not applied.
+	ExcludeResourceTypes []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -58,6 +60,22 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ExcludesResourceType returns true if the given configuration
+// is not applied to the specified resource type.
+func (dc *DefaultConfig) ExcludesResourceType(resourceType string) bool {
+	if dc == nil {
+		return false
+	}
+
+	for _, v := range dc.ExcludeResourceTypes {
+		if v == resourceType {
+			return true
+		}
+	}
+
+	return false
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
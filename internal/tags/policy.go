@@ -0,0 +1,87 @@
+package tags
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	multierror "github.com/hashicorp/go-multierror"
+)
+
+// PolicyConfig contains rules that resource tags are validated against.
+type PolicyConfig struct {
+	// RequiredKeys lists the tag keys that every resource must have.
+	RequiredKeys []string
+	// AllowedValues maps tag keys to the values permitted for them.
+	AllowedValues map[string][]string
+	// ExcludeResourceTypes lists the resource types (e.g. aws_s3_bucket_object) that are not validated.
+	ExcludeResourceTypes []string
+}
+
+// ExcludesResourceType returns true if the given configuration
+// is not applied to the specified resource type.
+func (pc *PolicyConfig) ExcludesResourceType(resourceType string) bool {
+	if pc == nil {
+		return false
+	}
+
+	for _, v := range pc.ExcludeResourceTypes {
+		if v == resourceType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate returns an error describing every way in which the given tags
+// violate the configuration; otherwise returns nil.
+func (pc *PolicyConfig) Validate(tags KeyValueTags) error {
+	if pc == nil {
+		return nil
+	}
+
+	var errs *multierror.Error
+
+	for _, k := range pc.RequiredKeys {
+		if _, ok := tags[k]; !ok {
+			errs = multierror.Append(errs, fmt.Errorf("required tag %q is missing", k))
+		}
+	}
+
+	keys := make([]string, 0, len(pc.AllowedValues))
+	for k := range pc.AllowedValues {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		tag, ok := tags[k]
+
+		if !ok {
+			continue
+		}
+
+		allowedValues := pc.AllowedValues[k]
+		value := ""
+		if tag != nil && tag.Value != nil {
+			value = *tag.Value
+		}
+
+		if !stringInSlice(value, allowedValues) {
+			errs = multierror.Append(errs, fmt.Errorf("tag %q has value %q, expected one of: %s", k, value, strings.Join(allowedValues, ", ")))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func stringInSlice(s string, l []string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+
+	return false
+}
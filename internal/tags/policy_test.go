@@ -0,0 +1,145 @@
+package tags
+
+import (
+	"testing"
+)
+
+func TestPolicyConfigExcludesResourceType(t *testing.T) {
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		resourceType string
+		want         bool
+	}{
+		{
+			name:         "nil config",
+			policyConfig: nil,
+			resourceType: "aws_instance",
+			want:         false,
+		},
+		{
+			name:         "empty config",
+			policyConfig: &PolicyConfig{},
+			resourceType: "aws_instance",
+			want:         false,
+		},
+		{
+			name: "not excluded",
+			policyConfig: &PolicyConfig{
+				ExcludeResourceTypes: []string{"aws_s3_bucket_object"},
+			},
+			resourceType: "aws_instance",
+			want:         false,
+		},
+		{
+			name: "excluded",
+			policyConfig: &PolicyConfig{
+				ExcludeResourceTypes: []string{"aws_instance", "aws_s3_bucket_object"},
+			},
+			resourceType: "aws_instance",
+			want:         true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			got := testCase.policyConfig.ExcludesResourceType(testCase.resourceType)
+
+			if got != testCase.want {
+				t.Errorf("got %t, expected %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPolicyConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		tags         KeyValueTags
+		wantErr      bool
+	}{
+		{
+			name:         "nil config",
+			policyConfig: nil,
+			tags:         New(map[string]string{}),
+			wantErr:      false,
+		},
+		{
+			name:         "empty config",
+			policyConfig: &PolicyConfig{},
+			tags:         New(map[string]string{}),
+			wantErr:      false,
+		},
+		{
+			name: "required keys present",
+			policyConfig: &PolicyConfig{
+				RequiredKeys: []string{"owner", "environment"},
+			},
+			tags: New(map[string]string{
+				"owner":       "team1",
+				"environment": "production",
+				"other":       "value",
+			}),
+			wantErr: false,
+		},
+		{
+			name: "required key missing",
+			policyConfig: &PolicyConfig{
+				RequiredKeys: []string{"owner", "environment"},
+			},
+			tags: New(map[string]string{
+				"owner": "team1",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "allowed value",
+			policyConfig: &PolicyConfig{
+				AllowedValues: map[string][]string{
+					"environment": {"production", "staging"},
+				},
+			},
+			tags: New(map[string]string{
+				"environment": "staging",
+			}),
+			wantErr: false,
+		},
+		{
+			name: "disallowed value",
+			policyConfig: &PolicyConfig{
+				AllowedValues: map[string][]string{
+					"environment": {"production", "staging"},
+				},
+			},
+			tags: New(map[string]string{
+				"environment": "development",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "allowed values key absent",
+			policyConfig: &PolicyConfig{
+				AllowedValues: map[string][]string{
+					"environment": {"production", "staging"},
+				},
+			},
+			tags:    New(map[string]string{}),
+			wantErr: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.policyConfig.Validate(testCase.tags)
+
+			if err != nil && !testCase.wantErr {
+				t.Errorf("got unexpected error: %s", err)
+			}
+
+			if err == nil && testCase.wantErr {
+				t.Errorf("expected error, got none")
+			}
+		})
+	}
+}
@@ -18,9 +18,11 @@ import (
 // to those configured at the provider-level to avoid non-empty plans
 // after resource READ operations as resource and provider-level tags
 // will be indistinguishable when returned from an AWS API.
+// The merged tags are also checked against any provider-level tag policy.
 func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
 	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+	tagPolicyConfig := meta.(*conns.AWSClient).TagPolicyConfig
 
 	resourceTags := tftags.New(diff.Get("tags").(map[string]interface{}))
 
@@ -28,6 +30,13 @@ func SetTagsDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{})
 		return fmt.Errorf(`"tags" are identical to those in the "default_tags" configuration block of the provider: please de-duplicate and try again`)
 	}
 
+	// Tag values may not be known until apply, in which case the tag policy cannot be checked.
+	if diff.NewValueKnown("tags") {
+		if err := tagPolicyConfig.Validate(defaultTagsConfig.MergeTags(resourceTags)); err != nil {
+			return fmt.Errorf(`"tags" do not comply with the "tag_policy" configuration block of the provider: %w`, err)
+		}
+	}
+
 	allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
 
 	// To ensure "tags_all" is correctly computed, we explicitly set the attribute diff
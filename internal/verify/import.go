@@ -0,0 +1,111 @@
+package verify
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// ARNImportIDFunc converts a resource ARN into the resource's import ID.
+type ARNImportIDFunc func(v arn.ARN, meta interface{}) (string, error)
+
+// ImportByARN returns an importer function that accepts either the resource's
+// import ID or its ARN. An ARN is validated, checked against the provider's
+// partition, region and account and converted to the import ID before next is called.
+func ImportByARN(f ARNImportIDFunc, next schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		if v := d.Id(); arn.IsARN(v) {
+			id, err := ImportIDFromARN(v, f, meta)
+
+			if err != nil {
+				return nil, err
+			}
+
+			d.SetId(id)
+		}
+
+		return next(ctx, d, meta)
+	}
+}
+
+// ImportIDFromARN validates the ARN and converts it to a resource import ID.
+func ImportIDFromARN(v string, f ARNImportIDFunc, meta interface{}) (string, error) {
+	if _, errs := ValidARN(v, "import ID"); len(errs) > 0 {
+		return "", multierror.Append(nil, errs...)
+	}
+
+	parsedARN, err := arn.Parse(v)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing ARN (%s): %w", v, err)
+	}
+
+	if client, ok := meta.(*conns.AWSClient); ok {
+		if parsedARN.Partition != client.Partition {
+			return "", fmt.Errorf("ARN (%s) partition (%s) does not match provider partition (%s)", v, parsedARN.Partition, client.Partition)
+		}
+
+		if parsedARN.Region != "" && parsedARN.Region != client.Region {
+			return "", fmt.Errorf("ARN (%s) region (%s) does not match provider region (%s)", v, parsedARN.Region, client.Region)
+		}
+
+		if parsedARN.AccountID != "" && client.AccountID != "" && parsedARN.AccountID != client.AccountID {
+			return "", fmt.Errorf("ARN (%s) account ID (%s) does not match provider account ID (%s)", v, parsedARN.AccountID, client.AccountID)
+		}
+	}
+
+	id, err := f(parsedARN, meta)
+
+	if err != nil {
+		return "", fmt.Errorf("error converting ARN (%s) to import ID: %w", v, err)
+	}
+
+	return id, nil
+}
+
+// ARNImportIDResource returns an ARNImportIDFunc for ARNs of the specified service
+// whose resource is "<prefix><import ID>", e.g. "vpc/vpc-12345678".
+func ARNImportIDResource(service, prefix string) ARNImportIDFunc {
+	return func(v arn.ARN, _ interface{}) (string, error) {
+		if v.Service != service {
+			return "", fmt.Errorf("unexpected service (%s), expected %s", v.Service, service)
+		}
+
+		id := strings.TrimPrefix(v.Resource, prefix)
+
+		if id == v.Resource && prefix != "" || id == "" {
+			return "", fmt.Errorf("unexpected resource (%s), expected %s<ID>", v.Resource, prefix)
+		}
+
+		return id, nil
+	}
+}
+
+// ARNImportIDResourceName returns an ARNImportIDFunc for ARNs of the specified service
+// whose resource is "<prefix>[<path>/]<import ID>", e.g. IAM ARNs such as "role/path/name".
+func ARNImportIDResourceName(service, prefix string) ARNImportIDFunc {
+	resource := ARNImportIDResource(service, prefix)
+
+	return func(v arn.ARN, meta interface{}) (string, error) {
+		id, err := resource(v, meta)
+
+		if err != nil {
+			return "", err
+		}
+
+		if i := strings.LastIndex(id, "/"); i >= 0 {
+			id = id[i+1:]
+		}
+
+		if id == "" {
+			return "", fmt.Errorf("unexpected resource (%s), expected %s[<path>/]<name>", v.Resource, prefix)
+		}
+
+		return id, nil
+	}
+}
@@ -0,0 +1,133 @@
+package verify
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func TestImportIDFromARN(t *testing.T) {
+	client := &conns.AWSClient{
+		AccountID: "123456789012",
+		Partition: "aws",
+		Region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	testCases := []struct {
+		Name        string
+		ARN         string
+		F           ARNImportIDFunc
+		Expected    string
+		ExpectError bool
+	}{
+		{
+			Name:     "resource",
+			ARN:      "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			F:        ARNImportIDResource("ec2", "vpc/"),
+			Expected: "vpc-12345678",
+		},
+		{
+			Name:     "resource with slashes",
+			ARN:      "arn:aws:ecr:us-west-2:123456789012:repository/team/app", //lintignore:AWSAT003,AWSAT005
+			F:        ARNImportIDResource("ecr", "repository/"),
+			Expected: "team/app",
+		},
+		{
+			Name:     "resource without prefix",
+			ARN:      "arn:aws:s3:::example-bucket", //lintignore:AWSAT005
+			F:        ARNImportIDResource("s3", ""),
+			Expected: "example-bucket",
+		},
+		{
+			Name:     "resource name with path",
+			ARN:      "arn:aws:iam::123456789012:role/path/to/example", //lintignore:AWSAT005
+			F:        ARNImportIDResourceName("iam", "role/"),
+			Expected: "example",
+		},
+		{
+			Name:     "resource name without path",
+			ARN:      "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+			F:        ARNImportIDResourceName("iam", "role/"),
+			Expected: "example",
+		},
+		{
+			Name:        "invalid ARN",
+			ARN:         "arn:aws:ec2",
+			F:           ARNImportIDResource("ec2", "vpc/"),
+			ExpectError: true,
+		},
+		{
+			Name:        "wrong service",
+			ARN:         "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			F:           ARNImportIDResource("iam", "vpc/"),
+			ExpectError: true,
+		},
+		{
+			Name:        "wrong resource type",
+			ARN:         "arn:aws:ec2:us-west-2:123456789012:subnet/subnet-12345678", //lintignore:AWSAT003,AWSAT005
+			F:           ARNImportIDResource("ec2", "vpc/"),
+			ExpectError: true,
+		},
+		{
+			Name:        "wrong partition",
+			ARN:         "arn:aws-us-gov:ec2:us-west-2:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			F:           ARNImportIDResource("ec2", "vpc/"),
+			ExpectError: true,
+		},
+		{
+			Name:        "wrong region",
+			ARN:         "arn:aws:ec2:us-east-1:123456789012:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			F:           ARNImportIDResource("ec2", "vpc/"),
+			ExpectError: true,
+		},
+		{
+			Name:        "wrong account",
+			ARN:         "arn:aws:ec2:us-west-2:210987654321:vpc/vpc-12345678", //lintignore:AWSAT003,AWSAT005
+			F:           ARNImportIDResource("ec2", "vpc/"),
+			ExpectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			got, err := ImportIDFromARN(testCase.ARN, testCase.F, client)
+
+			if err == nil && testCase.ExpectError {
+				t.Fatalf("expected error, got %q", got)
+			}
+
+			if err != nil && !testCase.ExpectError {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.Expected {
+				t.Errorf("got %q, expected %q", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestImportByARN(t *testing.T) {
+	r := &schema.Resource{
+		Importer: &schema.ResourceImporter{
+			StateContext: ImportByARN(ARNImportIDResource("ec2", "vpc/"), schema.ImportStatePassthroughContext),
+		},
+	}
+
+	for _, id := range []string{"vpc-12345678", "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678"} { //lintignore:AWSAT003,AWSAT005
+		d := r.Data(nil)
+		d.SetId(id)
+
+		result, err := r.Importer.StateContext(context.Background(), d, nil)
+
+		if err != nil {
+			t.Fatalf("unexpected error importing %q: %s", id, err)
+		}
+
+		if got, expected := result[0].Id(), "vpc-12345678"; got != expected {
+			t.Errorf("importing %q: got ID %q, expected %q", id, got, expected)
+		}
+	}
+}
@@ -0,0 +1,298 @@
+package AWSR003
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/bflad/tfproviderlint/helper/terraformtype/helper/schema"
+	"github.com/bflad/tfproviderlint/passes/commentignore"
+	"github.com/bflad/tfproviderlint/passes/helper/schema/resourceinfo"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const Doc = `check for resource CRUD functions and AWS SDK calls without context
+
+The AWSR003 analyzer reports when a schema.Resource declares a Create, Read,
+Update or Delete function instead of the context-aware CreateContext,
+ReadContext, UpdateContext or DeleteContext (or WithoutTimeout) variant.
+
+It also reports when a function that receives a context.Context calls an AWS
+Go SDK client method that has a WithContext variant, e.g. conn.DescribeVpcs()
+instead of conn.DescribeVpcsWithContext(ctx), as the context is not passed on
+and the operation cannot be cancelled. Requests created with an AWS Go SDK
+client XxxRequest method, e.g. conn.DescribeVpcsRequest(), are reported when
+SetContext is not called on the returned request.
+`
+
+const analyzerName = "AWSR003"
+
+const (
+	awsSdkServicePackagePrefix = `github.com/aws/aws-sdk-go/service/`
+	requestSuffix              = `Request`
+	setContextMethodName       = `SetContext`
+	withContextSuffix          = `WithContext`
+)
+
+var crudFields = map[string]string{
+	schema.ResourceFieldCreate: schema.ResourceFieldCreateContext,
+	schema.ResourceFieldDelete: schema.ResourceFieldDeleteContext,
+	schema.ResourceFieldRead:   schema.ResourceFieldReadContext,
+	schema.ResourceFieldUpdate: schema.ResourceFieldUpdateContext,
+}
+
+var Analyzer = &analysis.Analyzer{
+	Name: analyzerName,
+	Doc:  Doc,
+	Requires: []*analysis.Analyzer{
+		commentignore.Analyzer,
+		inspect.Analyzer,
+		resourceinfo.Analyzer,
+	},
+	Run: run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	commentIgnorer := pass.ResultOf[commentignore.Analyzer].(*commentignore.Ignorer)
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	resourceInfos := pass.ResultOf[resourceinfo.Analyzer].([]*schema.ResourceInfo)
+
+	for _, resourceInfo := range resourceInfos {
+		for _, fieldName := range []string{schema.ResourceFieldCreate, schema.ResourceFieldRead, schema.ResourceFieldUpdate, schema.ResourceFieldDelete} {
+			kvExpr := resourceInfo.Fields[fieldName]
+
+			if kvExpr == nil {
+				continue
+			}
+
+			if commentIgnorer.ShouldIgnore(analyzerName, kvExpr) {
+				continue
+			}
+
+			pass.Reportf(kvExpr.Pos(), "%s: prefer %s over %s", analyzerName, crudFields[fieldName], fieldName)
+		}
+	}
+
+	nodeFilter := []ast.Node{
+		(*ast.FuncDecl)(nil),
+		(*ast.FuncLit)(nil),
+	}
+	reported := make(map[token.Pos]bool)
+
+	inspect.Preorder(nodeFilter, func(n ast.Node) {
+		var funcType *ast.FuncType
+		var body *ast.BlockStmt
+
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			funcType, body = n.Type, n.Body
+		case *ast.FuncLit:
+			funcType, body = n.Type, n.Body
+		}
+
+		if body == nil || !hasContextParam(pass, funcType) {
+			return
+		}
+
+		assignedRequests, contextRequests := requestVariables(pass, body)
+
+		// Calls in nested function literals, such as retry functions, are
+		// also checked as the context is available to them.
+		ast.Inspect(body, func(n ast.Node) bool {
+			callExpr, ok := n.(*ast.CallExpr)
+
+			if !ok || reported[callExpr.Pos()] {
+				return true
+			}
+
+			if receiverName, methodName, ok := sdkMethodWithContextVariant(pass, callExpr); ok {
+				if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+					return true
+				}
+
+				reported[callExpr.Pos()] = true
+				pass.Reportf(callExpr.Pos(), "%s: prefer (*%s).%s%s() to pass the context", analyzerName, receiverName, methodName, withContextSuffix)
+
+				return true
+			}
+
+			if receiverName, methodName, ok := sdkRequestMethod(pass, callExpr); ok {
+				if obj := assignedRequests[callExpr]; obj != nil && contextRequests[obj] {
+					return true
+				}
+
+				if commentIgnorer.ShouldIgnore(analyzerName, callExpr) {
+					return true
+				}
+
+				reported[callExpr.Pos()] = true
+				pass.Reportf(callExpr.Pos(), "%s: call %s(ctx) on the request returned by (*%s).%s() to pass the context", analyzerName, setContextMethodName, receiverName, methodName)
+			}
+
+			return true
+		})
+	})
+
+	return nil, nil
+}
+
+// hasContextParam returns true if any of the function parameters is a context.Context.
+func hasContextParam(pass *analysis.Pass, funcType *ast.FuncType) bool {
+	if funcType.Params == nil {
+		return false
+	}
+
+	for _, field := range funcType.Params.List {
+		if isContextType(pass.TypesInfo.TypeOf(field.Type)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isContextType(t types.Type) bool {
+	named, ok := t.(*types.Named)
+
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj != nil && obj.Pkg() != nil && obj.Pkg().Path() == "context" && obj.Name() == "Context"
+}
+
+// requestVariables returns the variables that AWS Go SDK requests are assigned to,
+// keyed by the XxxRequest call, and the variables on which SetContext is called.
+func requestVariables(pass *analysis.Pass, body *ast.BlockStmt) (map[*ast.CallExpr]types.Object, map[types.Object]bool) {
+	assigned := make(map[*ast.CallExpr]types.Object)
+	setContext := make(map[types.Object]bool)
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Rhs) != 1 || len(n.Lhs) == 0 {
+				return true
+			}
+
+			callExpr, ok := n.Rhs[0].(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			if ident, ok := n.Lhs[0].(*ast.Ident); ok {
+				if obj := pass.TypesInfo.ObjectOf(ident); obj != nil {
+					assigned[callExpr] = obj
+				}
+			}
+		case *ast.ValueSpec:
+			if len(n.Values) != 1 || len(n.Names) == 0 {
+				return true
+			}
+
+			callExpr, ok := n.Values[0].(*ast.CallExpr)
+
+			if !ok {
+				return true
+			}
+
+			if obj := pass.TypesInfo.ObjectOf(n.Names[0]); obj != nil {
+				assigned[callExpr] = obj
+			}
+		case *ast.CallExpr:
+			selectorExpr, ok := n.Fun.(*ast.SelectorExpr)
+
+			if !ok || selectorExpr.Sel.Name != setContextMethodName {
+				return true
+			}
+
+			if ident, ok := selectorExpr.X.(*ast.Ident); ok {
+				if obj := pass.TypesInfo.ObjectOf(ident); obj != nil {
+					setContext[obj] = true
+				}
+			}
+		}
+
+		return true
+	})
+
+	return assigned, setContext
+}
+
+// sdkMethodWithContextVariant returns the receiver type name and method name if
+// the call is to an AWS Go SDK service client method that has a WithContext variant.
+func sdkMethodWithContextVariant(pass *analysis.Pass, callExpr *ast.CallExpr) (string, string, bool) {
+	named, selection, methodName, ok := sdkServiceClientMethod(pass, callExpr)
+
+	if !ok || strings.HasSuffix(methodName, withContextSuffix) {
+		return "", "", false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(selection.Recv(), true, named.Obj().Pkg(), methodName+withContextSuffix)
+
+	if _, ok := obj.(*types.Func); !ok {
+		return "", "", false
+	}
+
+	return named.Obj().Pkg().Name() + "." + named.Obj().Name(), methodName, true
+}
+
+// sdkRequestMethod returns the receiver type name and method name if the call is
+// to an AWS Go SDK service client XxxRequest method, whose returned request has a
+// SetContext method.
+func sdkRequestMethod(pass *analysis.Pass, callExpr *ast.CallExpr) (string, string, bool) {
+	named, selection, methodName, ok := sdkServiceClientMethod(pass, callExpr)
+
+	if !ok || !strings.HasSuffix(methodName, requestSuffix) {
+		return "", "", false
+	}
+
+	signature, ok := selection.Type().(*types.Signature)
+
+	if !ok || signature.Results().Len() == 0 {
+		return "", "", false
+	}
+
+	obj, _, _ := types.LookupFieldOrMethod(signature.Results().At(0).Type(), true, nil, setContextMethodName)
+
+	if _, ok := obj.(*types.Func); !ok {
+		return "", "", false
+	}
+
+	return named.Obj().Pkg().Name() + "." + named.Obj().Name(), methodName, true
+}
+
+// sdkServiceClientMethod returns the receiver type, selection and method name if
+// the call is to an AWS Go SDK service client method.
+func sdkServiceClientMethod(pass *analysis.Pass, callExpr *ast.CallExpr) (*types.Named, *types.Selection, string, bool) {
+	selectorExpr, ok := callExpr.Fun.(*ast.SelectorExpr)
+
+	if !ok {
+		return nil, nil, "", false
+	}
+
+	selection, ok := pass.TypesInfo.Selections[selectorExpr]
+
+	if !ok || selection.Kind() != types.MethodVal {
+		return nil, nil, "", false
+	}
+
+	recv := selection.Recv()
+
+	if pointer, ok := recv.(*types.Pointer); ok {
+		recv = pointer.Elem()
+	}
+
+	named, ok := recv.(*types.Named)
+
+	if !ok || named.Obj().Pkg() == nil || !strings.HasPrefix(named.Obj().Pkg().Path(), awsSdkServicePackagePrefix) {
+		return nil, nil, "", false
+	}
+
+	return named, selection, selectorExpr.Sel.Name, true
+}
@@ -0,0 +1,145 @@
+package a
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/service/example"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func f() {
+	/* Passing cases */
+
+	_ = schema.Resource{
+		CreateContext: resourceCreateContext,
+		ReadContext:   resourceReadContext,
+		UpdateContext: resourceUpdateContext,
+		DeleteContext: resourceDeleteContext,
+	}
+
+	_ = schema.Resource{
+		CreateWithoutTimeout: resourceCreateContext,
+		ReadWithoutTimeout:   resourceReadContext,
+		UpdateWithoutTimeout: resourceUpdateContext,
+		DeleteWithoutTimeout: resourceDeleteContext,
+	}
+
+	/* Comment ignored cases */
+
+	_ = schema.Resource{
+		//lintignore:AWSR003
+		Create: resourceCreate,
+		//lintignore:AWSR003
+		Read: resourceRead,
+	}
+
+	/* Failing cases */
+
+	_ = schema.Resource{
+		Create: resourceCreate, // want "prefer CreateContext over Create"
+		Read:   resourceRead,   // want "prefer ReadContext over Read"
+		Update: resourceUpdate, // want "prefer UpdateContext over Update"
+		Delete: resourceDelete, // want "prefer DeleteContext over Delete"
+	}
+}
+
+func resourceCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*example.Example)
+
+	// Functions without a context are not checked.
+	_, err := conn.DescribeThings(&example.DescribeThingsInput{})
+
+	return err
+}
+
+func resourceRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func resourceCreateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*example.Example)
+
+	/* Passing cases */
+
+	if _, err := conn.DescribeThingsWithContext(ctx, &example.DescribeThingsInput{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := conn.DescribeThingsPagesWithContext(ctx, &example.DescribeThingsInput{}, func(page *example.DescribeThingsOutput, lastPage bool) bool {
+		return !lastPage
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	req, _ := conn.DescribeThingsRequest(&example.DescribeThingsInput{})
+	req.SetContext(ctx)
+
+	if err := req.Send(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	/* Comment ignored cases */
+
+	//lintignore:AWSR003
+	conn.DescribeThings(&example.DescribeThingsInput{})
+
+	conn.DescribeThings(&example.DescribeThingsInput{}) //lintignore:AWSR003
+
+	//lintignore:AWSR003
+	conn.DescribeThingsRequest(&example.DescribeThingsInput{})
+
+	return nil
+}
+
+func resourceReadContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*example.Example)
+
+	/* Failing cases */
+
+	if _, err := conn.DescribeThings(&example.DescribeThingsInput{}); err != nil { // want "prefer \\(\\*example.Example\\).DescribeThingsWithContext\\(\\) to pass the context"
+		return diag.FromErr(err)
+	}
+
+	if err := conn.DescribeThingsPages(&example.DescribeThingsInput{}, func(page *example.DescribeThingsOutput, lastPage bool) bool { // want "prefer \\(\\*example.Example\\).DescribeThingsPagesWithContext\\(\\) to pass the context"
+		return !lastPage
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	retry := func() error {
+		_, err := conn.DescribeThings(&example.DescribeThingsInput{}) // want "prefer \\(\\*example.Example\\).DescribeThingsWithContext\\(\\) to pass the context"
+
+		return err
+	}
+
+	if err := retry(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	conn.DescribeThingsRequest(&example.DescribeThingsInput{}) // want "call SetContext\\(ctx\\) on the request returned by \\(\\*example.Example\\).DescribeThingsRequest\\(\\) to pass the context"
+
+	req, _ := conn.DescribeThingsRequest(&example.DescribeThingsInput{}) // want "call SetContext\\(ctx\\) on the request returned by \\(\\*example.Example\\).DescribeThingsRequest\\(\\) to pass the context"
+
+	if err := req.Send(); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceUpdateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceDeleteContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return nil
+}
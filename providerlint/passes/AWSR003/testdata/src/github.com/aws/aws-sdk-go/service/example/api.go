@@ -0,0 +1,39 @@
+package example
+
+import (
+	"context"
+)
+
+type Example struct{}
+
+type Request struct{}
+
+func (r *Request) SetContext(ctx context.Context) {}
+
+func (r *Request) Send() error {
+	return nil
+}
+
+type DescribeThingsInput struct{}
+
+type DescribeThingsOutput struct{}
+
+func (c *Example) DescribeThings(input *DescribeThingsInput) (*DescribeThingsOutput, error) {
+	return c.DescribeThingsWithContext(context.Background(), input)
+}
+
+func (c *Example) DescribeThingsWithContext(ctx context.Context, input *DescribeThingsInput) (*DescribeThingsOutput, error) {
+	return &DescribeThingsOutput{}, nil
+}
+
+func (c *Example) DescribeThingsPages(input *DescribeThingsInput, fn func(*DescribeThingsOutput, bool) bool) error {
+	return c.DescribeThingsPagesWithContext(context.Background(), input, fn)
+}
+
+func (c *Example) DescribeThingsPagesWithContext(ctx context.Context, input *DescribeThingsInput, fn func(*DescribeThingsOutput, bool) bool) error {
+	return nil
+}
+
+func (c *Example) DescribeThingsRequest(input *DescribeThingsInput) (*Request, *DescribeThingsOutput) {
+	return &Request{}, &DescribeThingsOutput{}
+}